@@ -18,15 +18,8 @@
 package cmd
 
 import (
-	"fmt"
-
-	humanize "github.com/dustin/go-humanize"
-	"github.com/fatih/color"
 	"github.com/minio/cli"
-	json "github.com/minio/colorjson"
 	"github.com/minio/madmin-go"
-	"github.com/minio/mc/pkg/probe"
-	"github.com/minio/pkg/console"
 )
 
 var adminQuotaFlags = []cli.Flag{
@@ -40,42 +33,13 @@ var adminQuotaFlags = []cli.Flag{
 	},
 }
 
-// quotaMessage container for content message structure
-type quotaMessage struct {
-	op        string
-	Status    string `json:"status"`
-	Bucket    string `json:"bucket"`
-	Quota     uint64 `json:"quota,omitempty"`
-	QuotaType string `json:"type,omitempty"`
-}
-
-func (q quotaMessage) String() string {
-	switch q.op {
-	case "set":
-		return console.Colorize("QuotaMessage",
-			fmt.Sprintf("Successfully set bucket quota of %s with %s type on `%s`", humanize.IBytes(q.Quota), q.QuotaType, q.Bucket))
-	case "unset":
-		return console.Colorize("QuotaMessage",
-			fmt.Sprintf("Successfully cleared bucket quota configured on `%s`", q.Bucket))
-	default:
-		return console.Colorize("QuotaInfo",
-			fmt.Sprintf("Bucket `%s` has %s quota of %s", q.Bucket, q.QuotaType, humanize.IBytes(q.Quota)))
-	}
-}
-
-func (q quotaMessage) JSON() string {
-	jsonMessageBytes, e := json.MarshalIndent(q, "", " ")
-	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
-
-	return string(jsonMessageBytes)
-}
-
 var adminBucketQuotaCmd = cli.Command{
 	Name:         "quota",
 	Usage:        "manage bucket quota",
 	Action:       mainAdminBucketQuota,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
+	Hidden:       true,
 	Flags:        append(adminQuotaFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
@@ -83,6 +47,9 @@ var adminBucketQuotaCmd = cli.Command{
 USAGE:
   {{.HelpName}} TARGET [--hard QUOTA | --clear]
 
+  **DEPRECATED**: This command will be removed in a future version. Please use
+  "mc quota set|info|clear" instead.
+
 QUOTA
   quota accepts human-readable case-insensitive number
   suffixes such as "k", "m", "g" and "t" referring to the metric units KB,
@@ -115,58 +82,33 @@ func checkAdminBucketQuotaSyntax(ctx *cli.Context) {
 // mainAdminBucketQuota is the handler for "mc admin bucket quota" command.
 func mainAdminBucketQuota(ctx *cli.Context) error {
 	checkAdminBucketQuotaSyntax(ctx)
-
-	console.SetColor("QuotaMessage", color.New(color.FgGreen))
-	console.SetColor("QuotaInfo", color.New(color.FgBlue))
+	setQuotaColorScheme()
 
 	// Get the alias parameter from cli
 	args := ctx.Args()
 	aliasedURL := args.Get(0)
+	bucket, err := quotaBucket(aliasedURL)
+	fatalIf(err, "Quota is only available for a bucket.")
 
 	// Create a new MinIO Admin Client
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
-	_, targetURL := url2Alias(args[0])
-	if ctx.IsSet("hard") {
-		qType := madmin.HardQuota
-		quotaStr := ctx.String("hard")
-		quota, e := humanize.ParseBytes(quotaStr)
-		fatalIf(probe.NewError(e).Trace(quotaStr), "Unable to parse quota")
-
-		fatalIf(probe.NewError(client.SetBucketQuota(globalContext, targetURL, &madmin.BucketQuota{
-			Quota: quota,
-			Type:  qType,
-		})).Trace(args...), "Unable to set bucket quota")
-
-		printMsg(quotaMessage{
-			op:        ctx.Command.Name,
-			Bucket:    targetURL,
-			Quota:     quota,
-			QuotaType: string(qType),
-			Status:    "success",
-		})
-	} else if ctx.Bool("clear") {
-		if e := client.SetBucketQuota(globalContext, targetURL, &madmin.BucketQuota{}); e != nil {
-			fatalIf(probe.NewError(e).Trace(args...), "Unable to clear bucket quota config")
-		}
-		printMsg(quotaMessage{
-			op:     ctx.Command.Name,
-			Bucket: targetURL,
-			Status: "success",
-		})
-
-	} else {
-		qCfg, e := client.GetBucketQuota(globalContext, targetURL)
-		fatalIf(probe.NewError(e).Trace(args...), "Unable to get bucket quota")
-		printMsg(quotaMessage{
-			op:        ctx.Command.Name,
-			Bucket:    targetURL,
-			Quota:     qCfg.Quota,
-			QuotaType: string(qCfg.Type),
-			Status:    "success",
-		})
+	switch {
+	case ctx.IsSet("hard"):
+		quota, err := parseQuotaSize(ctx.String("hard"))
+		fatalIf(err, "Unable to parse quota")
+		msg, err := setBucketQuota(client, bucket, quota, madmin.HardQuota)
+		fatalIf(err.Trace(args...), "Unable to set bucket quota")
+		printMsg(msg)
+	case ctx.Bool("clear"):
+		msg, err := clearBucketQuota(client, bucket)
+		fatalIf(err.Trace(args...), "Unable to clear bucket quota config")
+		printMsg(msg)
+	default:
+		msg, err := getBucketQuotaInfo(client, bucket)
+		fatalIf(err.Trace(args...), "Unable to get bucket quota")
+		printMsg(msg)
 	}
-
 	return nil
 }
@@ -125,22 +125,23 @@ func checkAdminBucketRemoteAddSyntax(ctx *cli.Context) {
 // RemoteMessage container for content message structure
 type RemoteMessage struct {
 	op                  string
-	Status              string        `json:"status"`
-	AccessKey           string        `json:"accessKey,omitempty"`
-	SecretKey           string        `json:"secretKey,omitempty"`
-	SourceBucket        string        `json:"sourceBucket"`
-	TargetURL           string        `json:"TargetURL,omitempty"`
-	TargetBucket        string        `json:"TargetBucket,omitempty"`
-	RemoteARN           string        `json:"RemoteARN,omitempty"`
-	Path                string        `json:"path,omitempty"`
-	Region              string        `json:"region,omitempty"`
-	ServiceType         string        `json:"service"`
-	Bandwidth           int64         `json:"bandwidth"`
-	ReplicationSync     bool          `json:"replicationSync"`
-	Proxy               bool          `json:"proxy"`
-	HealthCheckDuration time.Duration `json:"healthcheckDuration"`
-	ResetID             string        `json:"resetID"`
-	ResetBefore         time.Time     `json:"resetBeforeDate"`
+	Status              string            `json:"status"`
+	AccessKey           string            `json:"accessKey,omitempty"`
+	SecretKey           string            `json:"secretKey,omitempty"`
+	SourceBucket        string            `json:"sourceBucket"`
+	TargetURL           string            `json:"TargetURL,omitempty"`
+	TargetBucket        string            `json:"TargetBucket,omitempty"`
+	RemoteARN           string            `json:"RemoteARN,omitempty"`
+	Path                string            `json:"path,omitempty"`
+	Region              string            `json:"region,omitempty"`
+	ServiceType         string            `json:"service"`
+	Bandwidth           int64             `json:"bandwidth"`
+	ReplicationSync     bool              `json:"replicationSync"`
+	Proxy               bool              `json:"proxy"`
+	HealthCheckDuration time.Duration     `json:"healthcheckDuration"`
+	ResetID             string            `json:"resetID"`
+	ResetBefore         time.Time         `json:"resetBeforeDate"`
+	Test                *remoteTargetTest `json:"test,omitempty"`
 }
 
 func (r RemoteMessage) String() string {
@@ -163,6 +164,9 @@ func (r RemoteMessage) String() string {
 		}
 		message += " "
 		message += console.Colorize("ProxyLabel", proxyStr)
+		if r.Test != nil {
+			message += " " + r.Test.String()
+		}
 		return message
 	case "rm":
 		return console.Colorize("RemoteMessage", "Removed remote target for `"+r.SourceBucket+"` bucket successfully.")
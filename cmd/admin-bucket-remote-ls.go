@@ -18,13 +18,20 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"sort"
+	"sync"
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	"github.com/minio/madmin-go"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/pkg/console"
 )
 
@@ -33,10 +40,15 @@ var adminBucketRemoteListFlags = []cli.Flag{
 		Name:  "service",
 		Usage: "type of service. valid options are '[replication]'",
 	},
+	cli.BoolFlag{
+		Name:  "test",
+		Usage: "test connectivity, credentials and bucket of each remote target",
+	},
 }
 
 var adminBucketRemoteListCmd = cli.Command{
 	Name:         "ls",
+	Aliases:      []string{"list"},
 	Usage:        "list remote target ARN(s)",
 	Action:       mainAdminBucketRemoteList,
 	OnUsageError: onUsageError,
@@ -48,6 +60,11 @@ var adminBucketRemoteListCmd = cli.Command{
 USAGE:
   {{.HelpName}} TARGET
 
+TEST:
+  The server does not return the secret key of remote targets, so credentials and
+  the remote bucket are verified using a local alias with the same endpoint and
+  access key. Targets without such an alias are only checked for reachability.
+
 FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
@@ -60,6 +77,9 @@ EXAMPLES:
 
   3. List all remote bucket target(s) on MinIO tenant.
      {{.Prompt}} {{.HelpName}} myminio
+
+  4. List all remote bucket target(s) for bucket 'srcbucket' and test each of them.
+     {{.Prompt}} {{.HelpName}} myminio/srcbucket --test
 `,
 }
 
@@ -95,11 +115,182 @@ func mainAdminBucketRemoteList(ctx *cli.Context) error {
 	fatalIf(err, "Unable to initialize admin connection.")
 	targets, e := client.ListRemoteTargets(globalContext, sourceBucket, ctx.String("service"))
 	fatalIf(probe.NewError(e).Trace(args...), "Unable to list remote target")
-	printRemotes(ctx, aliasedURL, targets)
+
+	var tests []remoteTargetTest
+	if ctx.Bool("test") {
+		console.SetColor("TestSuccess", color.New(color.FgGreen))
+		console.SetColor("TestFailure", color.New(color.FgRed, color.Bold))
+		console.SetColor("TestUnknown", color.New(color.FgYellow))
+		tests = testRemoteTargets(globalContext, targets)
+	}
+	printRemotes(ctx, aliasedURL, targets, tests)
+
+	for _, test := range tests {
+		if !test.ok() {
+			return exitStatus(globalErrorExitStatus)
+		}
+	}
 	return nil
 }
 
-func printRemotes(ctx *cli.Context, urlStr string, targets []madmin.BucketTarget) {
+// remoteTargetTest - result of testing a remote target.
+type remoteTargetTest struct {
+	Reachable    bool   `json:"reachable"`
+	Latency      string `json:"latency,omitempty"`
+	Alias        string `json:"alias,omitempty"`
+	Credentials  string `json:"credentials"`
+	BucketExists *bool  `json:"bucketExists,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// Results of the credentials check of a remote target.
+const (
+	remoteCredsValid   = "valid"
+	remoteCredsInvalid = "invalid"
+	remoteCredsDenied  = "denied"
+	remoteCredsUnknown = "unknown"
+)
+
+func (t remoteTargetTest) ok() bool {
+	return t.Reachable && t.Error == "" && t.Credentials != remoteCredsInvalid &&
+		(t.BucketExists == nil || *t.BucketExists)
+}
+
+func (t remoteTargetTest) String() string {
+	if !t.Reachable {
+		return console.Colorize("TestFailure", "unreachable ("+t.Error+")")
+	}
+	msg := console.Colorize("TestSuccess", "reachable ("+t.Latency+")")
+	switch t.Credentials {
+	case remoteCredsValid:
+		msg += ", " + console.Colorize("TestSuccess", "credentials valid")
+	case remoteCredsInvalid:
+		msg += ", " + console.Colorize("TestFailure", "credentials invalid")
+	case remoteCredsDenied:
+		msg += ", " + console.Colorize("TestUnknown", "access denied")
+	default:
+		msg += ", " + console.Colorize("TestUnknown", "credentials not verified, no local alias")
+	}
+	if t.BucketExists != nil {
+		if *t.BucketExists {
+			msg += ", " + console.Colorize("TestSuccess", "bucket exists")
+		} else {
+			msg += ", " + console.Colorize("TestFailure", "bucket not found")
+		}
+	}
+	if t.Error != "" {
+		msg += ", " + console.Colorize("TestFailure", t.Error)
+	}
+	return msg
+}
+
+// hostWithPort - returns host with the default port of the scheme if it has none.
+func hostWithPort(host string, secure bool) string {
+	if _, _, e := net.SplitHostPort(host); e == nil {
+		return host
+	}
+	if secure {
+		return net.JoinHostPort(host, "443")
+	}
+	return net.JoinHostPort(host, "80")
+}
+
+// findRemoteTargetAlias - returns the local alias pointing to the endpoint of
+// the remote target with the same access key, if any.
+func findRemoteTargetAlias(target madmin.BucketTarget) string {
+	if target.Credentials == nil {
+		return ""
+	}
+	mcCfg, err := loadMcConfig()
+	if err != nil {
+		return ""
+	}
+	endpoint := hostWithPort(target.Endpoint, target.Secure)
+	aliases := make([]string, 0, len(mcCfg.Aliases))
+	for alias := range mcCfg.Aliases {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		aliasCfg := mcCfg.Aliases[alias]
+		u, e := url.Parse(aliasCfg.URL)
+		if e != nil || u.Host == "" {
+			continue
+		}
+		secure := u.Scheme == "https"
+		if secure == target.Secure && hostWithPort(u.Host, secure) == endpoint &&
+			aliasCfg.AccessKey == target.Credentials.AccessKey {
+			return alias
+		}
+	}
+	return ""
+}
+
+// testRemoteTarget - checks that the remote target is reachable and, when a
+// matching local alias is found, that its credentials and bucket are valid.
+func testRemoteTarget(ctx context.Context, pingClnt *http.Client, target madmin.BucketTarget) (test remoteTargetTest) {
+	test.Credentials = remoteCredsUnknown
+	ping := pingAlias(ctx, pingClnt, target.URL().String())
+	test.Reachable, test.Latency, test.Error = ping.Reachable, ping.Latency, ping.Error
+	if !test.Reachable {
+		return test
+	}
+
+	test.Alias = findRemoteTargetAlias(target)
+	if test.Alias == "" {
+		return test
+	}
+	clnt, err := newClientFromAlias(test.Alias, urlJoinPath(mustGetHostConfig(test.Alias).URL, target.TargetBucket))
+	if err != nil {
+		test.Error = err.ToGoError().Error()
+		return test
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, aliasPingTimeout)
+	defer cancel()
+	_, err = clnt.Stat(ctx, StatOptions{})
+	if err == nil {
+		test.Credentials = remoteCredsValid
+		exists := true
+		test.BucketExists = &exists
+		return test
+	}
+
+	e := err.ToGoError()
+	if _, ok := e.(BucketDoesNotExist); ok {
+		test.Credentials = remoteCredsValid
+		exists := false
+		test.BucketExists = &exists
+		return test
+	}
+	switch minio.ToErrorResponse(e).Code {
+	case "InvalidAccessKeyId", "SignatureDoesNotMatch":
+		test.Credentials = remoteCredsInvalid
+	case "AccessDenied":
+		test.Credentials = remoteCredsDenied
+	default:
+		test.Error = e.Error()
+	}
+	return test
+}
+
+// testRemoteTargets - tests all remote targets in parallel.
+func testRemoteTargets(ctx context.Context, targets []madmin.BucketTarget) []remoteTargetTest {
+	pingClnt := newPingClient()
+	tests := make([]remoteTargetTest, len(targets))
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tests[i] = testRemoteTarget(ctx, pingClnt, targets[i])
+		}(i)
+	}
+	wg.Wait()
+	return tests
+}
+
+func printRemotes(ctx *cli.Context, urlStr string, targets []madmin.BucketTarget, tests []remoteTargetTest) {
 	maxURLLen := 10
 	maxTgtLen := 6
 	maxSrcLen := 6
@@ -126,10 +317,14 @@ func printRemotes(ctx *cli.Context, urlStr string, targets []madmin.BucketTarget
 			}
 		}
 		if maxURLLen > 0 {
-			console.Println(console.Colorize("RemoteListMessage", fmt.Sprintf("%-*.*s %-*.*s->%-*.*s %-*.*s %s %s", maxURLLen+8, maxURLLen+8, "Remote URL", maxSrcLen, maxSrcLen, "Source", maxTgtLen, maxTgtLen, "Target", maxArnLen, maxArnLen, "ARN", "SYNC", "PROXY")))
+			header := fmt.Sprintf("%-*.*s %-*.*s->%-*.*s %-*.*s %s %s", maxURLLen+8, maxURLLen+8, "Remote URL", maxSrcLen, maxSrcLen, "Source", maxTgtLen, maxTgtLen, "Target", maxArnLen, maxArnLen, "ARN", "SYNC", "PROXY")
+			if tests != nil {
+				header += " TEST"
+			}
+			console.Println(console.Colorize("RemoteListMessage", header))
 		}
 	}
-	for _, target := range targets {
+	for i, target := range targets {
 		targetURL := target.URL().String()
 		if !globalJSON {
 			if maxURLLen > 0 {
@@ -146,6 +341,10 @@ func printRemotes(ctx *cli.Context, urlStr string, targets []madmin.BucketTarget
 				target.Arn = fmt.Sprintf("%-*.*s", maxArnLen, maxArnLen, target.Arn)
 			}
 		}
+		var test *remoteTargetTest
+		if i < len(tests) {
+			test = &tests[i]
+		}
 		printMsg(RemoteMessage{
 			op:              ctx.Command.Name,
 			AccessKey:       target.Credentials.AccessKey,
@@ -159,6 +358,7 @@ func printRemotes(ctx *cli.Context, urlStr string, targets []madmin.BucketTarget
 			Proxy:           !target.DisableProxy,
 			ResetID:         target.ResetID,
 			ResetBefore:     target.ResetBeforeDate,
+			Test:            test,
 		})
 	}
 }
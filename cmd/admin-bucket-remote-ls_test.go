@@ -0,0 +1,60 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestHostWithPort(t *testing.T) {
+	testCases := []struct {
+		host     string
+		secure   bool
+		expected string
+	}{
+		{"play.min.io", true, "play.min.io:443"},
+		{"play.min.io", false, "play.min.io:80"},
+		{"localhost:9000", false, "localhost:9000"},
+		{"[::1]:9000", true, "[::1]:9000"},
+	}
+	for i, tc := range testCases {
+		if got := hostWithPort(tc.host, tc.secure); got != tc.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, tc.expected, got)
+		}
+	}
+}
+
+func TestRemoteTargetTestOK(t *testing.T) {
+	exists, missing := true, false
+	testCases := []struct {
+		test remoteTargetTest
+		ok   bool
+	}{
+		{remoteTargetTest{Reachable: false, Credentials: remoteCredsUnknown, Error: "connection refused"}, false},
+		{remoteTargetTest{Reachable: true, Credentials: remoteCredsUnknown}, true},
+		{remoteTargetTest{Reachable: true, Credentials: remoteCredsValid, BucketExists: &exists}, true},
+		{remoteTargetTest{Reachable: true, Credentials: remoteCredsValid, BucketExists: &missing}, false},
+		{remoteTargetTest{Reachable: true, Credentials: remoteCredsInvalid}, false},
+		{remoteTargetTest{Reachable: true, Credentials: remoteCredsDenied}, true},
+	}
+	for i, tc := range testCases {
+		if got := tc.test.ok(); got != tc.ok {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.ok, got)
+		}
+	}
+}
@@ -33,6 +33,7 @@ var adminBucketRemoteRmFlags = []cli.Flag{
 
 var adminBucketRemoteRmCmd = cli.Command{
 	Name:         "rm",
+	Aliases:      []string{"remove"},
 	Usage:        "remove configured remote target",
 	Action:       mainAdminBucketRemoteRemove,
 	OnUsageError: onUsageError,
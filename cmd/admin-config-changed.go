@@ -0,0 +1,174 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// configDefaults holds the default values of the config keys of a MinIO
+// server, the server does not report them through its admin API. Keys
+// which are not listed here are not compared.
+var configDefaults = map[string]map[string]string{
+	"api": {
+		"requests_max":                   "0",
+		"requests_deadline":              "10s",
+		"cluster_deadline":               "10s",
+		"cors_allow_origin":              "*",
+		"remote_transport_deadline":      "2h",
+		"list_quorum":                    "strict",
+		"replication_workers":            "250",
+		"replication_failed_workers":     "8",
+		"transition_workers":             "100",
+		"stale_uploads_cleanup_interval": "6h",
+		"stale_uploads_expiry":           "24h",
+		"delete_cleanup_interval":        "5m",
+	},
+	"compression": {
+		"enable":           "off",
+		"allow_encryption": "off",
+		"extensions":       ".txt,.log,.csv,.json,.tar,.xml,.bin",
+		"mime_types":       "text/*,application/json,application/xml,binary/octet-stream",
+	},
+	"region": {
+		"name": "",
+	},
+	"scanner": {
+		"speed": "default",
+	},
+	"notify_webhook":  {"enable": "off"},
+	"notify_kafka":    {"enable": "off"},
+	"notify_amqp":     {"enable": "off"},
+	"notify_mqtt":     {"enable": "off"},
+	"notify_nats":     {"enable": "off"},
+	"notify_nsq":      {"enable": "off"},
+	"notify_mysql":    {"enable": "off"},
+	"notify_postgres": {"enable": "off"},
+	"notify_redis":    {"enable": "off"},
+	"logger_webhook":  {"enable": "off"},
+	"audit_webhook":   {"enable": "off"},
+}
+
+// configChangedKV is a config key whose effective value differs
+// from the server default.
+type configChangedKV struct {
+	SubSystem string `json:"subSystem"`
+	Target    string `json:"target,omitempty"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Default   string `json:"default"`
+	Env       string `json:"env,omitempty"`
+}
+
+// configChangedMessage lists the config keys changed from their defaults.
+type configChangedMessage struct {
+	Status  string            `json:"status"`
+	Changed []configChangedKV `json:"changed"`
+}
+
+// String config lines with only the changed keys, the environment
+// variables overriding keys of a line are listed right before it like
+// the server does.
+func (u configChangedMessage) String() string {
+	var lines []string
+	for i := 0; i < len(u.Changed); {
+		first := u.Changed[i]
+		line := first.SubSystem
+		if first.Target != "" {
+			line += madmin.SubSystemSeparator + first.Target
+		}
+		for ; i < len(u.Changed) && u.Changed[i].SubSystem == first.SubSystem && u.Changed[i].Target == first.Target; i++ {
+			kv := u.Changed[i]
+			if kv.Env != "" {
+				lines = append(lines, fmt.Sprintf("# %s=%s", console.Colorize("EnvVar", kv.Env), kv.Value))
+			}
+			value := kv.Value
+			if value == "" || strings.ContainsAny(value, " \t") {
+				value = fmt.Sprintf("%q", value)
+			}
+			line += " " + kv.Key + "=" + value
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// JSON jsonified config changed message.
+func (u configChangedMessage) JSON() string {
+	u.Status = "success"
+	statusJSONBytes, e := json.MarshalIndent(u, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(statusJSONBytes)
+}
+
+// changedConfigKVs returns the keys of the config whose effective value,
+// environment overrides included, differs from the default.
+// Keys without a known default are skipped.
+func changedConfigKVs(cfgs []madmin.SubsysConfig, defaults map[string]map[string]string) (changed []configChangedKV) {
+	for _, cfg := range cfgs {
+		subDefaults := defaults[cfg.SubSystem]
+		for _, kv := range cfg.KV {
+			def, ok := subDefaults[kv.Key]
+			if !ok {
+				continue
+			}
+			c := configChangedKV{
+				SubSystem: cfg.SubSystem,
+				Target:    cfg.Target,
+				Key:       kv.Key,
+				Value:     kv.Value,
+				Default:   def,
+			}
+			if kv.EnvOverride != nil {
+				c.Value = kv.EnvOverride.Value
+				c.Env = kv.EnvOverride.Name
+			}
+			if c.Value != c.Default {
+				changed = append(changed, c)
+			}
+		}
+	}
+	return changed
+}
+
+// getChangedConfig fetches the config, of a single sub-system if subSys
+// is not empty, and returns only the keys changed from their defaults.
+func getChangedConfig(client *madmin.AdminClient, subSys string) (configChangedMessage, error) {
+	var buf []byte
+	var e error
+	if subSys == "" {
+		buf, e = client.GetConfig(globalContext)
+	} else {
+		buf, e = client.GetConfigKV(globalContext, subSys)
+	}
+	if e != nil {
+		return configChangedMessage{}, e
+	}
+	cfgs, e := madmin.ParseServerConfigOutput(string(buf))
+	if e != nil {
+		return configChangedMessage{}, e
+	}
+	return configChangedMessage{Changed: changedConfigKVs(cfgs, configDefaults)}, nil
+}
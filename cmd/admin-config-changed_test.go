@@ -0,0 +1,63 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestChangedConfigKVs(t *testing.T) {
+	cfgs, err := madmin.ParseServerConfigOutput(`api requests_max=1000 cors_allow_origin="*"
+# MINIO_SCANNER_SPEED=slow
+scanner speed=default
+notify_webhook:1 enable=on endpoint="http://localhost:8080" queue_dir=
+# MINIO_API_REQUESTS_DEADLINE=20s
+api requests_max=0 requests_deadline=10s cors_allow_origin="https://example.com"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := map[string]map[string]string{
+		"api":            {"requests_max": "0", "requests_deadline": "10s", "cors_allow_origin": "*"},
+		"scanner":        {"speed": "default"},
+		"notify_webhook": {"enable": "off", "queue_dir": ""},
+	}
+
+	changed := changedConfigKVs(cfgs, defaults)
+	expected := []configChangedKV{
+		{SubSystem: "api", Key: "requests_max", Value: "1000", Default: "0"},
+		{SubSystem: "scanner", Key: "speed", Value: "slow", Default: "default", Env: "MINIO_SCANNER_SPEED"},
+		{SubSystem: "notify_webhook", Target: "1", Key: "enable", Value: "on", Default: "off"},
+		{SubSystem: "api", Key: "requests_deadline", Value: "20s", Default: "10s", Env: "MINIO_API_REQUESTS_DEADLINE"},
+		{SubSystem: "api", Key: "cors_allow_origin", Value: "https://example.com", Default: "*"},
+	}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, changed)
+	}
+
+	out := configChangedMessage{Changed: changed}.String()
+	// Environment variables are listed before the line of their sub-system.
+	want := "api requests_max=1000\n# MINIO_SCANNER_SPEED=slow\nscanner speed=slow\nnotify_webhook:1 enable=on\n" +
+		"# MINIO_API_REQUESTS_DEADLINE=20s\napi requests_deadline=20s cors_allow_origin=https://example.com"
+	if out != want {
+		t.Errorf("expected output %q, got %q", want, out)
+	}
+}
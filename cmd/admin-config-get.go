@@ -32,18 +32,25 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var adminConfigGetFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "changed-only",
+		Usage: "show only the keys whose value differs from the MinIO server default",
+	},
+}
+
 var adminConfigGetCmd = cli.Command{
 	Name:         "get",
 	Usage:        "interactively retrieve a config key parameters",
 	Before:       setGlobalsFromContext,
 	Action:       mainAdminConfigGet,
 	OnUsageError: onUsageError,
-	Flags:        globalFlags,
+	Flags:        append(adminConfigGetFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET [SUBSYSTEM[:NAME] [KEY]]
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -62,6 +69,16 @@ EXAMPLES:
   3. Get the current compression settings on MinIO server
      {{.Prompt}} {{.HelpName}} myminio/ compression
      compression extensions=".txt,.csv" mime_types="text/*"
+
+  4. Print only the endpoint of the Webhook target '1' on MinIO server, e.g. for use in scripts
+     {{.Prompt}} {{.HelpName}} myminio/ notify_webhook:1 endpoint
+     http://localhost:8080
+
+  5. Show only the settings changed from their defaults on MinIO server, the defaults are those of
+     the api, compression, region, scanner, notification and logger keys known to this mc release.
+     {{.Prompt}} {{.HelpName}} myminio/ --changed-only
+     api requests_max=1000
+     notify_webhook:1 enable=on
 `,
 }
 
@@ -108,6 +125,53 @@ func (u configGetMessage) JSON() string {
 	return string(statusJSONBytes)
 }
 
+// configGetValueMessage is a single config value printed without decoration.
+type configGetValueMessage struct {
+	Status    string `json:"status"`
+	SubSystem string `json:"subSystem"`
+	Target    string `json:"target,omitempty"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+// String raw config value.
+func (u configGetValueMessage) String() string {
+	return u.Value
+}
+
+// JSON jsonified config value message.
+func (u configGetValueMessage) JSON() string {
+	u.Status = "success"
+	statusJSONBytes, e := json.MarshalIndent(u, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(statusJSONBytes)
+}
+
+// lookupConfigValue extracts the value of key for the sub-system, with
+// an optional ':target' suffix, from the server config output.
+func lookupConfigValue(buf []byte, subSys, key string) (configGetValueMessage, error) {
+	msg := configGetValueMessage{Key: key}
+	msg.SubSystem, msg.Target, _ = strings.Cut(subSys, ":")
+
+	cfgs, e := madmin.ParseServerConfigOutput(string(buf))
+	if e != nil {
+		return msg, e
+	}
+	for _, cfg := range cfgs {
+		if cfg.SubSystem != msg.SubSystem || cfg.Target != msg.Target {
+			continue
+		}
+		value, ok := cfg.Lookup(key)
+		if !ok {
+			return msg, fmt.Errorf("key `%s` not found in `%s`", key, subSys)
+		}
+		msg.Value = value
+		return msg, nil
+	}
+	return msg, fmt.Errorf("`%s` not found", subSys)
+}
+
 // checkAdminConfigGetSyntax - validate all the passed arguments
 func checkAdminConfigGetSyntax(ctx *cli.Context) {
 	if !ctx.Args().Present() || len(ctx.Args()) < 1 {
@@ -126,6 +190,18 @@ func mainAdminConfigGet(ctx *cli.Context) error {
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
+	if ctx.Bool("changed-only") {
+		if len(args) > 2 {
+			fatalIf(errInvalidArgument().Trace(args...), "--changed-only accepts at most one sub-system.")
+		}
+		console.SetColor("EnvVar", color.New(color.FgYellow))
+		msg, e := getChangedConfig(client, args.Get(1))
+		fatalIf(probe.NewError(e).Trace(args...), "Unable to get changed config")
+
+		printMsg(msg)
+		return nil
+	}
+
 	if len(ctx.Args()) == 1 {
 		// Call get config API
 		hr, e := client.HelpConfigKV(globalContext, "", "", false)
@@ -140,6 +216,18 @@ func mainAdminConfigGet(ctx *cli.Context) error {
 		return nil
 	}
 
+	if len(args) == 3 {
+		subSys, key := args.Get(1), args.Get(2)
+		buf, e := client.GetConfigKV(globalContext, subSys)
+		fatalIf(probe.NewError(e), "Unable to get server '%s' config", subSys)
+
+		msg, e := lookupConfigValue(buf, subSys, key)
+		fatalIf(probe.NewError(e).Trace(args...), "Unable to get config key")
+
+		printMsg(msg)
+		return nil
+	}
+
 	subSys := strings.Join(args.Tail(), " ")
 
 	// Call get config API
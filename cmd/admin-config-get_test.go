@@ -0,0 +1,49 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestLookupConfigValue(t *testing.T) {
+	buf := []byte(`notify_webhook enable=off endpoint= queue_limit=0
+notify_webhook:1 endpoint="http://localhost:8080" auth_token= queue_limit=10000
+# MINIO_REGION_NAME=eu-west-1
+region name=us-east-1
+`)
+	testCases := []struct {
+		subSys, key string
+		value       string
+		wantErr     bool
+	}{
+		{"notify_webhook:1", "endpoint", "http://localhost:8080", false},
+		{"notify_webhook:1", "auth_token", "", false},
+		{"notify_webhook", "queue_limit", "0", false},
+		{"notify_webhook:1", "unknown", "", true},
+		{"notify_webhook:2", "endpoint", "", true},
+		{"region", "name", "eu-west-1", false},
+	}
+	for i, tc := range testCases {
+		msg, err := lookupConfigValue(buf, tc.subSys, tc.key)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, tc.wantErr, err)
+		}
+		if msg.Value != tc.value {
+			t.Errorf("Test %d: expected value %q, got %q", i+1, tc.value, msg.Value)
+		}
+	}
+}
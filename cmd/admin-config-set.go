@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/fatih/color"
@@ -29,13 +30,24 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var adminConfigSetFlags = append([]cli.Flag{
+	cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "validate the settings against the server config help without applying them",
+	},
+	cli.BoolFlag{
+		Name:  "backup",
+		Usage: "save the current config to a timestamped file before applying the settings",
+	},
+}, adminConfigEnvFlags...)
+
 var adminConfigSetCmd = cli.Command{
 	Name:         "set",
 	Usage:        "interactively set a config key parameters",
 	Before:       setGlobalsFromContext,
 	Action:       mainAdminConfigSet,
 	OnUsageError: onUsageError,
-	Flags:        append(adminConfigEnvFlags, globalFlags...),
+	Flags:        append(adminConfigSetFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
@@ -54,12 +66,20 @@ EXAMPLES:
 
   3. Change healing settings on a distributed MinIO server setup.
      {{.Prompt}} {{.HelpName}} mydist/ heal max_delay=300ms max_io=50
+
+  4. Validate webhook notification settings without applying them.
+     {{.Prompt}} {{.HelpName}} --dry-run myminio/ notify_webhook:1 enable=on endpoint="http://localhost:8080/minio/events"
+
+  5. Save the current config to a local file before changing the region, restore it with 'mc admin config import'.
+     {{.Prompt}} {{.HelpName}} --backup myminio/ region name=us-west-1
+     {{.Prompt}} mc admin config import myminio/ < myminio-config-20220101T000000Z.txt
 `,
 }
 
 // configSetMessage container to hold locks information.
 type configSetMessage struct {
 	Status      string `json:"status"`
+	Backup      string `json:"backup,omitempty"`
 	targetAlias string
 	restart     bool
 }
@@ -68,6 +88,10 @@ type configSetMessage struct {
 func (u configSetMessage) String() (msg string) {
 	msg += console.Colorize("SetConfigSuccess",
 		"Successfully applied new settings.")
+	if u.Backup != "" {
+		msg += console.Colorize("SetConfigSuccess",
+			fmt.Sprintf("\nPrevious config saved to '%s'.", u.Backup))
+	}
 	if u.restart {
 		suggestion := color.RedString("mc admin service restart %s", u.targetAlias)
 		msg += console.Colorize("SetConfigSuccess",
@@ -92,6 +116,23 @@ func checkAdminConfigSetSyntax(ctx *cli.Context) {
 	}
 }
 
+// backupConfig saves the full server config, as printed by
+// 'mc admin config export', to a timestamped file in the current
+// directory and returns its path.
+func backupConfig(client *madmin.AdminClient, aliasedURL string) (string, *probe.Error) {
+	buf, e := client.GetConfig(globalContext)
+	if e != nil {
+		return "", probe.NewError(e)
+	}
+	alias, _ := url2Alias(aliasedURL)
+	backup := fmt.Sprintf("%s-config-%s.txt", alias, UTCNow().Format("20060102T150405Z"))
+	// The config holds credentials, keep it private.
+	if e = os.WriteFile(backup, buf, 0o600); e != nil {
+		return "", probe.NewError(e)
+	}
+	return backup, nil
+}
+
 // main config set function
 func mainAdminConfigSet(ctx *cli.Context) error {
 	// Check command arguments
@@ -125,12 +166,26 @@ func mainAdminConfigSet(ctx *cli.Context) error {
 
 	}
 
+	if ctx.Bool("dry-run") {
+		return validateConfigSet(client, aliasedURL, input)
+	}
+
+	var backup string
+	if ctx.Bool("backup") {
+		backup, err = backupConfig(client, aliasedURL)
+		fatalIf(err, "Unable to backup server config")
+	}
+
 	// Call set config API
 	restart, e := client.SetConfigKV(globalContext, input)
+	if backup != "" {
+		fatalIf(probe.NewError(e), "Unable to set '%s' to server, previous config saved to '%s'", input, backup)
+	}
 	fatalIf(probe.NewError(e), "Unable to set '%s' to server", input)
 
 	// Print set config result
 	printMsg(configSetMessage{
+		Backup:      backup,
 		targetAlias: aliasedURL,
 		restart:     restart,
 	})
@@ -0,0 +1,164 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// Sub-systems the server applies without a restart.
+var dynamicConfigSubSystems = map[string]bool{
+	"api":            true,
+	"compression":    true,
+	"scanner":        true,
+	"heal":           true,
+	"subnet":         true,
+	"callhome":       true,
+	"storage_class":  true,
+	"logger_webhook": true,
+	"audit_webhook":  true,
+	"audit_kafka":    true,
+}
+
+// configValidateMessage is the result of 'mc admin config set --dry-run'.
+type configValidateMessage struct {
+	Status      string   `json:"status"`
+	SubSystem   string   `json:"subSystem"`
+	Target      string   `json:"target,omitempty"`
+	Errors      []string `json:"errors,omitempty"`
+	Restart     bool     `json:"restart"`
+	targetAlias string
+}
+
+// String colorized config validation message.
+func (u configValidateMessage) String() (msg string) {
+	if len(u.Errors) > 0 {
+		msg = console.Colorize("SetConfigFailure", "Invalid settings, nothing was applied:")
+		for _, e := range u.Errors {
+			msg += "\n" + console.Colorize("SetConfigFailure", "  "+e)
+		}
+		return msg
+	}
+	msg = console.Colorize("SetConfigSuccess", "Settings are valid, nothing was applied.")
+	if u.Restart {
+		suggestion := color.RedString("mc admin service restart %s", u.targetAlias)
+		msg += console.Colorize("SetConfigSuccess",
+			fmt.Sprintf("\nApplying them requires restarting your server with '%s'.", suggestion))
+	}
+	return msg
+}
+
+// JSON jsonified config validation message.
+func (u configValidateMessage) JSON() string {
+	u.Status = "success"
+	if len(u.Errors) > 0 {
+		u.Status = "error"
+	}
+	statusJSONBytes, e := json.MarshalIndent(u, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(statusJSONBytes)
+}
+
+// validateConfigValue checks a value against the type advertised in
+// the config help of its key.
+func validateConfigValue(typ, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch typ {
+	case "on|off":
+		if value != "on" && value != "off" {
+			return fmt.Errorf("expected 'on' or 'off', got '%s'", value)
+		}
+	case "duration":
+		if _, e := time.ParseDuration(value); e != nil {
+			return fmt.Errorf("invalid duration '%s'", value)
+		}
+	case "number":
+		if _, e := strconv.ParseFloat(value, 64); e != nil {
+			return fmt.Errorf("invalid number '%s'", value)
+		}
+	}
+	return nil
+}
+
+// validateConfigInput checks a 'SUBSYS[:TARGET] KEY=VALUE...' input
+// against the help of its sub-system, without applying it.
+func validateConfigInput(input string, help madmin.Help) (u configValidateMessage, e error) {
+	cfgs, e := madmin.ParseServerConfigOutput(input)
+	if e != nil {
+		return u, e
+	}
+	if len(cfgs) != 1 {
+		return u, fmt.Errorf("expected settings for a single sub-system, got %d", len(cfgs))
+	}
+	cfg := cfgs[0]
+	u.SubSystem, u.Target = cfg.SubSystem, cfg.Target
+	u.Restart = !dynamicConfigSubSystems[cfg.SubSystem]
+
+	if cfg.Target != "" && !help.MultipleTargets {
+		u.Errors = append(u.Errors, fmt.Sprintf("sub-system '%s' does not support named targets", cfg.SubSystem))
+	}
+	keysHelp := make(map[string]madmin.HelpKV, len(help.KeysHelp))
+	for _, kh := range help.KeysHelp {
+		keysHelp[kh.Key] = kh
+	}
+	for _, kv := range cfg.KV {
+		if kv.Key == madmin.CommentKey {
+			continue
+		}
+		kh, ok := keysHelp[kv.Key]
+		if !ok {
+			u.Errors = append(u.Errors, fmt.Sprintf("unknown key '%s' for sub-system '%s'", kv.Key, cfg.SubSystem))
+			continue
+		}
+		if e := validateConfigValue(kh.Type, kv.Value); e != nil {
+			u.Errors = append(u.Errors, fmt.Sprintf("%s: %v", kv.Key, e))
+		}
+	}
+	return u, nil
+}
+
+// validateConfigSet implements 'mc admin config set --dry-run'.
+func validateConfigSet(client *madmin.AdminClient, aliasedURL, input string) error {
+	console.SetColor("SetConfigFailure", color.New(color.FgRed, color.Bold))
+
+	subSys := strings.SplitN(strings.Fields(input)[0], madmin.SubSystemSeparator, 2)[0]
+	help, e := client.HelpConfigKV(globalContext, subSys, "", false)
+	fatalIf(probe.NewError(e), "Unable to get help for the sub-system")
+
+	msg, e := validateConfigInput(input, help)
+	fatalIf(probe.NewError(e), "Unable to parse '%s'", input)
+
+	msg.targetAlias = aliasedURL
+	printMsg(msg)
+	if len(msg.Errors) > 0 {
+		return exitStatus(globalErrorExitStatus)
+	}
+	return nil
+}
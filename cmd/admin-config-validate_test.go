@@ -0,0 +1,75 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestValidateConfigInput(t *testing.T) {
+	webhookHelp := madmin.Help{
+		SubSys:          "notify_webhook",
+		MultipleTargets: true,
+		KeysHelp: madmin.HelpKVS{
+			{Key: "enable", Type: "on|off"},
+			{Key: "endpoint", Type: "url"},
+			{Key: "queue_limit", Type: "number"},
+		},
+	}
+	healHelp := madmin.Help{
+		SubSys: "heal",
+		KeysHelp: madmin.HelpKVS{
+			{Key: "max_sleep", Type: "duration"},
+			{Key: "max_io", Type: "number"},
+		},
+	}
+
+	testCases := []struct {
+		input   string
+		help    madmin.Help
+		errors  []string
+		restart bool
+	}{
+		{`notify_webhook:1 enable=on endpoint="http://localhost:8080" comment="events"`, webhookHelp, nil, true},
+		{`notify_webhook:1 enable=yes queue_limit=ten`, webhookHelp, []string{
+			"enable: expected 'on' or 'off', got 'yes'",
+			"queue_limit: invalid number 'ten'",
+		}, true},
+		{`notify_webhook auth=token`, webhookHelp, []string{"unknown key 'auth' for sub-system 'notify_webhook'"}, true},
+		{`heal max_sleep=250ms max_io=100`, healHelp, nil, false},
+		{`heal:1 max_sleep=soon`, healHelp, []string{
+			"sub-system 'heal' does not support named targets",
+			"max_sleep: invalid duration 'soon'",
+		}, false},
+	}
+	for i, tc := range testCases {
+		msg, err := validateConfigInput(tc.input, tc.help)
+		if err != nil {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if !reflect.DeepEqual(msg.Errors, tc.errors) {
+			t.Errorf("Test %d: expected errors %q, got %q", i+1, tc.errors, msg.Errors)
+		}
+		if msg.Restart != tc.restart {
+			t.Errorf("Test %d: expected restart %v, got %v", i+1, tc.restart, msg.Restart)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// decomFollowInterval is how often the decommission status is polled
+// by 'mc admin decommission start --follow'.
+const decomFollowInterval = 5 * time.Second
+
+// Decommission states reported by decomProgressMessage.
+const (
+	decomStateStarting = "starting"
+	decomStateDraining = "draining"
+	decomStateComplete = "complete"
+	decomStateFailed   = "failed"
+	decomStateCanceled = "canceled"
+)
+
+// decomProgressMessage is one progress record of a pool decommission.
+type decomProgressMessage struct {
+	Status         string    `json:"status"`
+	Pool           string    `json:"pool"`
+	State          string    `json:"state"`
+	StartTime      time.Time `json:"startTime,omitempty"`
+	TotalSize      int64     `json:"totalSize"`
+	BytesMoved     int64     `json:"bytesMoved"`
+	BytesRemaining int64     `json:"bytesRemaining"`
+	Percent        float64   `json:"percent"`
+	BytesPerSecond int64     `json:"bytesPerSecond"`
+	ETA            int64     `json:"etaSeconds,omitempty"`
+}
+
+func (d decomProgressMessage) String() string {
+	switch d.State {
+	case decomStateStarting:
+		return console.Colorize("DecomPool", "Decommissioning of `"+d.Pool+"` is starting...")
+	case decomStateComplete:
+		return console.Colorize("DecomPool", "Decommission of `"+d.Pool+"` is complete, you may now remove it from server command line.")
+	case decomStateFailed:
+		return console.Colorize("DecomFailed", "Decommission of `"+d.Pool+"` failed, please retry again.")
+	case decomStateCanceled:
+		return console.Colorize("DecomFailed", "Decommission of `"+d.Pool+"` was canceled, you may start again.")
+	}
+	eta := "unknown"
+	if d.ETA > 0 {
+		eta = timeDurationToHumanizedDuration(time.Duration(d.ETA) * time.Second).StringShort()
+	}
+	return console.Colorize("DecomProgress", fmt.Sprintf("Decommissioning `%s` %.1f%% [%s/%s moved] at %s/s, ETA %s",
+		d.Pool, d.Percent, humanize.IBytes(uint64(d.BytesMoved)), humanize.IBytes(uint64(d.BytesMoved+d.BytesRemaining)),
+		humanize.IBytes(uint64(d.BytesPerSecond)), eta))
+}
+
+func (d decomProgressMessage) JSON() string {
+	d.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(d, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+// newDecomProgress computes the progress of a pool decommission. The
+// data moved is the space used when decommissioning started minus the
+// space used now, the rate is averaged since the start.
+func newDecomProgress(pool string, info *madmin.PoolDecommissionInfo, now time.Time) decomProgressMessage {
+	msg := decomProgressMessage{Pool: pool, State: decomStateStarting}
+	if info == nil || info.StartTime.IsZero() {
+		return msg
+	}
+	msg.StartTime = info.StartTime
+	msg.TotalSize = info.TotalSize
+	switch {
+	case info.Complete:
+		msg.State = decomStateComplete
+	case info.Failed:
+		msg.State = decomStateFailed
+	case info.Canceled:
+		msg.State = decomStateCanceled
+	default:
+		msg.State = decomStateDraining
+	}
+
+	usedStart := info.TotalSize - info.StartSize
+	usedCurrent := info.TotalSize - info.CurrentSize
+	if usedCurrent < 0 {
+		usedCurrent = 0
+	}
+	if usedStart > usedCurrent {
+		msg.BytesMoved = usedStart - usedCurrent
+	}
+	msg.BytesRemaining = usedCurrent
+	if msg.State == decomStateComplete {
+		msg.BytesRemaining = 0
+	}
+	if total := msg.BytesMoved + msg.BytesRemaining; total > 0 {
+		msg.Percent = float64(msg.BytesMoved) * 100 / float64(total)
+	} else if msg.State == decomStateComplete {
+		msg.Percent = 100
+	}
+	if elapsed := now.Sub(info.StartTime).Seconds(); elapsed >= 1 {
+		msg.BytesPerSecond = int64(float64(msg.BytesMoved) / elapsed)
+	}
+	if msg.State == decomStateDraining && msg.BytesPerSecond > 0 {
+		msg.ETA = msg.BytesRemaining / msg.BytesPerSecond
+	}
+	return msg
+}
+
+// followDecommission prints the progress of a pool decommission until
+// it completes, fails or is canceled. Status errors are reported and
+// polling continues, the server may be restarting.
+func followDecommission(ctx context.Context, client *madmin.AdminClient, pool string) error {
+	ticker := time.NewTicker(decomFollowInterval)
+	defer ticker.Stop()
+	for {
+		status, e := client.StatusPool(ctx, pool)
+		if e != nil {
+			errorIf(probe.NewError(e).Trace(pool), "Unable to get decommission status, retrying.")
+		} else {
+			msg := newDecomProgress(pool, status.Decommission, time.Now())
+			printMsg(msg)
+			switch msg.State {
+			case decomStateComplete:
+				return nil
+			case decomStateFailed, decomStateCanceled:
+				return exitStatus(globalErrorExitStatus)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return exitStatus(globalErrorExitStatus)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestNewDecomProgress(t *testing.T) {
+	start := time.Unix(1000, 0)
+	now := start.Add(100 * time.Second)
+	testCases := []struct {
+		info     *madmin.PoolDecommissionInfo
+		expected decomProgressMessage
+	}{
+		{nil, decomProgressMessage{State: decomStateStarting}},
+		{&madmin.PoolDecommissionInfo{}, decomProgressMessage{State: decomStateStarting}},
+		// 1000 bytes used at start, 250 still used: 750 moved at 7 bytes/sec.
+		{
+			&madmin.PoolDecommissionInfo{StartTime: start, TotalSize: 2000, StartSize: 1000, CurrentSize: 1750},
+			decomProgressMessage{
+				State: decomStateDraining, StartTime: start, TotalSize: 2000, BytesMoved: 750,
+				BytesRemaining: 250, Percent: 75, BytesPerSecond: 7, ETA: 35,
+			},
+		},
+		// Nothing moved yet, the ETA is unknown.
+		{
+			&madmin.PoolDecommissionInfo{StartTime: start, TotalSize: 2000, StartSize: 1000, CurrentSize: 1000},
+			decomProgressMessage{State: decomStateDraining, StartTime: start, TotalSize: 2000, BytesRemaining: 1000},
+		},
+		{
+			&madmin.PoolDecommissionInfo{StartTime: start, TotalSize: 2000, StartSize: 1000, CurrentSize: 2000, Complete: true},
+			decomProgressMessage{State: decomStateComplete, StartTime: start, TotalSize: 2000, BytesMoved: 1000, Percent: 100, BytesPerSecond: 10},
+		},
+		{
+			&madmin.PoolDecommissionInfo{StartTime: start, TotalSize: 2000, StartSize: 1000, CurrentSize: 1500, Failed: true},
+			decomProgressMessage{State: decomStateFailed, StartTime: start, TotalSize: 2000, BytesMoved: 500, BytesRemaining: 500, Percent: 50, BytesPerSecond: 5},
+		},
+	}
+	for i, testCase := range testCases {
+		testCase.expected.Pool = "pool"
+		if got := newDecomProgress("pool", testCase.info, now); got != testCase.expected {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.expected, got)
+		}
+	}
+}
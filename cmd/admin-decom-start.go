@@ -33,12 +33,17 @@ var adminDecommissionStartCmd = cli.Command{
 	Action:       mainAdminDecommissionStart,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags: append([]cli.Flag{
+		cli.BoolFlag{
+			Name:  "follow",
+			Usage: "show progress until the decommission completes, fails or is canceled",
+		},
+	}, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET POOL
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -46,6 +51,12 @@ FLAGS:
 EXAMPLES:
   1. Start decommissioning a pool for removal.
      {{.Prompt}} {{.HelpName}} myminio/ http://server{5...8}/disk{1...4}
+
+  2. Start decommissioning a pool and show progress every 5 seconds until it completes.
+     {{.Prompt}} {{.HelpName}} --follow myminio/ http://server{5...8}/disk{1...4}
+
+  3. Start decommissioning a pool and stream JSON progress records for automation.
+     {{.Prompt}} {{.HelpName}} --follow --json myminio/ http://server{5...8}/disk{1...4}
 `,
 }
 
@@ -81,6 +92,8 @@ func mainAdminDecommissionStart(ctx *cli.Context) error {
 
 	// Additional command speific theme customization.
 	console.SetColor("DecomPool", color.New(color.FgGreen, color.Bold))
+	console.SetColor("DecomProgress", color.New(color.FgGreen))
+	console.SetColor("DecomFailed", color.New(color.FgRed, color.Bold))
 
 	// Get the alias parameter from cli
 	args := ctx.Args()
@@ -98,5 +111,8 @@ func mainAdminDecommissionStart(ctx *cli.Context) error {
 		Status: "success",
 		Pool:   args.Get(1),
 	})
+	if ctx.Bool("follow") {
+		return followDecommission(globalContext, client, args.Get(1))
+	}
 	return nil
 }
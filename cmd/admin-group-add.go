@@ -67,6 +67,35 @@ type groupMessage struct {
 	Members     []string `json:"members,omitempty"`
 	GroupStatus string   `json:"groupStatus,omitempty"`
 	GroupPolicy string   `json:"groupPolicy,omitempty"`
+	// PreviousStatus, Changed and DryRun are only set by enable/disable
+	PreviousStatus string `json:"previousStatus,omitempty"`
+	Changed        *bool  `json:"changed,omitempty"`
+	DryRun         bool   `json:"dryRun,omitempty"`
+	showMembers    bool
+}
+
+// unchanged - returns true if enable/disable found the group already in the requested status
+func (u groupMessage) unchanged() bool {
+	return u.Changed != nil && !*u.Changed
+}
+
+// dryRunString - status transition of the group, displayed with --dry-run
+func (u groupMessage) dryRunString() string {
+	if u.unchanged() {
+		return console.Colorize("GroupMessage", u.GroupName+": already "+u.GroupStatus+", no change needed") + u.membersString()
+	}
+	return console.Colorize("GroupMessage", u.GroupName+": "+u.PreviousStatus+" -> "+u.GroupStatus) + u.membersString()
+}
+
+// membersString - members and policy of the group, displayed with --show-members
+func (u groupMessage) membersString() string {
+	if !u.showMembers {
+		return ""
+	}
+	return "\n" + strings.Join([]string{
+		console.Colorize("GroupMessage", "Policy: "+u.GroupPolicy),
+		console.Colorize("GroupMessage", "Members: "+strings.Join(u.Members, ",")),
+	}, "\n")
 }
 
 func (u groupMessage) String() string {
@@ -78,9 +107,21 @@ func (u groupMessage) String() string {
 		}
 		return strings.Join(s, "\n")
 	case "disable":
-		return console.Colorize("GroupMessage", "Disabled group `"+u.GroupName+"` successfully.")
+		if u.DryRun {
+			return u.dryRunString()
+		}
+		if u.unchanged() {
+			return console.Colorize("GroupMessage", "Group `"+u.GroupName+"` already disabled (no change).") + u.membersString()
+		}
+		return console.Colorize("GroupMessage", "Disabled group `"+u.GroupName+"` successfully.") + u.membersString()
 	case "enable":
-		return console.Colorize("GroupMessage", "Enabled group `"+u.GroupName+"` successfully.")
+		if u.DryRun {
+			return u.dryRunString()
+		}
+		if u.unchanged() {
+			return console.Colorize("GroupMessage", "Group `"+u.GroupName+"` already enabled (no change).") + u.membersString()
+		}
+		return console.Colorize("GroupMessage", "Enabled group `"+u.GroupName+"` successfully.") + u.membersString()
 	case "add":
 		membersStr := fmt.Sprintf("{%s}", strings.Join(u.Members, ","))
 		return console.Colorize("GroupMessage", "Added members "+membersStr+" to group "+u.GroupName+" successfully.")
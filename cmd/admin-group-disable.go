@@ -27,12 +27,12 @@ var adminGroupDisableCmd = cli.Command{
 	Action:       mainAdminGroupEnableDisable,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminGroupEnableDisableFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET GROUPNAME
+  {{.HelpName}} TARGET GROUPNAME [GROUPNAME...]
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -40,5 +40,14 @@ FLAGS:
 EXAMPLES:
   1. Disable group 'allcents'.
      {{.Prompt}} {{.HelpName}} myminio allcents
+
+  2. Disable group 'allcents' and display its members and policy.
+     {{.Prompt}} {{.HelpName}} --show-members myminio allcents
+
+  3. Disable groups 'allcents' and 'alldollars'.
+     {{.Prompt}} {{.HelpName}} myminio allcents alldollars
+
+  4. Display the status change of group 'allcents' without disabling it.
+     {{.Prompt}} {{.HelpName}} --dry-run myminio allcents
 `,
 }
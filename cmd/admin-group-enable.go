@@ -18,6 +18,8 @@
 package cmd
 
 import (
+	"strings"
+
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	"github.com/minio/madmin-go"
@@ -25,18 +27,29 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var adminGroupEnableDisableFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "show-members",
+		Usage: "display the members and the policy of the group",
+	},
+	cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "display the status change without applying it",
+	},
+}
+
 var adminGroupEnableCmd = cli.Command{
 	Name:         "enable",
 	Usage:        "enable a group",
 	Action:       mainAdminGroupEnableDisable,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminGroupEnableDisableFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET GROUPNAME
+  {{.HelpName}} TARGET GROUPNAME [GROUPNAME...]
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -44,12 +57,21 @@ FLAGS:
 EXAMPLES:
   1. Enable group 'allcents'.
      {{.Prompt}} {{.HelpName}} myminio allcents
+
+  2. Enable group 'allcents' and display its members and policy.
+     {{.Prompt}} {{.HelpName}} --show-members myminio allcents
+
+  3. Enable groups 'allcents' and 'alldollars'.
+     {{.Prompt}} {{.HelpName}} myminio allcents alldollars
+
+  4. Display the status change of group 'allcents' without enabling it.
+     {{.Prompt}} {{.HelpName}} --dry-run myminio allcents
 `,
 }
 
 // checkAdminGroupEnableSyntax - validate all the passed arguments
 func checkAdminGroupEnableSyntax(ctx *cli.Context) {
-	if len(ctx.Args()) != 2 {
+	if len(ctx.Args()) < 2 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
 }
@@ -68,7 +90,6 @@ func mainAdminGroupEnableDisable(ctx *cli.Context) error {
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
-	group := args.Get(1)
 	var status madmin.GroupStatus
 	switch ctx.Command.Name {
 	case "enable":
@@ -78,14 +99,48 @@ func mainAdminGroupEnableDisable(ctx *cli.Context) error {
 	default:
 		fatalIf(errInvalidArgument().Trace(ctx.Command.Name), "Invalid group status name")
 	}
-	e := client.SetGroupStatus(globalContext, group, status)
-	fatalIf(probe.NewError(e).Trace(args...), "Unable set group status")
 
-	printMsg(groupMessage{
-		op:          ctx.Command.Name,
-		GroupName:   group,
-		GroupStatus: string(status),
-	})
+	dryRun := ctx.Bool("dry-run")
+
+	var failed []string
+	for _, group := range args.Tail() {
+		msg := groupMessage{
+			op:          ctx.Command.Name,
+			GroupName:   group,
+			GroupStatus: string(status),
+			DryRun:      dryRun,
+			showMembers: ctx.Bool("show-members"),
+		}
+		// Fetch the current status to report whether the group status changes.
+		gd, e := client.GetGroupDescription(globalContext, group)
+		if e != nil {
+			errorIf(probe.NewError(e).Trace(aliasedURL, group), "Unable to fetch group info")
+			failed = append(failed, group)
+			continue
+		}
+		if msg.showMembers {
+			msg.Members = gd.Members
+			msg.GroupPolicy = gd.Policy
+		}
+		msg.PreviousStatus = gd.Status
+		changed := gd.Status != string(status)
+		msg.Changed = &changed
 
+		if !dryRun {
+			e = client.SetGroupStatus(globalContext, group, status)
+			if e != nil {
+				errorIf(probe.NewError(e).Trace(aliasedURL, group), "Unable set group status")
+				failed = append(failed, group)
+				continue
+			}
+		}
+		printMsg(msg)
+	}
+
+	if len(failed) > 0 {
+		errorIf(errDummy().Trace(failed...), "Unable to %s %d of %d group(s): %s",
+			ctx.Command.Name, len(failed), len(args.Tail()), strings.Join(failed, ", "))
+		return exitStatus(globalErrorExitStatus)
+	}
 	return nil
 }
@@ -0,0 +1,176 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/pkg/console"
+)
+
+// healBucketResult holds the totals of a heal sequence run on one bucket.
+type healBucketResult struct {
+	Bucket         string `json:"bucket"`
+	Error          string `json:"error,omitempty"`
+	ObjectsScanned int64  `json:"objects_scanned"`
+	ItemsScanned   int64  `json:"items_scanned"`
+	ItemsHealed    int64  `json:"items_healed"`
+	ItemsFailed    int64  `json:"items_failed"`
+}
+
+// healBucketsMessage is the per-bucket report printed after all
+// buckets listed with --buckets were healed.
+type healBucketsMessage struct {
+	Status  string             `json:"status"`
+	Type    string             `json:"type"`
+	Buckets []healBucketResult `json:"buckets"`
+}
+
+// String colorized per-bucket heal report.
+func (m healBucketsMessage) String() string {
+	width := len("Bucket")
+	for _, r := range m.Buckets {
+		if len(r.Bucket) > width {
+			width = len(r.Bucket)
+		}
+	}
+	var b strings.Builder
+	b.WriteString(console.Colorize("HealBackgroundTitle",
+		fmt.Sprintf("%-*s  %10s  %10s  %10s", width, "Bucket", "Scanned", "Healed", "Failed")))
+	for _, r := range m.Buckets {
+		b.WriteString("\n")
+		if r.Error != "" {
+			b.WriteString(console.Colorize("DiskFailed", fmt.Sprintf("%-*s  %s", width, r.Bucket, r.Error)))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%-*s  %10d  %10d  %10d", width, r.Bucket, r.ItemsScanned, r.ItemsHealed, r.ItemsFailed))
+	}
+	return b.String()
+}
+
+// JSON jsonified per-bucket heal report.
+func (m healBucketsMessage) JSON() string {
+	jsonBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonBytes)
+}
+
+// readHealBuckets reads one bucket name per line, blank lines and
+// lines starting with '#' are ignored and duplicates are dropped.
+func readHealBuckets(r io.Reader) ([]string, error) {
+	var buckets []string
+	seen := make(map[string]struct{})
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		bucket := strings.TrimSpace(scanner.Text())
+		if bucket == "" || strings.HasPrefix(bucket, "#") {
+			continue
+		}
+		if e := s3utils.CheckValidBucketName(bucket); e != nil {
+			return nil, fmt.Errorf("line %d: %w", n, e)
+		}
+		if _, ok := seen[bucket]; ok {
+			continue
+		}
+		seen[bucket] = struct{}{}
+		buckets = append(buckets, bucket)
+	}
+	if e := scanner.Err(); e != nil {
+		return nil, e
+	}
+	if len(buckets) == 0 {
+		return nil, fmt.Errorf("no buckets found")
+	}
+	return buckets, nil
+}
+
+// healBuckets runs one heal sequence per bucket listed in the file
+// passed to --buckets and reports healed/failed totals for each.
+func healBuckets(ctx *cli.Context, adminClnt *madmin.AdminClient, alias string, opts madmin.HealOpts) error {
+	bucketsFile := ctx.String("buckets")
+	var reader io.Reader = os.Stdin
+	if bucketsFile != "-" {
+		f, e := os.Open(bucketsFile)
+		fatalIf(probe.NewError(e), "Unable to open buckets file `"+bucketsFile+"`.")
+		defer f.Close()
+		reader = f
+	}
+	buckets, e := readHealBuckets(reader)
+	fatalIf(probe.NewError(e), "Unable to read buckets file `"+bucketsFile+"`.")
+
+	forceStart := ctx.Bool("force-start")
+	report := healBucketsMessage{Status: "success", Type: "buckets"}
+	var failed bool
+	for _, bucket := range buckets {
+		aliasedURL := alias + "/" + bucket
+		result := healBucketResult{Bucket: bucket}
+
+		healStart, _, herr := adminClnt.Heal(globalContext, bucket, "", opts, "", forceStart, false)
+		if herr != nil {
+			errorIf(probe.NewError(herr).Trace(aliasedURL), "Failed to start heal sequence.")
+			result.Error = herr.Error()
+			report.Buckets = append(report.Buckets, result)
+			failed = true
+			continue
+		}
+
+		ui := uiData{
+			Bucket:                bucket,
+			Client:                adminClnt,
+			ClientToken:           healStart.ClientToken,
+			ForceStart:            forceStart,
+			HealOpts:              &opts,
+			ObjectsByOnlineDrives: make(map[int]int64),
+			HealthCols:            make(map[col]int64),
+			CurChan:               cursorAnimate(),
+		}
+		_, herr = ui.DisplayAndFollowHealStatus(aliasedURL)
+		if globalContext.Err() != nil {
+			fatalIf(probe.NewError(herr).Trace(aliasedURL), "Unable to display heal status.")
+		}
+		if herr != nil {
+			errorIf(probe.NewError(herr).Trace(aliasedURL), "Unable to display heal status.")
+			result.Error = herr.Error()
+			failed = true
+		}
+		result.ObjectsScanned = ui.ObjectsScanned
+		result.ItemsScanned = ui.ItemsScanned
+		result.ItemsHealed = ui.ItemsHealed
+		result.ItemsFailed = ui.ItemsFailed
+		report.Buckets = append(report.Buckets, result)
+	}
+
+	if failed {
+		report.Status = "error"
+	}
+	printMsg(report)
+	if failed {
+		return exitStatus(globalErrorExitStatus)
+	}
+	return nil
+}
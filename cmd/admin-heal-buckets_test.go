@@ -0,0 +1,46 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadHealBuckets(t *testing.T) {
+	testCases := []struct {
+		input   string
+		buckets []string
+		wantErr bool
+	}{
+		{"bucket1\nbucket2\n", []string{"bucket1", "bucket2"}, false},
+		{"# comment\n\n  bucket1  \r\nbucket1\n", []string{"bucket1"}, false},
+		{"bucket1\nab\n", nil, true},
+		{"# only comments\n\n", nil, true},
+	}
+	for i, tc := range testCases {
+		buckets, err := readHealBuckets(strings.NewReader(tc.input))
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, tc.wantErr, err)
+		}
+		if !reflect.DeepEqual(buckets, tc.buckets) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.buckets, buckets)
+		}
+	}
+}
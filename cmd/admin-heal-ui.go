@@ -87,6 +87,9 @@ type uiData struct {
 	// Counters for healed objects and all kinds of healed items
 	ObjectsHealed, ItemsHealed int64
 
+	// Counter for items that remain unrecoverable after heal
+	ItemsFailed int64
+
 	// Map from online drives to number of objects with that many
 	// online drives.
 	ObjectsByOnlineDrives map[int]int64
@@ -133,8 +136,12 @@ func (ui *uiData) updateStats(i madmin.HealResultItem) error {
 		_, afterCol, err = h.getObjectHCCChange()
 	}
 	if err != nil {
+		ui.ItemsFailed++
 		return err
 	}
+	if afterCol == colGrey {
+		ui.ItemsFailed++
+	}
 
 	ui.HealthCols[afterCol]++
 	return nil
@@ -291,39 +298,62 @@ func (ui *uiData) printItemsJSON(s *madmin.HealTaskStatus) (err error) {
 
 	for _, item := range s.Items {
 		h := newHRI(&item)
-		jsonBytes, err := json.MarshalIndent(makeHR(h), "", " ")
-		fatalIf(probe.NewError(err), "Unable to marshal to JSON.")
-		console.Println(string(jsonBytes))
+		printHealJSON(makeHR(h))
 	}
+	printHealJSON(ui.getProgressRecord("progress"))
 	return nil
 }
 
-func (ui *uiData) printStatsJSON(s *madmin.HealTaskStatus) {
-	var summary struct {
-		Status         string `json:"status"`
-		Error          string `json:"error,omitempty"`
-		Type           string `json:"type"`
-		ObjectsScanned int64  `json:"objects_scanned"`
-		ObjectsHealed  int64  `json:"objects_healed"`
-		ItemsScanned   int64  `json:"items_scanned"`
-		ItemsHealed    int64  `json:"items_healed"`
-		Size           int64  `json:"size"`
-		ElapsedTime    int64  `json:"duration"`
-	}
+// healProgressRecord is the JSON record periodically emitted while
+// following a heal sequence, and once more as the final summary.
+type healProgressRecord struct {
+	Status         string `json:"status"`
+	Error          string `json:"error,omitempty"`
+	Type           string `json:"type"`
+	ObjectsScanned int64  `json:"objects_scanned"`
+	ObjectsHealed  int64  `json:"objects_healed"`
+	ItemsScanned   int64  `json:"items_scanned"`
+	ItemsHealed    int64  `json:"items_healed"`
+	ItemsFailed    int64  `json:"items_failed"`
+	Size           int64  `json:"size"`
+	ElapsedTime    int64  `json:"duration"`
+	Bucket         string `json:"bucket,omitempty"`
+	Object         string `json:"object,omitempty"`
+}
 
-	summary.Status = "success"
-	summary.Type = "summary"
+func (ui *uiData) getProgressRecord(typ string) (r healProgressRecord) {
+	r.Status = "success"
+	r.Type = typ
+	r.ObjectsScanned = ui.ObjectsScanned
+	r.ObjectsHealed = ui.ObjectsHealed
+	r.ItemsScanned = ui.ItemsScanned
+	r.ItemsHealed = ui.ItemsHealed
+	r.ItemsFailed = ui.ItemsFailed
+	r.Size = ui.BytesScanned
+	r.ElapsedTime = int64(ui.HealDuration.Round(time.Second).Seconds())
+	if ui.LastItem != nil {
+		r.Bucket = ui.LastItem.Bucket
+		r.Object = ui.LastItem.Object
+	}
+	return r
+}
 
-	summary.ObjectsScanned = ui.ObjectsScanned
-	summary.ObjectsHealed = ui.ObjectsHealed
-	summary.ItemsScanned = ui.ItemsScanned
-	summary.ItemsHealed = ui.ItemsHealed
-	summary.Size = ui.BytesScanned
-	summary.ElapsedTime = int64(ui.HealDuration.Round(time.Second).Seconds())
+// printHealJSON prints a heal record, on a single line when the
+// output is not a terminal so the stream can be consumed as JSON lines.
+func printHealJSON(v interface{}) {
+	var jsonBytes []byte
+	var e error
+	if globalJSONLine {
+		jsonBytes, e = json.Marshal(v)
+	} else {
+		jsonBytes, e = json.MarshalIndent(v, "", " ")
+	}
+	fatalIf(probe.NewError(e), "Unable to marshal to JSON.")
+	console.Println(string(jsonBytes))
+}
 
-	jBytes, err := json.MarshalIndent(summary, "", " ")
-	fatalIf(probe.NewError(err), "Unable to marshal to JSON.")
-	console.Println(string(jBytes))
+func (ui *uiData) printStatsJSON(s *madmin.HealTaskStatus) {
+	printHealJSON(ui.getProgressRecord("summary"))
 }
 
 func (ui *uiData) updateUI(s *madmin.HealTaskStatus) (err error) {
@@ -375,6 +405,9 @@ func (ui *uiData) UpdateDisplay(s *madmin.HealTaskStatus) (err error) {
 	for _, i := range s.Items {
 		ui.updateStats(i)
 	}
+	if len(s.Items) > 0 {
+		ui.LastItem = newHRI(&s.Items[len(s.Items)-1])
+	}
 
 	// Update display
 	switch {
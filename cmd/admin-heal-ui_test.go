@@ -0,0 +1,73 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestHealProgressRecord(t *testing.T) {
+	drives := func(states ...string) (d []madmin.HealDriveInfo) {
+		for _, s := range states {
+			d = append(d, madmin.HealDriveInfo{State: s})
+		}
+		return d
+	}
+	ok, offline := madmin.DriveStateOk, madmin.DriveStateOffline
+
+	healed := madmin.HealResultItem{
+		Type: madmin.HealItemObject, Bucket: "bucket", Object: "healed",
+		ParityBlocks: 2, DataBlocks: 2, ObjectSize: 10,
+	}
+	healed.Before.Drives = drives(ok, ok, ok, offline)
+	healed.After.Drives = drives(ok, ok, ok, ok)
+
+	lost := madmin.HealResultItem{
+		Type: madmin.HealItemObject, Bucket: "bucket", Object: "lost",
+		ParityBlocks: 2, DataBlocks: 2, ObjectSize: 5,
+	}
+	lost.Before.Drives = drives(ok, offline, offline, offline)
+	lost.After.Drives = drives(ok, offline, offline, offline)
+
+	defer func(json bool) { globalJSON = json }(globalJSON)
+	globalJSON = true
+
+	ui := uiData{
+		ObjectsByOnlineDrives: make(map[int]int64),
+		HealthCols:            make(map[col]int64),
+	}
+	if err := ui.UpdateDisplay(&madmin.HealTaskStatus{Items: []madmin.HealResultItem{healed, lost}}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := ui.getProgressRecord("progress")
+	if r.Type != "progress" || r.Status != "success" {
+		t.Errorf("unexpected record header %q/%q", r.Type, r.Status)
+	}
+	if r.ObjectsScanned != 2 || r.ObjectsHealed != 1 || r.ItemsFailed != 1 {
+		t.Errorf("unexpected counts scanned=%d healed=%d failed=%d", r.ObjectsScanned, r.ObjectsHealed, r.ItemsFailed)
+	}
+	if r.Size != 15 {
+		t.Errorf("expected size 15, got %d", r.Size)
+	}
+	if r.Bucket != "bucket" || r.Object != "lost" {
+		t.Errorf("expected current item bucket/lost, got %s/%s", r.Bucket, r.Object)
+	}
+}
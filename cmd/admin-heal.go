@@ -78,6 +78,10 @@ var adminHealFlags = []cli.Flag{
 		Name:  "verbose, v",
 		Usage: "show verbose information",
 	},
+	cli.StringFlag{
+		Name:  "buckets",
+		Usage: "heal only the buckets listed in a file, one per line ('-' reads from stdin)",
+	},
 }
 
 var adminHealCmd = cli.Command{
@@ -100,6 +104,12 @@ FLAGS:
 EXAMPLES:
   1. Monitor healing status on a running server at alias 'myminio':
      {{.Prompt}} {{.HelpName}} myminio/
+
+  2. Follow a recursive heal as JSON lines, with periodic progress records and a final summary:
+     {{.Prompt}} {{.HelpName}} --recursive --json myminio/ | tee heal.log
+
+  3. Scan only the buckets listed in 'buckets.txt' recursively, without healing them:
+     {{.Prompt}} {{.HelpName}} --buckets buckets.txt --recursive --dry-run myminio
 `,
 }
 
@@ -114,6 +124,15 @@ func checkAdminHealSyntax(ctx *cli.Context) {
 	if scanArg != scanNormalMode && scanArg != scanDeepMode {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
+
+	if ctx.IsSet("buckets") {
+		if ctx.Bool("force-stop") {
+			fatalIf(errInvalidArgument().Trace(ctx.Args()...), "--buckets cannot be used with --force-stop.")
+		}
+		if _, bucket := url2Alias(ctx.Args().Get(0)); strings.Trim(bucket, "/") != "" {
+			fatalIf(errInvalidArgument().Trace(ctx.Args()...), "--buckets expects an alias without a bucket.")
+		}
+	}
 }
 
 // stopHealMessage is container for stop heal success and failure messages.
@@ -663,6 +682,18 @@ func mainAdminHeal(ctx *cli.Context) error {
 		return nil
 	}
 
+	opts := madmin.HealOpts{
+		ScanMode:  transformScanArg(ctx.String("scan")),
+		Remove:    ctx.Bool("remove"),
+		Recursive: ctx.Bool("recursive"),
+		DryRun:    ctx.Bool("dry-run"),
+		Recreate:  ctx.Bool("rewrite"),
+	}
+
+	if ctx.IsSet("buckets") {
+		return healBuckets(ctx, adminClnt, splits[0], opts)
+	}
+
 	// Return the background heal status when the user
 	// doesn't pass a bucket or --recursive flag.
 	if bucket == "" && !ctx.Bool("recursive") {
@@ -690,14 +721,6 @@ func mainAdminHeal(ctx *cli.Context) error {
 		}
 	}
 
-	opts := madmin.HealOpts{
-		ScanMode:  transformScanArg(ctx.String("scan")),
-		Remove:    ctx.Bool("remove"),
-		Recursive: ctx.Bool("recursive"),
-		DryRun:    ctx.Bool("dry-run"),
-		Recreate:  ctx.Bool("rewrite"),
-	}
-
 	forceStart := ctx.Bool("force-start")
 	forceStop := ctx.Bool("force-stop")
 	if forceStop {
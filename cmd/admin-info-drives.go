@@ -0,0 +1,111 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// driveInfo is a flattened per-drive record of the server info.
+type driveInfo struct {
+	Server         string `json:"server"`
+	Endpoint       string `json:"endpoint"`
+	Path           string `json:"path,omitempty"`
+	State          string `json:"state"`
+	Healing        bool   `json:"healing"`
+	UUID           string `json:"uuid,omitempty"`
+	TotalSpace     uint64 `json:"totalSpace"`
+	UsedSpace      uint64 `json:"usedSpace"`
+	AvailableSpace uint64 `json:"availableSpace"`
+	PoolIndex      int    `json:"poolIndex"`
+	SetIndex       int    `json:"setIndex"`
+	DiskIndex      int    `json:"diskIndex"`
+}
+
+// driveInfoMessage lists the drives of all servers.
+type driveInfoMessage struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error,omitempty"`
+	Drives []driveInfo `json:"drives"`
+}
+
+func (d driveInfoMessage) String() string {
+	if d.Error != "" {
+		return console.Colorize("InfoFail", d.Error)
+	}
+	width := len("Endpoint")
+	for _, drive := range d.Drives {
+		if len(drive.Endpoint) > width {
+			width = len(drive.Endpoint)
+		}
+	}
+	lines := []string{fmt.Sprintf("%-*s  %-12s  %10s  %10s  %s", width, "Endpoint", "State", "Used", "Total", "Healing")}
+	for _, drive := range d.Drives {
+		theme := "Info"
+		if drive.State != madmin.DriveStateOk {
+			theme = "InfoFail"
+		} else if drive.Healing {
+			theme = "InfoWarning"
+		}
+		healing := ""
+		if drive.Healing {
+			healing = "yes"
+		}
+		lines = append(lines, console.Colorize(theme, fmt.Sprintf("%-*s  %-12s  %10s  %10s  %s", width, drive.Endpoint, drive.State,
+			humanize.IBytes(drive.UsedSpace), humanize.IBytes(drive.TotalSpace), healing)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d driveInfoMessage) JSON() string {
+	statusJSONBytes, e := json.MarshalIndent(d, "", "    ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(statusJSONBytes)
+}
+
+// flattenDrives returns one record per drive of every server.
+func flattenDrives(info madmin.InfoMessage) []driveInfo {
+	drives := []driveInfo{}
+	for _, srv := range info.Servers {
+		for _, disk := range srv.Disks {
+			drives = append(drives, driveInfo{
+				Server:         srv.Endpoint,
+				Endpoint:       disk.Endpoint,
+				Path:           disk.DrivePath,
+				State:          disk.State,
+				Healing:        disk.Healing,
+				UUID:           disk.UUID,
+				TotalSpace:     disk.TotalSpace,
+				UsedSpace:      disk.UsedSpace,
+				AvailableSpace: disk.AvailableSpace,
+				PoolIndex:      disk.PoolIndex,
+				SetIndex:       disk.SetIndex,
+				DiskIndex:      disk.DiskIndex,
+			})
+		}
+	}
+	return drives
+}
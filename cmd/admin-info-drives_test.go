@@ -0,0 +1,56 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestFlattenDrives(t *testing.T) {
+	info := madmin.InfoMessage{
+		Servers: []madmin.ServerProperties{
+			{
+				Endpoint: "node1:9000",
+				Disks: []madmin.Disk{
+					{Endpoint: "http://node1:9000/data1", DrivePath: "/data1", State: madmin.DriveStateOk, TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60},
+					{Endpoint: "http://node1:9000/data2", DrivePath: "/data2", State: madmin.DriveStateOffline, PoolIndex: 0, SetIndex: 0, DiskIndex: 1},
+				},
+			},
+			{
+				Endpoint: "node2:9000",
+				Disks: []madmin.Disk{
+					{Endpoint: "http://node2:9000/data1", DrivePath: "/data1", State: madmin.DriveStateOk, Healing: true, SetIndex: 1},
+				},
+			},
+		},
+	}
+	expected := []driveInfo{
+		{Server: "node1:9000", Endpoint: "http://node1:9000/data1", Path: "/data1", State: madmin.DriveStateOk, TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60},
+		{Server: "node1:9000", Endpoint: "http://node1:9000/data2", Path: "/data2", State: madmin.DriveStateOffline, DiskIndex: 1},
+		{Server: "node2:9000", Endpoint: "http://node2:9000/data1", Path: "/data1", State: madmin.DriveStateOk, Healing: true, SetIndex: 1},
+	}
+	if drives := flattenDrives(info); !reflect.DeepEqual(drives, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, drives)
+	}
+	if drives := flattenDrives(madmin.InfoMessage{}); drives == nil || len(drives) != 0 {
+		t.Fatalf("expected an empty, non-nil list, got %#v", drives)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// forecastHorizonDays is how far ahead the capacity is forecast, a
+// threshold date further out is reported as not reached.
+const forecastHorizonDays = 10 * 365
+
+// capacityForecastMessage is the projected date the cluster drives
+// reach the capacity threshold, computed from two usage samples.
+type capacityForecastMessage struct {
+	Status           string     `json:"status"`
+	UsedSpace        uint64     `json:"usedSpace"`
+	TotalSpace       uint64     `json:"totalSpace"`
+	UsedPercent      float64    `json:"usedPercent"`
+	Threshold        float64    `json:"threshold"`
+	Interval         string     `json:"interval"`
+	DailyGrowthBytes int64      `json:"dailyGrowthBytes"`
+	ThresholdDate    *time.Time `json:"thresholdDate,omitempty"`
+	BeyondHorizon    bool       `json:"beyondHorizon,omitempty"`
+}
+
+func (c capacityForecastMessage) String() string {
+	msg := fmt.Sprintf("Used: %s of %s (%.1f%%)\n", humanize.IBytes(c.UsedSpace), humanize.IBytes(c.TotalSpace), c.UsedPercent)
+	growth := humanize.IBytes(uint64(c.DailyGrowthBytes))
+	if c.DailyGrowthBytes < 0 {
+		growth = "-" + humanize.IBytes(uint64(-c.DailyGrowthBytes))
+	}
+	msg += fmt.Sprintf("Growth: %s/day, measured over %s\n", growth, c.Interval)
+	switch {
+	case c.UsedPercent >= c.Threshold:
+		msg += console.Colorize("InfoFail", fmt.Sprintf("Usage is already above %.0f%%", c.Threshold))
+	case c.BeyondHorizon:
+		msg += console.Colorize("Info", fmt.Sprintf("%.0f%% will not be reached within %d years", c.Threshold, forecastHorizonDays/365))
+	case c.ThresholdDate == nil:
+		msg += console.Colorize("Info", fmt.Sprintf("Usage is not growing, %.0f%% will not be reached", c.Threshold))
+	default:
+		msg += console.Colorize("InfoWarning", fmt.Sprintf("%.0f%% will be reached on %s (in %s)", c.Threshold,
+			c.ThresholdDate.Format("2006-01-02"), timeDurationToHumanizedDuration(c.ThresholdDate.Sub(UTCNow())).StringShort()))
+	}
+	return msg
+}
+
+func (c capacityForecastMessage) JSON() string {
+	statusJSONBytes, e := json.MarshalIndent(c, "", "    ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(statusJSONBytes)
+}
+
+// drivesCapacity returns the used and total space of all drives.
+func drivesCapacity(info madmin.InfoMessage) (used, total uint64) {
+	for _, srv := range info.Servers {
+		for _, disk := range srv.Disks {
+			used += disk.UsedSpace
+			total += disk.TotalSpace
+		}
+	}
+	return used, total
+}
+
+// forecastCapacity projects when usage reaches threshold percent of
+// total, growing linearly from usedBefore to usedAfter over elapsed.
+func forecastCapacity(usedBefore, usedAfter, total uint64, elapsed time.Duration, threshold float64, now time.Time) capacityForecastMessage {
+	c := capacityForecastMessage{
+		UsedSpace:  usedAfter,
+		TotalSpace: total,
+		Threshold:  threshold,
+		Interval:   elapsed.Round(time.Second).String(),
+	}
+	if total == 0 || elapsed <= 0 {
+		return c
+	}
+	c.UsedPercent = float64(usedAfter) * 100 / float64(total)
+
+	perDay := (float64(usedAfter) - float64(usedBefore)) * float64(24*time.Hour) / float64(elapsed)
+	c.DailyGrowthBytes = int64(perDay)
+	if c.UsedPercent >= threshold {
+		c.ThresholdDate = &now
+		return c
+	}
+	if perDay <= 0 {
+		return c
+	}
+	remaining := float64(total)*threshold/100 - float64(usedAfter)
+	days := remaining / perDay
+	if days > forecastHorizonDays {
+		// Also keeps the duration below from overflowing.
+		c.BeyondHorizon = true
+		return c
+	}
+	date := now.Add(time.Duration(days * float64(24*time.Hour)))
+	c.ThresholdDate = &date
+	return c
+}
+
+// adminInfoForecast samples the drives capacity twice, interval apart,
+// and prints the capacity forecast.
+func adminInfoForecast(client *madmin.AdminClient, interval time.Duration, threshold float64) {
+	info, e := client.ServerInfo(globalContext)
+	fatalIf(probe.NewError(e), "Unable to get server info.")
+	usedBefore, _ := drivesCapacity(info)
+	start := UTCNow()
+
+	select {
+	case <-globalContext.Done():
+		return
+	case <-time.After(interval):
+	}
+
+	info, e = client.ServerInfo(globalContext)
+	fatalIf(probe.NewError(e), "Unable to get server info.")
+	usedAfter, total := drivesCapacity(info)
+	now := UTCNow()
+
+	msg := forecastCapacity(usedBefore, usedAfter, total, now.Sub(start), threshold, now)
+	msg.Status = "success"
+	printMsg(msg)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestForecastCapacity(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)
+	const gib = 1 << 30
+
+	// 10 GiB/day growth, 490 GiB left before reaching 90% of 1000 GiB.
+	c := forecastCapacity(400*gib, 410*gib, 1000*gib, 24*time.Hour, 90, now)
+	if c.DailyGrowthBytes != 10*gib {
+		t.Errorf("expected daily growth %d, got %d", int64(10*gib), c.DailyGrowthBytes)
+	}
+	if c.ThresholdDate == nil {
+		t.Fatal("expected a threshold date")
+	}
+	if expected := now.Add(49 * 24 * time.Hour); !c.ThresholdDate.Equal(expected) {
+		t.Errorf("expected the threshold on %s, got %s", expected, c.ThresholdDate)
+	}
+
+	// Shrinking usage never reaches the threshold.
+	c = forecastCapacity(400*gib, 300*gib, 1000*gib, time.Hour, 90, now)
+	if c.ThresholdDate != nil || c.DailyGrowthBytes >= 0 {
+		t.Errorf("expected no threshold date and negative growth, got %v %d", c.ThresholdDate, c.DailyGrowthBytes)
+	}
+
+	// Already above the threshold.
+	c = forecastCapacity(950*gib, 950*gib, 1000*gib, time.Hour, 90, now)
+	if c.ThresholdDate == nil || !c.ThresholdDate.Equal(now) || c.UsedPercent != 95 {
+		t.Errorf("expected threshold reached now at 95%%, got %v %.1f", c.ThresholdDate, c.UsedPercent)
+	}
+
+	// A growth of a byte per day is far beyond the horizon.
+	c = forecastCapacity(400*gib, 400*gib+1, 1000*gib, 24*time.Hour, 90, now)
+	if c.ThresholdDate != nil || !c.BeyondHorizon {
+		t.Errorf("expected no threshold date beyond the horizon, got %v %v", c.ThresholdDate, c.BeyondHorizon)
+	}
+}
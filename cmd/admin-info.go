@@ -36,18 +36,39 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var adminInfoFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "drives",
+		Usage: "list the state and capacity of every drive",
+	},
+	cli.BoolFlag{
+		Name:  "forecast",
+		Usage: "project when the drives reach the capacity threshold from two usage samples",
+	},
+	cli.DurationFlag{
+		Name:  "interval",
+		Usage: "time between the two usage samples of --forecast",
+		Value: time.Minute,
+	},
+	cli.IntFlag{
+		Name:  "threshold",
+		Usage: "capacity percentage used by --forecast",
+		Value: 90,
+	},
+}
+
 var adminInfoCmd = cli.Command{
 	Name:         "info",
 	Usage:        "display MinIO server information",
 	Action:       mainAdminInfo,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminInfoFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -55,6 +76,12 @@ FLAGS:
 EXAMPLES:
   1. Get server information of the 'play' MinIO server.
      {{.Prompt}} {{.HelpName}} play/
+
+  2. List every drive of the 'play' MinIO server as JSON, one record per drive.
+     {{.Prompt}} {{.HelpName}} --drives --json play/
+
+  3. Project when the drives of the 'play' MinIO server will be 80% full, sampling usage an hour apart.
+     {{.Prompt}} {{.HelpName}} --forecast --interval 1h --threshold 80 play/
 `,
 }
 
@@ -324,6 +351,14 @@ func checkAdminInfoSyntax(ctx *cli.Context) {
 	if len(ctx.Args()) == 0 || len(ctx.Args()) > 1 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
+	if ctx.Bool("forecast") {
+		if ctx.Duration("interval") <= 0 {
+			fatalIf(errInvalidArgument().Trace(ctx.String("interval")), "--interval must be greater than zero.")
+		}
+		if threshold := ctx.Int("threshold"); threshold <= 0 || threshold > 100 {
+			fatalIf(errInvalidArgument().Trace(ctx.String("threshold")), "--threshold must be between 1 and 100.")
+		}
+	}
 }
 
 func mainAdminInfo(ctx *cli.Context) error {
@@ -337,6 +372,31 @@ func mainAdminInfo(ctx *cli.Context) error {
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
+	if ctx.Bool("drives") || ctx.Bool("forecast") {
+		console.SetColor("Info", color.New(color.FgGreen, color.Bold))
+		console.SetColor("InfoFail", color.New(color.FgRed, color.Bold))
+		console.SetColor("InfoWarning", color.New(color.FgYellow, color.Bold))
+	}
+
+	if ctx.Bool("forecast") {
+		adminInfoForecast(client, ctx.Duration("interval"), float64(ctx.Int("threshold")))
+		return nil
+	}
+
+	if ctx.Bool("drives") {
+		var drivesInfo driveInfoMessage
+		admInfo, e := client.ServerInfo(globalContext)
+		if e != nil {
+			drivesInfo.Status = "error"
+			drivesInfo.Error = e.Error()
+		} else {
+			drivesInfo.Status = "success"
+			drivesInfo.Drives = flattenDrives(admInfo)
+		}
+		printMsg(drivesInfo)
+		return nil
+	}
+
 	var clusterInfo clusterStruct
 	// Fetch info of all servers (cluster or single server)
 	admInfo, e := client.ServerInfo(globalContext)
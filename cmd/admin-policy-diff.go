@@ -0,0 +1,303 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/minio/cli"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var adminPolicyDiffCmd = cli.Command{
+	Name:         "diff",
+	Usage:        "compare IAM policies between two MinIO deployments",
+	Action:       mainAdminPolicyDiff,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        globalFlags,
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} SOURCE TARGET
+
+  Policies only on SOURCE are prefixed with '<', policies only on TARGET with '>'
+  and policies present on both with a different document with '!'.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Compare the policies defined on 'staging' and 'prod'.
+     {{.Prompt}} {{.HelpName}} staging prod
+`,
+}
+
+// policyChange is a single difference between two policy documents.
+type policyChange struct {
+	Path   string      `json:"path"`
+	Source interface{} `json:"source,omitempty"`
+	Target interface{} `json:"target,omitempty"`
+}
+
+// policyDiff lists the differences of a policy present on both sides.
+type policyDiff struct {
+	Policy  string         `json:"policy"`
+	Changes []policyChange `json:"changes"`
+}
+
+// policyDiffMessage container for policy diff message structure
+type policyDiffMessage struct {
+	Status       string       `json:"status"`
+	Source       string       `json:"source"`
+	Target       string       `json:"target"`
+	OnlyInSource []string     `json:"onlyInSource,omitempty"`
+	OnlyInTarget []string     `json:"onlyInTarget,omitempty"`
+	Differ       []policyDiff `json:"differ,omitempty"`
+}
+
+func (m policyDiffMessage) String() string {
+	var lines []string
+	for _, policy := range m.OnlyInSource {
+		lines = append(lines, console.Colorize("DiffOnlyInFirst", "< "+policy))
+	}
+	for _, policy := range m.OnlyInTarget {
+		lines = append(lines, console.Colorize("DiffOnlyInSecond", "> "+policy))
+	}
+	for _, d := range m.Differ {
+		lines = append(lines, console.Colorize("DiffPolicy", "! "+d.Policy))
+		for _, c := range d.Changes {
+			if c.Source != nil {
+				lines = append(lines, console.Colorize("DiffOnlyInFirst", fmt.Sprintf("    - %s: %s", c.Path, policyJSONString(c.Source))))
+			}
+			if c.Target != nil {
+				lines = append(lines, console.Colorize("DiffOnlyInSecond", fmt.Sprintf("    + %s: %s", c.Path, policyJSONString(c.Target))))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return console.Colorize("DiffInNone", "Policies on `"+m.Source+"` and `"+m.Target+"` are identical.")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m policyDiffMessage) JSON() string {
+	jsonMessageBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
+func policyJSONString(v interface{}) string {
+	buf, e := json.Marshal(v)
+	if e != nil {
+		return fmt.Sprint(v)
+	}
+	return string(buf)
+}
+
+// Policy elements which accept either a single string or a list of
+// strings, a single string is normalized into a list of one.
+var policyMultiValueKeys = map[string]bool{
+	"Action":       true,
+	"NotAction":    true,
+	"Resource":     true,
+	"NotResource":  true,
+	"Principal":    true,
+	"NotPrincipal": true,
+	"Condition":    true,
+}
+
+// normalizePolicy returns v with lists sorted and single-valued
+// elements turned into lists, so equivalent documents compare equal.
+func normalizePolicy(v interface{}, multiValue bool) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, elem := range t {
+			t[k] = normalizePolicy(elem, multiValue || policyMultiValueKeys[k])
+		}
+		return t
+	case []interface{}:
+		for i, elem := range t {
+			t[i] = normalizePolicy(elem, false)
+		}
+		sort.Slice(t, func(i, j int) bool {
+			return policyJSONString(t[i]) < policyJSONString(t[j])
+		})
+		return t
+	case string:
+		if multiValue {
+			return []interface{}{t}
+		}
+	}
+	return v
+}
+
+// diffPolicy returns the differences between two normalized policy
+// documents. Lists are compared as sets.
+func diffPolicy(path string, src, tgt interface{}) (changes []policyChange) {
+	srcMap, srcIsMap := src.(map[string]interface{})
+	tgtMap, tgtIsMap := tgt.(map[string]interface{})
+	if srcIsMap && tgtIsMap {
+		keys := make(map[string]struct{}, len(srcMap)+len(tgtMap))
+		for k := range srcMap {
+			keys[k] = struct{}{}
+		}
+		for k := range tgtMap {
+			keys[k] = struct{}{}
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		for _, k := range sortedKeys {
+			keyPath := k
+			if path != "" {
+				keyPath = path + "." + k
+			}
+			changes = append(changes, diffPolicy(keyPath, srcMap[k], tgtMap[k])...)
+		}
+		return changes
+	}
+
+	srcList, srcIsList := src.([]interface{})
+	tgtList, tgtIsList := tgt.([]interface{})
+	if srcIsList && tgtIsList {
+		tgtCount := make(map[string]int, len(tgtList))
+		for _, elem := range tgtList {
+			tgtCount[policyJSONString(elem)]++
+		}
+		var removed, added []interface{}
+		for _, elem := range srcList {
+			key := policyJSONString(elem)
+			if tgtCount[key] > 0 {
+				tgtCount[key]--
+				continue
+			}
+			removed = append(removed, elem)
+		}
+		for _, elem := range tgtList {
+			key := policyJSONString(elem)
+			if tgtCount[key] > 0 {
+				tgtCount[key]--
+				added = append(added, elem)
+			}
+		}
+		// A single modified element, e.g. a statement with one
+		// more action, is easier to read as a nested diff.
+		if len(removed) == 1 && len(added) == 1 {
+			return diffPolicy(path+"[]", removed[0], added[0])
+		}
+		for _, elem := range removed {
+			changes = append(changes, policyChange{Path: path + "[]", Source: elem})
+		}
+		for _, elem := range added {
+			changes = append(changes, policyChange{Path: path + "[]", Target: elem})
+		}
+		return changes
+	}
+
+	if policyJSONString(src) != policyJSONString(tgt) {
+		changes = append(changes, policyChange{Path: path, Source: src, Target: tgt})
+	}
+	return changes
+}
+
+// diffPolicies compares the policy documents keyed by policy name.
+func diffPolicies(srcPolicies, tgtPolicies map[string]json.RawMessage) (m policyDiffMessage, e error) {
+	parse := func(name string, buf json.RawMessage) (interface{}, error) {
+		var v interface{}
+		if e := json.Unmarshal(buf, &v); e != nil {
+			return nil, fmt.Errorf("unable to parse policy `%s`: %w", name, e)
+		}
+		return normalizePolicy(v, false), nil
+	}
+
+	for name, srcBuf := range srcPolicies {
+		tgtBuf, ok := tgtPolicies[name]
+		if !ok {
+			m.OnlyInSource = append(m.OnlyInSource, name)
+			continue
+		}
+		src, e := parse(name, srcBuf)
+		if e != nil {
+			return m, e
+		}
+		tgt, e := parse(name, tgtBuf)
+		if e != nil {
+			return m, e
+		}
+		if changes := diffPolicy("", src, tgt); len(changes) > 0 {
+			m.Differ = append(m.Differ, policyDiff{Policy: name, Changes: changes})
+		}
+	}
+	for name := range tgtPolicies {
+		if _, ok := srcPolicies[name]; !ok {
+			m.OnlyInTarget = append(m.OnlyInTarget, name)
+		}
+	}
+
+	sort.Strings(m.OnlyInSource)
+	sort.Strings(m.OnlyInTarget)
+	sort.Slice(m.Differ, func(i, j int) bool { return m.Differ[i].Policy < m.Differ[j].Policy })
+	return m, nil
+}
+
+func checkAdminPolicyDiffSyntax(ctx *cli.Context) {
+	if len(ctx.Args()) != 2 {
+		showCommandHelpAndExit(ctx, 1) // last argument is exit code
+	}
+}
+
+// mainAdminPolicyDiff is the handler for "mc admin policy diff" command.
+func mainAdminPolicyDiff(ctx *cli.Context) error {
+	checkAdminPolicyDiffSyntax(ctx)
+
+	console.SetColor("DiffOnlyInFirst", color.New(color.FgRed))
+	console.SetColor("DiffOnlyInSecond", color.New(color.FgGreen))
+	console.SetColor("DiffPolicy", color.New(color.FgYellow, color.Bold))
+	console.SetColor("DiffInNone", color.New(color.FgGreen))
+
+	args := ctx.Args()
+	source, target := args.Get(0), args.Get(1)
+
+	listPolicies := func(aliasedURL string) map[string]json.RawMessage {
+		client, err := newAdminClient(aliasedURL)
+		fatalIf(err, "Unable to initialize admin connection.")
+
+		policies, e := client.ListCannedPolicies(globalContext)
+		fatalIf(probe.NewError(e).Trace(aliasedURL), "Unable to list policies on `"+aliasedURL+"`.")
+		return policies
+	}
+
+	msg, e := diffPolicies(listPolicies(source), listPolicies(target))
+	fatalIf(probe.NewError(e).Trace(args...), "Unable to compare policies.")
+
+	msg.Status = "success"
+	msg.Source = source
+	msg.Target = target
+	printMsg(msg)
+	return nil
+}
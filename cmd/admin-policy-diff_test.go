@@ -0,0 +1,55 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	src := map[string]json.RawMessage{
+		"readonly": json.RawMessage(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::*"]}]}`),
+		"reorder":  json.RawMessage(`{"Statement":[{"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow","Resource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:*","Resource":"arn:aws:s3:::b/*"}],"Version":"2012-10-17"}`),
+		"staging":  json.RawMessage(`{"Version":"2012-10-17","Statement":[]}`),
+	}
+	tgt := map[string]json.RawMessage{
+		"readonly": json.RawMessage(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::*"}]}`),
+		"reorder":  json.RawMessage(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:*"],"Resource":["arn:aws:s3:::b/*"]},{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::a/*"}]}`),
+		"prod":     json.RawMessage(`{"Version":"2012-10-17","Statement":[]}`),
+	}
+
+	m, err := diffPolicies(src, tgt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.OnlyInSource, []string{"staging"}) {
+		t.Errorf("expected only in source [staging], got %v", m.OnlyInSource)
+	}
+	if !reflect.DeepEqual(m.OnlyInTarget, []string{"prod"}) {
+		t.Errorf("expected only in target [prod], got %v", m.OnlyInTarget)
+	}
+	if len(m.Differ) != 1 || m.Differ[0].Policy != "readonly" {
+		t.Fatalf("expected only readonly to differ, got %+v", m.Differ)
+	}
+	expected := []policyChange{{Path: "Statement[].Action[]", Target: "s3:ListBucket"}}
+	if !reflect.DeepEqual(m.Differ[0].Changes, expected) {
+		t.Errorf("expected changes %+v, got %+v", expected, m.Differ[0].Changes)
+	}
+}
@@ -24,22 +24,35 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
+	"github.com/minio/madmin-go"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/pkg/console"
 )
 
+var adminPolicyUpdateFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name:  "users",
+		Usage: "comma separated list of users to attach the policy to",
+	},
+	cli.StringSliceFlag{
+		Name:  "groups",
+		Usage: "comma separated list of groups to attach the policy to",
+	},
+}
+
 var adminPolicyUpdateCmd = cli.Command{
 	Name:         "update",
+	Aliases:      []string{"attach"},
 	Usage:        "attach a new IAM policy to user or group",
 	Action:       mainAdminPolicyUpdate,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminPolicyUpdateFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET POLICYNAME [ user=username1 | group=groupname1 ]
+  {{.HelpName}} TARGET POLICYNAME [ user=username1 | group=groupname1 ] [--users USERS] [--groups GROUPS]
 
 POLICYNAME:
   Name of the policy on the MinIO server.
@@ -53,15 +66,65 @@ EXAMPLES:
 
   2. Add the "diagnostics" policy for group "auditors".
      {{.Prompt}} {{.HelpName}} myminio diagnostics group=auditors
+
+  3. Add the "readonly" policy for users "u1", "u2" and groups "g1", "g2".
+     {{.Prompt}} {{.HelpName}} myminio readonly --users u1,u2 --groups g1,g2
 `,
 }
 
 func checkAdminPolicyUpdateSyntax(ctx *cli.Context) {
-	if len(ctx.Args()) != 3 {
+	switch len(ctx.Args()) {
+	case 2:
+		if !ctx.IsSet("users") && !ctx.IsSet("groups") {
+			showCommandHelpAndExit(ctx, 1) // last argument is exit code
+		}
+	case 3:
+	default:
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
 }
 
+// policyEntity is a user or group a policy is attached to.
+type policyEntity struct {
+	name    string
+	isGroup bool
+}
+
+func (p policyEntity) String() string {
+	if p.isGroup {
+		return "group=" + p.name
+	}
+	return "user=" + p.name
+}
+
+// parsePolicyEntities collects the users and groups passed either as
+// the user=/group= argument or with --users and --groups.
+func parsePolicyEntities(entityArg string, users, groups []string) ([]policyEntity, error) {
+	var entities []policyEntity
+	if entityArg != "" {
+		userOrGroup, isGroup, e := parseEntityArg(entityArg)
+		if e != nil {
+			return nil, e
+		}
+		entities = append(entities, policyEntity{userOrGroup, isGroup})
+	}
+	add := func(values []string, isGroup bool) {
+		for _, value := range values {
+			for _, name := range strings.Split(value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					entities = append(entities, policyEntity{name, isGroup})
+				}
+			}
+		}
+	}
+	add(users, false)
+	add(groups, true)
+	if len(entities) == 0 {
+		return nil, errors.New("no users or groups provided")
+	}
+	return entities, nil
+}
+
 func updateCannedPolicies(existingPolicies, policiesToAdd string) (string, error) {
 	policiesToAdd = strings.TrimSpace(policiesToAdd)
 	if policiesToAdd == "" {
@@ -103,40 +166,55 @@ func mainAdminPolicyUpdate(ctx *cli.Context) error {
 	policiesToAdd := args.Get(1)
 	entityArg := args.Get(2)
 
-	userOrGroup, isGroup, e1 := parseEntityArg(entityArg)
-	fatalIf(probe.NewError(e1).Trace(args...), "Bad last argument")
+	entities, e := parsePolicyEntities(entityArg, ctx.StringSlice("users"), ctx.StringSlice("groups"))
+	fatalIf(probe.NewError(e).Trace(args...), "Unable to parse users and groups")
 
 	// Create a new MinIO Admin Client
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
-	var existingPolicies string
+	var failed bool
+	for _, entity := range entities {
+		e = attachCannedPolicies(client, policiesToAdd, entity)
+		if e != nil {
+			errorIf(probe.NewError(e).Trace(aliasedURL, entity.String()), "Unable to attach the policy to `"+entity.String()+"`.")
+			failed = true
+			continue
+		}
+		printMsg(userPolicyMessage{
+			op:          ctx.Command.Name,
+			Policy:      policiesToAdd,
+			UserOrGroup: entity.name,
+			IsGroup:     entity.isGroup,
+		})
+	}
+	if failed {
+		return exitStatus(globalErrorExitStatus)
+	}
+	return nil
+}
 
-	if !isGroup {
-		userInfo, e := client.GetUserInfo(globalContext, userOrGroup)
-		fatalIf(probe.NewError(e).Trace(args...), "Unable to get user policy info")
+// attachCannedPolicies adds the policies to the ones already set on
+// the user or group.
+func attachCannedPolicies(client *madmin.AdminClient, policiesToAdd string, entity policyEntity) error {
+	var existingPolicies string
+	if !entity.isGroup {
+		userInfo, e := client.GetUserInfo(globalContext, entity.name)
+		if e != nil {
+			return e
+		}
 		existingPolicies = userInfo.PolicyName
 	} else {
-		groupInfo, e := client.GetGroupDescription(globalContext, userOrGroup)
-		fatalIf(probe.NewError(e).Trace(args...), "Unable to get group policy info")
+		groupInfo, e := client.GetGroupDescription(globalContext, entity.name)
+		if e != nil {
+			return e
+		}
 		existingPolicies = groupInfo.Policy
 	}
 
 	updatedPolicies, e := updateCannedPolicies(existingPolicies, policiesToAdd)
 	if e != nil {
-		fatalIf(probe.NewError(e).Trace(args...), "Unable to update the policy")
+		return e
 	}
-
-	e = client.SetPolicy(globalContext, updatedPolicies, userOrGroup, isGroup)
-	if e == nil {
-		printMsg(userPolicyMessage{
-			op:          ctx.Command.Name,
-			Policy:      policiesToAdd,
-			UserOrGroup: userOrGroup,
-			IsGroup:     isGroup,
-		})
-	} else {
-		fatalIf(probe.NewError(e), "Unable to unset the policy")
-	}
-	return nil
+	return client.SetPolicy(globalContext, updatedPolicies, entity.name, entity.isGroup)
 }
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePolicyEntities(t *testing.T) {
+	testCases := []struct {
+		entityArg string
+		users     []string
+		groups    []string
+		entities  []policyEntity
+		wantErr   bool
+	}{
+		{"user=james", nil, nil, []policyEntity{{"james", false}}, false},
+		{"group=auditors", nil, nil, []policyEntity{{"auditors", true}}, false},
+		{"", []string{"u1,u2"}, []string{"g1", "g2"}, []policyEntity{{"u1", false}, {"u2", false}, {"g1", true}, {"g2", true}}, false},
+		{"user=james", []string{"u1, "}, nil, []policyEntity{{"james", false}, {"u1", false}}, false},
+		{"james", nil, nil, nil, true},
+		{"", []string{","}, nil, nil, true},
+	}
+	for i, tc := range testCases {
+		entities, err := parsePolicyEntities(tc.entityArg, tc.users, tc.groups)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, tc.wantErr, err)
+		}
+		if !reflect.DeepEqual(entities, tc.entities) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.entities, entities)
+		}
+	}
+}
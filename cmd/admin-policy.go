@@ -27,6 +27,7 @@ var adminPolicySubcommands = []cli.Command{
 	adminPolicySetCmd,
 	adminPolicyUnsetCmd,
 	adminPolicyUpdateCmd,
+	adminPolicyDiffCmd,
 }
 
 var adminPolicyCmd = cli.Command{
@@ -137,7 +137,7 @@ func mainAdminScannerTrace(ctx *cli.Context) error {
 			fatalIf(probe.NewError(traceInfo.Err), "Unable to listen to http trace")
 		}
 		if matchTrace(mopts, traceInfo) {
-			printTrace(verbose, traceInfo)
+			printTrace(verbose, 0, traceInfo)
 		}
 	}
 	return nil
@@ -18,8 +18,11 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -30,18 +33,34 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var adminServiceRestartFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "confirm",
+		Usage: "show the nodes to be restarted and ask for confirmation",
+	},
+	cli.BoolFlag{
+		Name:  "wait",
+		Usage: "wait until all nodes are back online, bounded by --timeout",
+	},
+	cli.DurationFlag{
+		Name:  "timeout",
+		Usage: "maximum time to wait with --wait",
+		Value: 5 * time.Minute,
+	},
+}
+
 var adminServiceRestartCmd = cli.Command{
 	Name:         "restart",
 	Usage:        "restart a MinIO cluster",
 	Action:       mainAdminServiceRestart,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminServiceRestartFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -49,6 +68,9 @@ FLAGS:
 EXAMPLES:
   1. Restart MinIO server represented by its alias 'play'.
      {{.Prompt}} {{.HelpName}} play/
+
+  2. Restart 'myminio' after confirming the affected nodes, and wait up to 10 minutes for all of them to be back online.
+     {{.Prompt}} {{.HelpName}} --confirm --wait --timeout 10m myminio/
 `,
 }
 
@@ -95,6 +117,73 @@ func (s serviceRestartMessage) JSON() string {
 	return string(serviceRestartJSONBytes)
 }
 
+// serviceRestartNode is a node affected by a restart.
+type serviceRestartNode struct {
+	Endpoint string `json:"endpoint"`
+	State    string `json:"state"`
+}
+
+// serviceRestartPreviewMessage lists the nodes a restart affects.
+type serviceRestartPreviewMessage struct {
+	Status    string               `json:"status"`
+	ServerURL string               `json:"serverURL"`
+	Nodes     []serviceRestartNode `json:"nodes"`
+}
+
+// String colorized service restart preview message.
+func (s serviceRestartPreviewMessage) String() string {
+	msg := console.Colorize("ServiceInitializing", fmt.Sprintf("Restarting `%s` affects %d node(s):", s.ServerURL, len(s.Nodes)))
+	for _, node := range s.Nodes {
+		theme := "ServiceRestart"
+		if node.State != string(madmin.ItemOnline) {
+			theme = "ServiceOffline"
+		}
+		msg += "\n   " + node.Endpoint + " " + console.Colorize(theme, node.State)
+	}
+	return msg
+}
+
+// JSON jsonified service restart preview message.
+func (s serviceRestartPreviewMessage) JSON() string {
+	serviceRestartJSONBytes, e := json.MarshalIndent(s, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(serviceRestartJSONBytes)
+}
+
+// serviceRestartNodes returns the nodes of the deployment and whether
+// all of them are online.
+func serviceRestartNodes(ctx context.Context, client *madmin.AdminClient) (nodes []serviceRestartNode, online bool, err error) {
+	info, err := client.ServerInfo(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	online = len(info.Servers) > 0
+	for _, srv := range info.Servers {
+		nodes = append(nodes, serviceRestartNode{Endpoint: srv.Endpoint, State: srv.State})
+		if srv.State != string(madmin.ItemOnline) {
+			online = false
+		}
+	}
+	return nodes, online, nil
+}
+
+// confirmRestart prints the nodes affected by the restart and asks
+// for a confirmation. Returns false if the restart should not proceed.
+func confirmRestart(ctx context.Context, client *madmin.AdminClient, aliasedURL string) bool {
+	nodes, _, e := serviceRestartNodes(ctx, client)
+	fatalIf(probe.NewError(e).Trace(aliasedURL), "Unable to list the nodes of `"+aliasedURL+"`.")
+	printMsg(serviceRestartPreviewMessage{Status: "success", ServerURL: aliasedURL, Nodes: nodes})
+
+	fmt.Print("Proceed with restart? y/N: ")
+	answer, e := bufio.NewReader(os.Stdin).ReadString('\n')
+	if e != nil {
+		return false
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y\n" || answer == "yes\n"
+}
+
 // checkAdminServiceRestartSyntax - validate all the passed arguments
 func checkAdminServiceRestartSyntax(ctx *cli.Context) {
 	if len(ctx.Args()) != 1 {
@@ -122,6 +211,10 @@ func mainAdminServiceRestart(ctx *cli.Context) error {
 	client, err := newAdminClient(aliasedURL)
 	fatalIf(err, "Unable to initialize admin connection.")
 
+	if ctx.Bool("confirm") && !confirmRestart(ctxt, client, aliasedURL) {
+		return nil
+	}
+
 	// Restart the specified MinIO server
 	fatalIf(probe.NewError(client.ServiceRestart(ctxt)), "Unable to restart the server.")
 
@@ -146,18 +239,36 @@ func mainAdminServiceRestart(ctx *cli.Context) error {
 	printProgress()
 	mark = "."
 
+	wait := ctx.Bool("wait")
+	waitCtx := ctxt
+	if wait {
+		var waitCancel context.CancelFunc
+		waitCtx, waitCancel = context.WithTimeout(ctxt, ctx.Duration("timeout"))
+		defer waitCancel()
+	}
+
 	timer := time.NewTimer(time.Second)
 	defer timer.Stop()
 
 	t := time.Now()
 	for {
 		select {
-		case <-ctxt.Done():
+		case <-waitCtx.Done():
+			if ctxt.Err() == nil {
+				fatalIf(errDummy().Trace(aliasedURL), fmt.Sprintf("Timed out after %s waiting for `%s` to be back online.",
+					ctx.Duration("timeout"), aliasedURL))
+			}
 			return ctxt.Err()
 		case <-timer.C:
-			healthCtx, healthCancel := context.WithTimeout(ctxt, 3*time.Second)
+			healthCtx, healthCancel := context.WithTimeout(waitCtx, 3*time.Second)
 			// Fetch the health status of the specified MinIO server
 			healthResult, healthErr := anonClient.Healthy(healthCtx, madmin.HealthOpts{})
+			if wait && healthErr == nil && healthResult.Healthy {
+				// Healthy only means write quorum, wait for every node.
+				var online bool
+				_, online, healthErr = serviceRestartNodes(healthCtx, client)
+				healthResult.Healthy = online
+			}
 			healthCancel()
 			switch {
 			case healthErr == nil && healthResult.Healthy:
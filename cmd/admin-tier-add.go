@@ -18,9 +18,15 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
@@ -85,6 +91,14 @@ var adminTierAddFlags = []cli.Flag{
 		Value: "",
 		Usage: "remote tier storage-class",
 	},
+	cli.BoolFlag{
+		Name:  "skip-verify",
+		Usage: "save the remote tier without verifying it",
+	},
+	cli.BoolFlag{
+		Name:  "check-from-client",
+		Usage: "also check the remote tier bucket from this machine, failures are only reported",
+	},
 }
 
 var adminTierAddCmd = cli.Command{
@@ -109,6 +123,14 @@ NAME:
 FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
+VERIFICATION:
+  Once saved, the server writes, reads and deletes a test object on the remote tier and a tier
+  failing that check is removed again. Use --skip-verify to save the tier regardless.
+
+  With --check-from-client, mc runs the same check on a MinIO or S3 remote tier from this machine
+  before saving it. Its network path may differ from the servers', so a failure is only reported
+  and does not prevent the tier from being added.
+
 EXAMPLES:
   1. Configure a new remote tier which transitions objects to a bucket in a MinIO deployment:
      {{.Prompt}} {{.HelpName}} minio myminio WARM-MINIO-TIER --endpoint https://warm-minio.com \
@@ -126,6 +148,14 @@ EXAMPLES:
   4. Configure a new remote tier which transitions objects to a bucket in Google Cloud Storage:
      {{.Prompt}} {{.HelpName}} gcs myminio GCSTIER --credentials-file /path/to/credentials.json \
         --bucket mygcsbucket  --prefix mygcsprefix/
+
+  5. Configure a new remote tier without verifying the remote bucket, e.g. when it is not yet reachable:
+     {{.Prompt}} {{.HelpName}} minio myminio WARM-MINIO-TIER --endpoint https://warm-minio.com \
+        --access-key ACCESSKEY --secret-key SECRETKEY --bucket mybucket --skip-verify
+
+  6. Configure a new remote tier and also check the remote bucket from this machine:
+     {{.Prompt}} {{.HelpName}} minio myminio WARM-MINIO-TIER --endpoint https://warm-minio.com \
+        --access-key ACCESSKEY --secret-key SECRETKEY --bucket mybucket --check-from-client
 `,
 }
 
@@ -356,6 +386,126 @@ func (msg *tierMessage) SetTierConfig(sCfg *madmin.TierConfig) {
 	}
 }
 
+// probeTierTarget writes, reads back and deletes a small test object
+// under the tier bucket and prefix with the credentials given for the
+// tier, from the network of mc rather than the servers'. Only MinIO and
+// S3 tiers with static credentials are probed, other tiers are left to
+// the server side check.
+func probeTierTarget(ctx context.Context, tCfg *madmin.TierConfig) *probe.Error {
+	var endpoint, accessKey, secretKey, bucket, prefix string
+	switch tCfg.Type {
+	case madmin.MinIO:
+		endpoint, accessKey, secretKey = tCfg.MinIO.Endpoint, tCfg.MinIO.AccessKey, tCfg.MinIO.SecretKey
+		bucket, prefix = tCfg.MinIO.Bucket, tCfg.MinIO.Prefix
+	case madmin.S3:
+		if tCfg.S3.AWSRole {
+			return nil
+		}
+		endpoint, accessKey, secretKey = tCfg.S3.Endpoint, tCfg.S3.AccessKey, tCfg.S3.SecretKey
+		bucket, prefix = tCfg.S3.Bucket, tCfg.S3.Prefix
+	default:
+		return nil
+	}
+
+	object := randString(60, rand.NewSource(time.Now().UnixNano()), "mc-tier-verify-")
+	clnt, err := S3New(&Config{
+		AccessKey:         accessKey,
+		SecretKey:         secretKey,
+		Signature:         "s3v4",
+		HostURL:           urlJoinPath(endpoint, path.Join(bucket, prefix, object)),
+		Debug:             globalDebug,
+		Insecure:          globalInsecure,
+		ConnReadDeadline:  globalConnReadDeadline,
+		ConnWriteDeadline: globalConnWriteDeadline,
+	})
+	if err != nil {
+		return err.Trace(endpoint)
+	}
+
+	payload := []byte("mc remote tier verification")
+	if _, err = clnt.Put(ctx, bytes.NewReader(payload), int64(len(payload)), nil, PutOptions{}); err != nil {
+		return err.Trace(bucket, "write")
+	}
+	reader, err := clnt.Get(ctx, GetOptions{})
+	if err == nil {
+		got, e := ioutil.ReadAll(reader)
+		reader.Close()
+		switch {
+		case e != nil:
+			err = probe.NewError(e)
+		case !bytes.Equal(got, payload):
+			err = probe.NewError(errors.New("test object read back with different content"))
+		}
+	}
+	if err != nil {
+		err = err.Trace(bucket, "read")
+	}
+
+	contentCh := make(chan *ClientContent, 1)
+	contentCh <- &ClientContent{URL: clnt.GetURL()}
+	close(contentCh)
+	for result := range clnt.Remove(ctx, false, false, false, false, contentCh) {
+		if result.Err != nil && err == nil {
+			err = result.Err.Trace(bucket, "delete")
+		}
+	}
+	return err
+}
+
+// tierVerifyOutcome is how the server answered the verification of a
+// newly added tier.
+type tierVerifyOutcome int
+
+const (
+	tierVerified tierVerifyOutcome = iota
+	// The server does not know the verify API.
+	tierVerifyUnsupported
+	// The request did not get a verdict, e.g. a network error.
+	tierVerifyInconclusive
+	// The server probed the tier and the probe failed.
+	tierVerifyFailed
+)
+
+// classifyTierVerify maps the error of VerifyTier to an outcome. Only a
+// failed probe on the server justifies removing the tier again.
+func classifyTierVerify(e error) tierVerifyOutcome {
+	if e == nil {
+		return tierVerified
+	}
+	switch madmin.ToErrorResponse(e).Code {
+	case "":
+		// Not an error response of the server.
+		return tierVerifyInconclusive
+	case "NotImplemented", "XMinioAdminVersionMismatch", "MethodNotAllowed":
+		return tierVerifyUnsupported
+	case "SlowDown", "ServiceUnavailable", "XMinioServerNotInitialized", "RequestTimeout", "InternalError":
+		return tierVerifyInconclusive
+	}
+	return tierVerifyFailed
+}
+
+// verifyAddedTier asks the server to verify a newly added tier, which
+// writes, reads and deletes a test object on the remote tier. A tier
+// whose probe fails on the server is removed again so that it is never
+// used for transitions, any other outcome keeps the tier.
+func verifyAddedTier(ctx context.Context, client *madmin.AdminClient, tierName string) *probe.Error {
+	e := client.VerifyTier(ctx, tierName)
+	switch classifyTierVerify(e) {
+	case tierVerified:
+		return nil
+	case tierVerifyUnsupported:
+		errorIf(errDummy().Trace(tierName), "Remote tier `"+tierName+"` was added but the server does not support verifying it.")
+		return nil
+	case tierVerifyInconclusive:
+		errorIf(probe.NewError(e).Trace(tierName), "Remote tier `"+tierName+"` was added but could not be verified, run `mc admin tier verify` to retry.")
+		return nil
+	}
+	if re := client.RemoveTier(ctx, tierName); re != nil {
+		errorIf(probe.NewError(re).Trace(tierName), "Unable to remove remote tier `"+tierName+"` which failed verification.")
+	}
+	return probe.NewError(e)
+}
+
 func mainAdminTierAdd(ctx *cli.Context) error {
 	checkAdminTierAddSyntax(ctx)
 
@@ -377,7 +527,14 @@ func mainAdminTierAdd(ctx *cli.Context) error {
 	fatalIf(cerr, "Unable to initialize admin connection.")
 
 	tCfg := fetchTierConfig(ctx, strings.ToUpper(tierName), tierType)
+	if ctx.Bool("check-from-client") {
+		// Advisory only, the servers may reach the tier through a different network.
+		errorIf(probeTierTarget(globalContext, tCfg).Trace(args...), "Unable to access remote tier target `"+tCfg.Name+"` from this machine.")
+	}
 	fatalIf(probe.NewError(client.AddTier(globalContext, tCfg)).Trace(args...), "Unable to configure remote tier target")
+	if !ctx.Bool("skip-verify") {
+		fatalIf(verifyAddedTier(globalContext, client, tCfg.Name).Trace(args...), "Unable to verify remote tier target `"+tCfg.Name+"`, the tier was not added")
+	}
 
 	msg := &tierMessage{
 		op:     ctx.Command.Name,
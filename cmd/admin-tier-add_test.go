@@ -0,0 +1,45 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestClassifyTierVerify(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected tierVerifyOutcome
+	}{
+		{nil, tierVerified},
+		{madmin.ErrorResponse{Code: "NotImplemented"}, tierVerifyUnsupported},
+		{madmin.ErrorResponse{Code: "XMinioAdminVersionMismatch"}, tierVerifyUnsupported},
+		{errors.New("dial tcp: connection refused"), tierVerifyInconclusive},
+		{madmin.ErrorResponse{Code: "ServiceUnavailable"}, tierVerifyInconclusive},
+		{madmin.ErrorResponse{Code: "XMinioAdminTierBackendInUse"}, tierVerifyFailed},
+		{madmin.ErrorResponse{Code: "XMinioAdminTierBucketNotFound"}, tierVerifyFailed},
+	}
+	for i, tc := range testCases {
+		if got := classifyTierVerify(tc.err); got != tc.expected {
+			t.Errorf("Test %d: expected outcome %d for %v, got %d", i+1, tc.expected, tc.err, got)
+		}
+	}
+}
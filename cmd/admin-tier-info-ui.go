@@ -57,23 +57,17 @@ func (ts tierInfos) TableUI() *tview.Table {
 				SetAlign(tview.AlignCenter))
 	}
 
-	tierType := func(tierName string) string {
-		if tierName == "STANDARD" {
-			return "hot"
-		}
-		return "warm"
-	}
 	for i, tInfo := range ts {
 		table.SetCell(i+1, 0,
 			tview.NewTableCell(tInfo.Name).
 				SetTextColor(tcell.ColorWhite).
 				SetAlign(tview.AlignCenter))
 		table.SetCell(i+1, 1,
-			tview.NewTableCell(tInfo.Type).
+			tview.NewTableCell(tierInfoAPI(tInfo.Type)).
 				SetTextColor(tcell.ColorWhite).
 				SetAlign(tview.AlignCenter))
 		table.SetCell(i+1, 2,
-			tview.NewTableCell(tierType(tInfo.Name)).
+			tview.NewTableCell(tierInfoType(tInfo.Type)).
 				SetTextColor(tcell.ColorWhite).
 				SetAlign(tview.AlignCenter))
 		table.SetCell(i+1, 3,
@@ -118,7 +112,7 @@ func (ts tierInfos) Barcharts(tier string) (objects *tvxwidgets.BarChart, versio
 	var tInfo madmin.TierInfo
 	for _, t := range ts {
 		if t.Name == tier {
-			tInfo = t
+			tInfo = t.TierInfo
 			break
 		}
 	}
@@ -19,7 +19,9 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
@@ -37,7 +39,12 @@ var adminTierInfoCmd = cli.Command{
 	Action:       mainAdminTierInfo,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags: append([]cli.Flag{
+		cli.BoolFlag{
+			Name:  "ui",
+			Usage: "show statistics in an interactive dashboard",
+		},
+	}, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
@@ -54,6 +61,9 @@ EXAMPLES:
 
   2. Print per-tier statistics of given tier name 'MINIOTIER-1':
      {{.Prompt}} {{.HelpName}} myminio MINIOTIER-1
+
+  3. Show the hourly objects and versions transitioned to 'MINIOTIER-1' in an interactive dashboard:
+     {{.Prompt}} {{.HelpName}} --ui myminio MINIOTIER-1
 `,
 }
 
@@ -76,6 +86,7 @@ const (
 	tierInfoAPIHdr
 	tierInfoTypeHdr
 	tierInfoUsageHdr
+	tierInfoUsagePercentHdr
 	tierInfoObjectsHdr
 	tierInfoVersionsHdr
 )
@@ -85,6 +96,7 @@ var tierInfoRowNames = []string{
 	"API",
 	"Type",
 	"Usage",
+	"Usage %",
 	"Objects",
 	"Versions",
 }
@@ -96,12 +108,36 @@ var tierInfoColorScheme = []*color.Color{
 	color.New(color.FgHiWhite),
 	color.New(color.FgHiWhite),
 	color.New(color.FgHiWhite),
+	color.New(color.FgHiWhite),
 }
 
-type tierInfos []madmin.TierInfo
+// tierInfo is the statistics of a tier along with its share in the total
+// size of all tiers, hot and warm.
+type tierInfo struct {
+	madmin.TierInfo
+	usagePercent float64
+}
+
+type tierInfos []tierInfo
+
+func newTierInfos(tInfos []madmin.TierInfo) tierInfos {
+	var total uint64
+	for _, tInfo := range tInfos {
+		total += tInfo.Stats.TotalSize
+	}
+	ts := make(tierInfos, 0, len(tInfos))
+	for _, tInfo := range tInfos {
+		var pct float64
+		if total > 0 {
+			pct = float64(tInfo.Stats.TotalSize) * 100 / float64(total)
+		}
+		ts = append(ts, tierInfo{TierInfo: tInfo, usagePercent: pct})
+	}
+	return ts
+}
 
 func (t tierInfos) NumRows() int {
-	return len([]madmin.TierInfo(t))
+	return len(t)
 }
 
 func (t tierInfos) NumCols() int {
@@ -113,26 +149,42 @@ func (t tierInfos) EmptyMessage() string {
 }
 
 func (t tierInfos) MarshalJSON() ([]byte, error) {
-	type tierInfo struct {
-		Name       string
-		API        string
-		Type       string
-		Stats      madmin.TierStats
-		DailyStats madmin.DailyTierStats
-	}
-	ts := make([]tierInfo, 0, len(t))
+	type tierInfoJSON struct {
+		Name         string
+		API          string
+		Type         string
+		Stats        madmin.TierStats
+		UsagePercent float64
+		DailyStats   madmin.DailyTierStats
+	}
+	ts := make([]tierInfoJSON, 0, len(t))
 	for _, tInfo := range t {
-		ts = append(ts, tierInfo{
-			Name:       tInfo.Name,
-			API:        tierInfoAPI(tInfo.Type),
-			Type:       tierInfoType(tInfo.Type),
-			Stats:      tInfo.Stats,
-			DailyStats: tInfo.DailyStats,
+		ts = append(ts, tierInfoJSON{
+			Name:         tInfo.Name,
+			API:          tierInfoAPI(tInfo.Type),
+			Type:         tierInfoType(tInfo.Type),
+			Stats:        tInfo.Stats,
+			UsagePercent: tInfo.usagePercent,
+			DailyStats:   tInfo.DailyStats,
 		})
 	}
 	return json.Marshal(ts)
 }
 
+// filter returns the statistics of the named tier only, an empty name
+// selects all tiers.
+func (t tierInfos) filter(tierName string) (tierInfos, bool) {
+	if tierName == "" {
+		return t, true
+	}
+	for _, tInfo := range t {
+		if strings.EqualFold(tInfo.Name, tierName) {
+			return tierInfos{tInfo}, true
+		}
+	}
+	return nil, false
+}
+
 func tierInfoAPI(tierType string) string {
 	switch tierType {
 	case madmin.S3.String(), madmin.GCS.String():
@@ -163,6 +215,7 @@ func (t tierInfos) ToRow(i int, ls []int) []string {
 		row[tierInfoAPIHdr] = tierInfoAPI(tierInfo.Type)
 		row[tierInfoTypeHdr] = tierInfoType(tierInfo.Type)
 		row[tierInfoUsageHdr] = humanize.IBytes(tierInfo.Stats.TotalSize)
+		row[tierInfoUsagePercentHdr] = fmt.Sprintf("%.1f%%", tierInfo.usagePercent)
 		row[tierInfoObjectsHdr] = strconv.Itoa(tierInfo.Stats.NumObjects)
 		row[tierInfoVersionsHdr] = strconv.Itoa(tierInfo.Stats.NumVersions)
 	}
@@ -197,7 +250,7 @@ func mainAdminTierInfo(ctx *cli.Context) error {
 		msg = tierInfoMessage{
 			Status:    "success",
 			Context:   ctx,
-			TierInfos: tierInfos(tInfos),
+			TierInfos: newTierInfos(tInfos),
 		}
 	}
 
@@ -205,19 +258,26 @@ func mainAdminTierInfo(ctx *cli.Context) error {
 		console.SetColor(tierInfoRowNames[i], color)
 	}
 
-	if globalJSON {
+	if !ctx.Bool("ui") || globalJSON {
+		if tier := args.Get(1); tier != "" && msg.Status == "success" {
+			var found bool
+			msg.TierInfos, found = msg.TierInfos.filter(tier)
+			if !found {
+				fatalIf(errInvalidArgument().Trace(args...), "Remote tier `"+tier+"` not found.")
+			}
+		}
 		printMsg(&msg)
 		return nil
 	}
 
 	layout := tview.NewFlex().SetDirection(tview.FlexRow)
 	if tier := args.Get(1); tier != "" {
-		if obc, vbc := tierInfos(tInfos).Barcharts(tier); obc != nil && vbc != nil {
+		if obc, vbc := newTierInfos(tInfos).Barcharts(tier); obc != nil && vbc != nil {
 			layout.AddItem(obc, 0, 1, false)
 			layout.AddItem(vbc, 0, 1, false)
 		}
 	} else {
-		table := tierInfos(tInfos).TableUI()
+		table := newTierInfos(tInfos).TableUI()
 		layout.AddItem(table, 0, 1, false)
 	}
 
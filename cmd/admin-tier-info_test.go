@@ -0,0 +1,50 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestTierInfosUsageAndFilter(t *testing.T) {
+	ts := newTierInfos([]madmin.TierInfo{
+		{Name: "STANDARD", Type: "internal", Stats: madmin.TierStats{TotalSize: 300}},
+		{Name: "WARM-TIER", Type: "s3", Stats: madmin.TierStats{TotalSize: 100}},
+	})
+	if got := ts[0].usagePercent; got != 75 {
+		t.Errorf("expected 75%% for STANDARD, got %v", got)
+	}
+	if got := ts[1].usagePercent; got != 25 {
+		t.Errorf("expected 25%% for WARM-TIER, got %v", got)
+	}
+	if got := newTierInfos([]madmin.TierInfo{{Name: "EMPTY"}})[0].usagePercent; got != 0 {
+		t.Errorf("expected 0%% for an empty tier, got %v", got)
+	}
+
+	if all, ok := ts.filter(""); !ok || len(all) != 2 {
+		t.Errorf("expected all tiers for an empty name, got %v %v", all, ok)
+	}
+	if one, ok := ts.filter("warm-tier"); !ok || len(one) != 1 || one[0].Name != "WARM-TIER" || one[0].usagePercent != 25 {
+		t.Errorf("expected WARM-TIER, got %v %v", one, ok)
+	}
+	if _, ok := ts.filter("COLD-TIER"); ok {
+		t.Error("expected COLD-TIER not to be found")
+	}
+}
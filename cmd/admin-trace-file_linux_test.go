@@ -0,0 +1,110 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/minio/madmin-go"
+	"golang.org/x/sys/unix"
+)
+
+func TestWriteJSONLineColored(t *testing.T) {
+	// Console output is colored when stdout is a terminal.
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skip("no pseudo terminal available:", err)
+	}
+	defer ptmx.Close()
+	if err = unix.IoctlSetPointerInt(int(ptmx.Fd()), unix.TIOCSPTLCK, 0); err != nil {
+		t.Skip("unable to unlock pseudo terminal:", err)
+	}
+	n, err := unix.IoctlGetInt(int(ptmx.Fd()), unix.TIOCGPTN)
+	if err != nil {
+		t.Skip("unable to get pseudo terminal:", err)
+	}
+	pts, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n), os.O_RDWR, 0)
+	if err != nil {
+		t.Skip("unable to open pseudo terminal:", err)
+	}
+	defer pts.Close()
+	stdout := os.Stdout
+	os.Stdout = pts
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() {
+		os.Stdout = stdout
+		color.NoColor = noColor
+	}()
+
+	traceInfo := madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{
+		TraceType: madmin.TraceS3,
+		NodeName:  "server1:9000",
+		FuncName:  "s3.GetObject",
+		Time:      time.Now(),
+		Path:      "/bucket/<object>",
+		HTTP: &madmin.TraceHTTPStats{
+			ReqInfo:  madmin.TraceRequestInfo{Method: "GET", Path: "/bucket/<object>"},
+			RespInfo: madmin.TraceResponseInfo{StatusCode: 200},
+		},
+	}}
+	msgs := []traceRecordMessage{newTraceMsg(false, 0, traceInfo), newTraceMsg(true, 0, traceInfo)}
+	if !strings.Contains(msgs[0].JSON(), "\x1b[") {
+		t.Fatal("expected colored console JSON")
+	}
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	r, err := newRotatingFile(path, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range msgs {
+		if err = writeJSONLine(r, msg.record()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r.Close()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", string(b))
+	}
+	for _, line := range lines {
+		var rec map[string]interface{}
+		if err = json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", line, err)
+		}
+		if rec["path"] != "/bucket/<object>" {
+			t.Errorf("unexpected path in %q", line)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// Maximum number of latencies kept per API to compute percentiles,
+// further calls are sampled.
+const traceStatsMaxSamples = 10000
+
+// traceStatsEntry - aggregated calls of a single API.
+type traceStatsEntry struct {
+	API       string        `json:"api"`
+	Count     int           `json:"count"`
+	Errors    int           `json:"errors"`
+	ErrorRate float64       `json:"errorRate"`
+	P50       time.Duration `json:"p50"`
+	P90       time.Duration `json:"p90"`
+	P99       time.Duration `json:"p99"`
+	Max       time.Duration `json:"max"`
+
+	latencies []time.Duration
+}
+
+// traceStats - aggregates trace records per API until printed.
+type traceStats struct {
+	started time.Time
+	entries map[string]*traceStatsEntry
+}
+
+func newTraceStats() *traceStats {
+	return &traceStats{started: time.Now(), entries: make(map[string]*traceStatsEntry)}
+}
+
+// isTraceError - returns true for failed calls.
+func isTraceError(ti madmin.ServiceTraceInfo) bool {
+	if ti.Trace.Error != "" {
+		return true
+	}
+	return ti.Trace.HTTP != nil && ti.Trace.HTTP.RespInfo.StatusCode >= 400
+}
+
+func (s *traceStats) add(ti madmin.ServiceTraceInfo) {
+	e, ok := s.entries[ti.Trace.FuncName]
+	if !ok {
+		e = &traceStatsEntry{API: ti.Trace.FuncName}
+		s.entries[ti.Trace.FuncName] = e
+	}
+	e.Count++
+	if isTraceError(ti) {
+		e.Errors++
+	}
+	latency := ti.Trace.Duration
+	if latency > e.Max {
+		e.Max = latency
+	}
+	// Reservoir sampling keeps a uniform sample of all latencies.
+	if len(e.latencies) < traceStatsMaxSamples {
+		e.latencies = append(e.latencies, latency)
+	} else if i := rand.Intn(e.Count); i < traceStatsMaxSamples {
+		e.latencies[i] = latency
+	}
+}
+
+// percentile - returns the p-th percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(float64(len(sorted))*p+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// message - returns the busiest top APIs, all of them if top is 0.
+func (s *traceStats) message(top int) traceStatsMessage {
+	msg := traceStatsMessage{Duration: time.Since(s.started)}
+	for _, e := range s.entries {
+		entry := *e
+		sorted := append([]time.Duration(nil), e.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		entry.P50 = percentile(sorted, 0.5)
+		entry.P90 = percentile(sorted, 0.9)
+		entry.P99 = percentile(sorted, 0.99)
+		entry.ErrorRate = float64(entry.Errors) / float64(entry.Count)
+		entry.latencies = nil
+		msg.Total += entry.Count
+		msg.APIs = append(msg.APIs, entry)
+	}
+	sort.Slice(msg.APIs, func(i, j int) bool {
+		if msg.APIs[i].Count != msg.APIs[j].Count {
+			return msg.APIs[i].Count > msg.APIs[j].Count
+		}
+		return msg.APIs[i].API < msg.APIs[j].API
+	})
+	if top > 0 && len(msg.APIs) > top {
+		msg.APIs = msg.APIs[:top]
+	}
+	return msg
+}
+
+// traceStatsMessage - summary of the calls traced with --stats.
+type traceStatsMessage struct {
+	Status   string            `json:"status"`
+	Duration time.Duration     `json:"duration"`
+	Total    int               `json:"total"`
+	APIs     []traceStatsEntry `json:"apis"`
+}
+
+func (m traceStatsMessage) JSON() string {
+	m.Status = "success"
+	statsJSONBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(statsJSONBytes)
+}
+
+func (m traceStatsMessage) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintln(b, console.Colorize("Stat", fmt.Sprintf("%d calls traced in %s", m.Total, m.Duration.Round(time.Second))))
+	if len(m.APIs) == 0 {
+		return b.String()
+	}
+	width := len("API")
+	for _, e := range m.APIs {
+		if len(e.API) > width {
+			width = len(e.API)
+		}
+	}
+	fmt.Fprintln(b, console.Colorize("Request", fmt.Sprintf("%-*s %10s %8s %10s %10s %10s %10s", width, "API", "COUNT", "ERRORS", "P50", "P90", "P99", "MAX")))
+	for _, e := range m.APIs {
+		row := fmt.Sprintf("%-*s %10d %7.1f%% %10s %10s %10s %10s", width, e.API, e.Count, e.ErrorRate*100,
+			e.P50.Round(time.Microsecond), e.P90.Round(time.Microsecond),
+			e.P99.Round(time.Microsecond), e.Max.Round(time.Microsecond))
+		if e.Errors > 0 {
+			row = console.Colorize("ErrStatus", row)
+		}
+		fmt.Fprintln(b, row)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
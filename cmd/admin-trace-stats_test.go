@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestTraceStats(t *testing.T) {
+	newTrace := func(api string, latency time.Duration, code int) madmin.ServiceTraceInfo {
+		var ti madmin.ServiceTraceInfo
+		ti.Trace.FuncName = api
+		ti.Trace.Duration = latency
+		ti.Trace.HTTP = &madmin.TraceHTTPStats{}
+		ti.Trace.HTTP.RespInfo.StatusCode = code
+		return ti
+	}
+
+	s := newTraceStats()
+	for i := 1; i <= 100; i++ {
+		code := 200
+		if i%10 == 0 {
+			code = 503
+		}
+		s.add(newTrace("s3.GetObject", time.Duration(i)*time.Millisecond, code))
+	}
+	s.add(newTrace("s3.PutObject", time.Second, 200))
+	s.add(newTrace("s3.PutObject", 2*time.Second, 200))
+	s.add(newTrace("s3.ListObjectsV2", time.Millisecond, 404))
+
+	msg := s.message(0)
+	if msg.Total != 103 || len(msg.APIs) != 3 {
+		t.Fatalf("unexpected summary %+v", msg)
+	}
+	get := msg.APIs[0]
+	if get.API != "s3.GetObject" || get.Count != 100 || get.Errors != 10 || get.ErrorRate != 0.1 {
+		t.Fatalf("unexpected entry %+v", get)
+	}
+	if get.P50 != 50*time.Millisecond || get.P90 != 90*time.Millisecond || get.P99 != 99*time.Millisecond || get.Max != 100*time.Millisecond {
+		t.Fatalf("unexpected percentiles %+v", get)
+	}
+	if msg.APIs[1].API != "s3.PutObject" || msg.APIs[2].API != "s3.ListObjectsV2" || msg.APIs[2].ErrorRate != 1 {
+		t.Fatalf("unexpected order %+v", msg.APIs)
+	}
+
+	if msg = s.message(1); len(msg.APIs) != 1 || msg.Total != 103 {
+		t.Fatalf("unexpected top summary %+v", msg)
+	}
+}
@@ -20,10 +20,12 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"hash/fnv"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -57,6 +59,10 @@ var adminTraceFlags = []cli.Flag{
 		Name:  "status-code",
 		Usage: "trace only matching status code",
 	},
+	cli.StringSliceFlag{
+		Name:  "status",
+		Usage: "trace only calls with a response status in comma separated codes or ranges (e.g. `500-599`, `301,302`)",
+	},
 	cli.StringSliceFlag{
 		Name:  "method",
 		Usage: "trace only matching HTTP method",
@@ -81,8 +87,41 @@ var adminTraceFlags = []cli.Flag{
 		Name:  "errors, e",
 		Usage: "trace only failed requests",
 	},
+	cli.BoolFlag{
+		Name:  "bodies",
+		Usage: "print verbose trace with request and response bodies truncated to --body-limit",
+	},
+	cli.IntFlag{
+		Name:  "body-limit",
+		Usage: "maximum number of body bytes printed with --bodies",
+		Value: defaultTraceBodyLimit,
+	},
+	cli.StringFlag{
+		Name:  "out",
+		Usage: "also write trace records to a file as JSON lines, console output is suppressed with --quiet",
+	},
+	cli.StringFlag{
+		Name:  "rotate-size",
+		Usage: "roll over the --out file once it reaches this size (e.g. `100MiB`)",
+	},
+	cli.IntFlag{
+		Name:  "rotate-keep",
+		Usage: "number of rolled over --out files to keep",
+		Value: defaultTraceRotateKeep,
+	},
+	cli.BoolFlag{
+		Name:  "stats",
+		Usage: "aggregate calls per API until interrupted, then print count, error rate and latency percentiles",
+	},
+	cli.IntFlag{
+		Name:  "top",
+		Usage: "limit --stats to the N busiest APIs",
+	},
 }
 
+// Default number of body bytes printed with --bodies.
+const defaultTraceBodyLimit = 4096
+
 var adminTraceCmd = cli.Command{
 	Name:            "trace",
 	Usage:           "show http trace for MinIO server",
@@ -115,6 +154,18 @@ EXAMPLES:
 
   5. Show console trace for requests with '404' and '503' status code
     {{.Prompt}} {{.HelpName}} --status-code 404 --status-code 503 myminio
+
+  6. Show console trace for all redirects and server errors
+    {{.Prompt}} {{.HelpName}} --status 300-399,500-599 myminio
+
+  7. Show the first 1KiB of request and response bodies of admin calls
+    {{.Prompt}} {{.HelpName}} --call internal --bodies --body-limit 1024 myminio
+
+  8. Write verbose trace to 'trace.json', rolling over every 100MiB and keeping 3 old files, without console output
+    {{.Prompt}} {{.HelpName}} -v --quiet --out trace.json --rotate-size 100MiB --rotate-keep 3 myminio
+
+  9. Profile the 10 busiest S3 APIs, press Ctrl-C to print the summary
+    {{.Prompt}} {{.HelpName}} --stats --top 10 myminio
 `,
 }
 
@@ -128,12 +179,25 @@ func checkAdminTraceSyntax(ctx *cli.Context) {
 	}
 }
 
-func printTrace(verbose bool, traceInfo madmin.ServiceTraceInfo) {
+func printTrace(verbose bool, bodyLimit int, traceInfo madmin.ServiceTraceInfo) {
+	printMsg(newTraceMsg(verbose, bodyLimit, traceInfo))
+}
+
+// traceRecordMessage - a trace message which also gives the record
+// behind its JSON output, to be written to the --out file.
+type traceRecordMessage interface {
+	message
+	record() interface{}
+}
+
+func newTraceMsg(verbose bool, bodyLimit int, traceInfo madmin.ServiceTraceInfo) traceRecordMessage {
+	if bodyLimit > 0 {
+		return traceMessage{ServiceTraceInfo: redactTrace(traceInfo), bodyLimit: bodyLimit}
+	}
 	if verbose {
-		printMsg(traceMessage{ServiceTraceInfo: traceInfo})
-	} else {
-		printMsg(shortTrace(traceInfo))
+		return traceMessage{ServiceTraceInfo: traceInfo}
 	}
+	return shortTrace(traceInfo)
 }
 
 type matchString struct {
@@ -141,13 +205,49 @@ type matchString struct {
 	reverse bool
 }
 
+// statusRange is an inclusive range of HTTP status codes.
+type statusRange struct {
+	min, max int
+}
+
+// parseStatusRanges - parses comma separated status codes and ranges
+// such as "404,500-599".
+func parseStatusRanges(values []string) ([]statusRange, error) {
+	var ranges []statusRange
+	for _, value := range values {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			lo, hi, isRange := strings.Cut(field, "-")
+			from, e := strconv.Atoi(lo)
+			if e != nil {
+				return nil, fmt.Errorf("invalid status code `%s`", field)
+			}
+			to := from
+			if isRange {
+				if to, e = strconv.Atoi(hi); e != nil {
+					return nil, fmt.Errorf("invalid status code range `%s`", field)
+				}
+			}
+			if from < 100 || to > 599 || from > to {
+				return nil, fmt.Errorf("invalid status code range `%s`", field)
+			}
+			ranges = append(ranges, statusRange{min: from, max: to})
+		}
+	}
+	return ranges, nil
+}
+
 type matchOpts struct {
-	statusCodes []int
-	methods     []string
-	funcNames   []string
-	apiPaths    []string
-	nodes       []string
-	reqHeaders  []matchString
+	statusCodes  []int
+	statusRanges []statusRange
+	methods      []string
+	funcNames    []string
+	apiPaths     []string
+	nodes        []string
+	reqHeaders   []matchString
 }
 
 func matchTrace(opts matchOpts, traceInfo madmin.ServiceTraceInfo) bool {
@@ -180,6 +280,24 @@ func matchTrace(opts matchOpts, traceInfo madmin.ServiceTraceInfo) bool {
 
 	}
 
+	// Filter response status ranges if passed by the user
+	if len(opts.statusRanges) > 0 {
+		if traceInfo.Trace.HTTP == nil {
+			return false
+		}
+		code := traceInfo.Trace.HTTP.RespInfo.StatusCode
+		matched := false
+		for _, r := range opts.statusRanges {
+			if code >= r.min && code <= r.max {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
 	// Filter request method if passed by the user
 	if len(opts.methods) > 0 {
 		matched := false
@@ -252,6 +370,9 @@ func matchTrace(opts matchOpts, traceInfo madmin.ServiceTraceInfo) bool {
 
 func matchingOpts(ctx *cli.Context) (opts matchOpts) {
 	opts.statusCodes = ctx.IntSlice("status-code")
+	statusRanges, e := parseStatusRanges(ctx.StringSlice("status"))
+	fatalIf(probe.NewError(e), "Invalid --status.")
+	opts.statusRanges = statusRanges
 	opts.methods = ctx.StringSlice("method")
 	opts.funcNames = ctx.StringSlice("funcname")
 	opts.apiPaths = ctx.StringSlice("path")
@@ -355,15 +476,75 @@ func mainAdminTrace(ctx *cli.Context) error {
 
 	mopts := matchingOpts(ctx)
 
+	var bodyLimit int
+	if ctx.Bool("bodies") {
+		bodyLimit = ctx.Int("body-limit")
+		if bodyLimit <= 0 {
+			fatalIf(errInvalidArgument().Trace(strconv.Itoa(bodyLimit)), "--body-limit must be positive.")
+		}
+	}
+
+	var out *rotatingFile
+	if outPath := ctx.String("out"); outPath != "" {
+		var rotateSize uint64
+		if sizeStr := ctx.String("rotate-size"); sizeStr != "" {
+			rotateSize, e = humanize.ParseBytes(sizeStr)
+			fatalIf(probe.NewError(e).Trace(sizeStr), "Unable to parse --rotate-size.")
+		}
+		out, e = newRotatingFile(outPath, int64(rotateSize), ctx.Int("rotate-keep"))
+		fatalIf(probe.NewError(e).Trace(outPath), "Unable to open trace output file.")
+		defer out.Close()
+	} else if ctx.IsSet("rotate-size") || ctx.IsSet("rotate-keep") {
+		fatalIf(errInvalidArgument(), "--rotate-size and --rotate-keep require --out.")
+	}
+
+	var stats *traceStats
+	statsPrinted := make(chan struct{})
+	if ctx.Bool("stats") {
+		if out != nil || bodyLimit > 0 {
+			fatalIf(errInvalidArgument(), "--stats cannot be used with --out and --bodies.")
+		}
+		stats = newTraceStats()
+		registerSignalHook(func() {
+			// Give the trace loop below time to print the summary.
+			select {
+			case <-statsPrinted:
+			case <-time.After(5 * time.Second):
+			}
+		})
+	} else if ctx.IsSet("top") {
+		fatalIf(errInvalidArgument(), "--top requires --stats.")
+	}
+
 	// Start listening on all trace activity.
 	traceCh := client.ServiceTrace(ctxt, opts)
 	for traceInfo := range traceCh {
 		if traceInfo.Err != nil {
+			if stats != nil && globalContext.Err() != nil {
+				break
+			}
 			fatalIf(probe.NewError(traceInfo.Err), "Unable to listen to http trace")
 		}
-		if matchTrace(mopts, traceInfo) {
-			printTrace(verbose, traceInfo)
+		if !matchTrace(mopts, traceInfo) {
+			continue
+		}
+		if stats != nil {
+			stats.add(traceInfo)
+			continue
+		}
+		msg := newTraceMsg(verbose, bodyLimit, traceInfo)
+		if out != nil {
+			fatalIf(probe.NewError(writeJSONLine(out, msg.record())), "Unable to write trace output file.")
+			if globalQuiet {
+				continue
+			}
 		}
+		printMsg(msg)
+	}
+
+	if stats != nil {
+		printMsg(stats.message(ctx.Int("top")))
+		close(statsPrinted)
 	}
 
 	return nil
@@ -390,6 +571,9 @@ type shortTraceMsg struct {
 type traceMessage struct {
 	Status string `json:"status"`
 	madmin.ServiceTraceInfo
+
+	// Bodies are truncated to bodyLimit bytes if set.
+	bodyLimit int
 }
 
 type requestInfo struct {
@@ -400,6 +584,7 @@ type requestInfo struct {
 	RawQuery string            `json:"rawQuery,omitempty"`
 	Headers  map[string]string `json:"headers,omitempty"`
 	Body     string            `json:"body,omitempty"`
+	traceBody
 }
 
 type responseInfo struct {
@@ -407,6 +592,79 @@ type responseInfo struct {
 	Headers    map[string]string `json:"headers,omitempty"`
 	Body       string            `json:"body,omitempty"`
 	StatusCode int               `json:"statusCode,omitempty"`
+	traceBody
+}
+
+// traceBody - body captured with --bodies, base64 encoded and
+// truncated to --body-limit, BodyLength is the length of the
+// whole body.
+type traceBody struct {
+	BodyBase64 string `json:"bodyBase64,omitempty"`
+	BodyLength int    `json:"bodyLength,omitempty"`
+}
+
+func newTraceBody(body []byte, limit int) traceBody {
+	return traceBody{
+		BodyBase64: base64.StdEncoding.EncodeToString(truncateTraceBody(body, limit)),
+		BodyLength: len(body),
+	}
+}
+
+func truncateTraceBody(body []byte, limit int) []byte {
+	if limit > 0 && len(body) > limit {
+		return body[:limit]
+	}
+	return body
+}
+
+// traceBodyString - body printed by the verbose trace, noting the
+// whole length when it is truncated.
+func traceBodyString(body []byte, limit int) string {
+	if limit <= 0 || len(body) <= limit {
+		return string(body)
+	}
+	return fmt.Sprintf("%s... (%d of %d bytes)", truncateTraceBody(body, limit), limit, len(body))
+}
+
+// Headers holding credentials, redacted when bodies are printed.
+var traceSensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// redactTrace - returns a copy of traceInfo with the values of
+// sensitive headers replaced, the credential of an Authorization
+// header is kept since it only holds the access key.
+func redactTrace(traceInfo madmin.ServiceTraceInfo) madmin.ServiceTraceInfo {
+	if traceInfo.Trace.HTTP == nil {
+		return traceInfo
+	}
+	redact := func(headers http.Header) http.Header {
+		redacted := headers.Clone()
+		for _, k := range traceSensitiveHeaders {
+			if _, ok := redacted[k]; !ok {
+				continue
+			}
+			value := "*REDACTED*"
+			if k == "Authorization" {
+				auth := redacted.Get(k)
+				if i := strings.Index(auth, "Signature="); i >= 0 {
+					value = auth[:i] + "Signature=*REDACTED*"
+				}
+			}
+			redacted.Set(k, value)
+		}
+		return redacted
+	}
+	httpStats := *traceInfo.Trace.HTTP
+	httpStats.ReqInfo.Headers = redact(httpStats.ReqInfo.Headers)
+	httpStats.RespInfo.Headers = redact(httpStats.RespInfo.Headers)
+	traceInfo.Trace.HTTP = &httpStats
+	return traceInfo
 }
 
 type callStats struct {
@@ -463,15 +721,20 @@ func shortTrace(ti madmin.ServiceTraceInfo) shortTraceMsg {
 	return s
 }
 
-func (s shortTraceMsg) JSON() string {
+// record - the trace as printed in JSON.
+func (s shortTraceMsg) record() interface{} {
 	s.Status = "success"
+	return s
+}
+
+func (s shortTraceMsg) JSON() string {
 	buf := &bytes.Buffer{}
 	enc := json.NewEncoder(buf)
 	enc.SetIndent("", " ")
 	// Disable escaping special chars to display XML tags correctly
 	enc.SetEscapeHTML(false)
 
-	fatalIf(probe.NewError(enc.Encode(s)), "Unable to marshal into JSON.")
+	fatalIf(probe.NewError(enc.Encode(s.record())), "Unable to marshal into JSON.")
 	return buf.String()
 }
 
@@ -539,9 +802,8 @@ func colorizedNodeName(nodeName string) string {
 	return console.Colorize(fmt.Sprintf("Node%d", colors[idx]), nodeName)
 }
 
-func (t traceMessage) JSON() string {
-	t.Status = "success"
-
+// record - the trace as printed in JSON.
+func (t traceMessage) record() interface{} {
 	trc := verboseTrace{
 		trcType:    t.Trace.TraceType,
 		Type:       t.Trace.TraceType.String(),
@@ -585,6 +847,11 @@ func (t traceMessage) JSON() string {
 			Headers:    rspHdrs,
 			StatusCode: rs.StatusCode,
 		}
+		if t.bodyLimit > 0 {
+			trc.RequestInfo.Body, trc.ResponseInfo.Body = "", ""
+			trc.RequestInfo.traceBody = newTraceBody(rq.Body, t.bodyLimit)
+			trc.ResponseInfo.traceBody = newTraceBody(rs.Body, t.bodyLimit)
+		}
 		trc.CallStats = &callStats{
 			Duration: t.Trace.Duration,
 			Rx:       t.Trace.HTTP.CallStats.InputBytes,
@@ -592,12 +859,16 @@ func (t traceMessage) JSON() string {
 			Ttfb:     t.Trace.HTTP.CallStats.TimeToFirstByte,
 		}
 	}
+	return trc
+}
+
+func (t traceMessage) JSON() string {
 	buf := &bytes.Buffer{}
 	enc := json.NewEncoder(buf)
 	enc.SetIndent("", " ")
 	// Disable escaping special chars to display XML tags correctly
 	enc.SetEscapeHTML(false)
-	fatalIf(probe.NewError(enc.Encode(trc)), "Unable to marshal into JSON.")
+	fatalIf(probe.NewError(enc.Encode(t.record())), "Unable to marshal into JSON.")
 
 	// strip off extra newline added by json encoder
 	return strings.TrimSuffix(buf.String(), "\n")
@@ -647,7 +918,7 @@ func (t traceMessage) String() string {
 			fmt.Sprintf("%s: ", k))+console.Colorize("HeaderValue", fmt.Sprintf("%s\n", strings.Join(v, ""))))
 	}
 
-	fmt.Fprintf(b, "%s%s", nodeNameStr, console.Colorize("Body", fmt.Sprintf("%s\n", string(ri.Body))))
+	fmt.Fprintf(b, "%s%s", nodeNameStr, console.Colorize("Body", fmt.Sprintf("%s\n", traceBodyString(ri.Body, t.bodyLimit))))
 	fmt.Fprintf(b, "%s%s", nodeNameStr, console.Colorize("Response", "[RESPONSE] "))
 	fmt.Fprintf(b, "[%s] ", rs.Time.Local().Format(traceTimeFormat))
 	fmt.Fprint(b, console.Colorize("Stat", fmt.Sprintf("[ Duration %2s  ↑ %s  ↓ %s ]\n", trc.HTTP.CallStats.Latency.Round(time.Microsecond), humanize.IBytes(uint64(trc.HTTP.CallStats.InputBytes)), humanize.IBytes(uint64(trc.HTTP.CallStats.OutputBytes)))))
@@ -662,7 +933,7 @@ func (t traceMessage) String() string {
 		fmt.Fprintf(b, "%s%s", nodeNameStr, console.Colorize("RespHeaderKey",
 			fmt.Sprintf("%s: ", k))+console.Colorize("HeaderValue", fmt.Sprintf("%s\n", strings.Join(v, ","))))
 	}
-	fmt.Fprintf(b, "%s%s\n", nodeNameStr, console.Colorize("Body", string(rs.Body)))
+	fmt.Fprintf(b, "%s%s\n", nodeNameStr, console.Colorize("Body", traceBodyString(rs.Body, t.bodyLimit)))
 	fmt.Fprint(b, nodeNameStr)
 	return b.String()
 }
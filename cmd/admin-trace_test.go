@@ -0,0 +1,128 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestParseStatusRanges(t *testing.T) {
+	testCases := []struct {
+		values   []string
+		expected []statusRange
+		success  bool
+	}{
+		{nil, nil, true},
+		{[]string{"500-599"}, []statusRange{{500, 599}}, true},
+		{[]string{"301,302", "404"}, []statusRange{{301, 301}, {302, 302}, {404, 404}}, true},
+		{[]string{"abc"}, nil, false},
+		{[]string{"500-"}, nil, false},
+		{[]string{"599-500"}, nil, false},
+		{[]string{"50-99"}, nil, false},
+		{[]string{"600"}, nil, false},
+	}
+	for i, testCase := range testCases {
+		ranges, err := parseStatusRanges(testCase.values)
+		if testCase.success != (err == nil) {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if testCase.success && !reflect.DeepEqual(ranges, testCase.expected) {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, ranges)
+		}
+	}
+}
+
+func TestMatchTraceStatusRanges(t *testing.T) {
+	newTrace := func(code int) madmin.ServiceTraceInfo {
+		var ti madmin.ServiceTraceInfo
+		ti.Trace.HTTP = &madmin.TraceHTTPStats{}
+		ti.Trace.HTTP.RespInfo.StatusCode = code
+		return ti
+	}
+	opts := matchOpts{statusRanges: []statusRange{{300, 399}, {503, 503}}}
+	testCases := []struct {
+		trace    madmin.ServiceTraceInfo
+		expected bool
+	}{
+		{newTrace(200), false},
+		{newTrace(301), true},
+		{newTrace(399), true},
+		{newTrace(500), false},
+		{newTrace(503), true},
+		// Non HTTP traces have no status.
+		{madmin.ServiceTraceInfo{}, false},
+	}
+	for i, testCase := range testCases {
+		if got := matchTrace(opts, testCase.trace); got != testCase.expected {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestTraceBodies(t *testing.T) {
+	var ti madmin.ServiceTraceInfo
+	ti.Trace.TraceType = madmin.TraceInternal
+	ti.Trace.HTTP = &madmin.TraceHTTPStats{}
+	ti.Trace.HTTP.ReqInfo.Headers = http.Header{
+		"Authorization":        {"AWS4-HMAC-SHA256 Credential=minio/20230101/us-east-1/s3/aws4_request, Signature=abcdef"},
+		"X-Amz-Security-Token": {"token"},
+		"Content-Type":         {"application/json"},
+	}
+	ti.Trace.HTTP.ReqInfo.Body = []byte(`{"policy":"readwrite"}`)
+	ti.Trace.HTTP.RespInfo.Body = []byte("ok")
+
+	redacted := redactTrace(ti)
+	hdrs := redacted.Trace.HTTP.ReqInfo.Headers
+	if got := hdrs.Get("Authorization"); got != "AWS4-HMAC-SHA256 Credential=minio/20230101/us-east-1/s3/aws4_request, Signature=*REDACTED*" {
+		t.Fatalf("unexpected Authorization %s", got)
+	}
+	if got := hdrs.Get("X-Amz-Security-Token"); got != "*REDACTED*" {
+		t.Fatalf("unexpected X-Amz-Security-Token %s", got)
+	}
+	if got := hdrs.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("unexpected Content-Type %s", got)
+	}
+	// The original trace is not modified.
+	if ti.Trace.HTTP.ReqInfo.Headers.Get("X-Amz-Security-Token") != "token" {
+		t.Fatal("original headers were modified")
+	}
+
+	msg := traceMessage{ServiceTraceInfo: redacted, bodyLimit: 4}
+	var trc struct {
+		Request  responseInfo `json:"request"`
+		Response responseInfo `json:"response"`
+	}
+	if e := json.Unmarshal([]byte(msg.JSON()), &trc); e != nil {
+		t.Fatal(e)
+	}
+	if trc.Request.Body != "" || trc.Request.BodyBase64 != "eyJwbw==" || trc.Request.BodyLength != 22 {
+		t.Fatalf("unexpected request body %+v", trc.Request)
+	}
+	if trc.Response.BodyBase64 != "b2s=" || trc.Response.BodyLength != 2 {
+		t.Fatalf("unexpected response body %+v", trc.Response)
+	}
+	if !strings.Contains(msg.String(), `{"po... (4 of 22 bytes)`) {
+		t.Fatalf("truncated body not found in %s", msg.String())
+	}
+}
@@ -31,13 +31,20 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+var adminUserAddFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "secret-file",
+		Usage: "read the secret key from a file",
+	},
+}
+
 var adminUserAddCmd = cli.Command{
 	Name:         "add",
 	Usage:        "add a new user",
 	Action:       mainAdminUserAdd,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(adminUserAddFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
@@ -48,7 +55,8 @@ ACCESSKEY:
   Also called as username.
 
 SECRETKEY:
-  Also called as password.
+  Also called as password. Use '-' to read it from stdin. Passing the secret
+  key as an argument is deprecated, prefer '-' or --secret-file.
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -68,6 +76,12 @@ EXAMPLES:
      {{.DisableHistory}}
      {{.Prompt}} echo -e "foobar\nfoobar12345" | {{.HelpName}} myminio
      {{.EnableHistory}}
+
+  4. Add a new user 'foobar' to MinIO server, reading the secret key from stdin.
+     {{.Prompt}} cat secret.txt | {{.HelpName}} myminio foobar -
+
+  5. Add a new user 'foobar' to MinIO server, reading the secret key from a file.
+     {{.Prompt}} {{.HelpName}} myminio foobar --secret-file secret.txt
 `,
 }
 
@@ -132,7 +146,7 @@ func (u userMessage) JSON() string {
 }
 
 // fetchUserKeys - returns the access and secret key
-func fetchUserKeys(args cli.Args) (string, string) {
+func fetchUserKeys(args cli.Args, secretFile string) (string, string) {
 	accessKey := ""
 	secretKey := ""
 	console.SetColor(cred, color.New(color.FgYellow, color.Italic))
@@ -151,7 +165,23 @@ func fetchUserKeys(args cli.Args) (string, string) {
 		accessKey = args.Get(1)
 	}
 
-	if argCount == 1 || argCount == 2 {
+	switch {
+	case secretFile != "":
+		if argCount == 3 {
+			fatalIf(errInvalidArgument().Trace(args...), "SECRETKEY cannot be used with --secret-file.")
+		}
+		data, e := os.ReadFile(secretFile)
+		fatalIf(probe.NewError(e), "Unable to read secret key file `"+secretFile+"`.")
+		secretKey = strings.TrimRight(string(data), "\r\n")
+		if secretKey == "" {
+			fatalIf(errInvalidArgument().Trace(secretFile), "Secret key file `"+secretFile+"` is empty.")
+		}
+	case argCount == 3 && args.Get(2) != "-":
+		secretKey = args.Get(2)
+		fmt.Fprintln(os.Stderr, console.Colorize("UserWarning",
+			"WARNING: passing SECRETKEY as an argument is deprecated, it may be saved in your shell history. "+
+				"Use '-' to read it from stdin or --secret-file instead."))
+	default:
 		if isTerminal {
 			fmt.Printf("%s", console.Colorize(cred, "Enter Secret Key: "))
 			bytePassword, _ := terminal.ReadPassword(int(os.Stdin.Fd()))
@@ -161,8 +191,6 @@ func fetchUserKeys(args cli.Args) (string, string) {
 			value, _, _ := reader.ReadLine()
 			secretKey = string(value)
 		}
-	} else {
-		secretKey = args.Get(2)
 	}
 
 	return accessKey, secretKey
@@ -173,11 +201,12 @@ func mainAdminUserAdd(ctx *cli.Context) error {
 	checkAdminUserAddSyntax(ctx)
 
 	console.SetColor("UserMessage", color.New(color.FgGreen))
+	console.SetColor("UserWarning", color.New(color.FgYellow))
 
 	// Get the alias parameter from cli
 	args := ctx.Args()
 	aliasedURL := args.Get(0)
-	accessKey, secretKey := fetchUserKeys(args)
+	accessKey, secretKey := fetchUserKeys(args, ctx.String("secret-file"))
 
 	// Create a new MinIO Admin Client
 	client, err := newAdminClient(aliasedURL)
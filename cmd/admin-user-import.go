@@ -0,0 +1,259 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var adminUserImportFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "validate the file and show what would change without applying it",
+	},
+}
+
+var adminUserImportCmd = cli.Command{
+	Name:         "import",
+	Usage:        "create or update users from a JSON file",
+	Action:       mainAdminUserImport,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        append(adminUserImportFlags, globalFlags...),
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} [FLAGS] TARGET FILE
+
+FILE:
+  A JSON array of user records, '-' reads it from stdin. Each record has the
+  fields "accessKey", "secretKey", "policy" and "status" (enabled/disabled).
+  "secretKey" is only required for new users.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Create or update the users listed in 'users.json' on MinIO server.
+     {{.Prompt}} {{.HelpName}} myminio users.json
+
+  2. Show what importing 'users.json' would change, without applying it.
+     {{.Prompt}} {{.HelpName}} --dry-run myminio users.json
+`,
+}
+
+// userImportRecord is a single entry of the file passed to
+// 'mc admin user import'.
+type userImportRecord struct {
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	Policy    string `json:"policy"`
+	Status    string `json:"status"`
+}
+
+// Actions taken for an imported user record.
+const (
+	userImportCreate    = "create"
+	userImportUpdate    = "update"
+	userImportUnchanged = "unchanged"
+)
+
+// userImportMessage reports the outcome of importing one user record.
+type userImportMessage struct {
+	Status    string `json:"status"`
+	AccessKey string `json:"accessKey"`
+	Action    string `json:"action,omitempty"`
+	DryRun    bool   `json:"dryRun,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (u userImportMessage) String() string {
+	if u.Error != "" {
+		return console.Colorize("UserImportFailed", fmt.Sprintf("Failed to import user `%s`: %s", u.AccessKey, u.Error))
+	}
+	var msg string
+	switch u.Action {
+	case userImportCreate:
+		msg = "Created user `" + u.AccessKey + "`."
+		if u.DryRun {
+			msg = "Would create user `" + u.AccessKey + "`."
+		}
+	case userImportUpdate:
+		msg = "Updated user `" + u.AccessKey + "`."
+		if u.DryRun {
+			msg = "Would update user `" + u.AccessKey + "`."
+		}
+	default:
+		msg = "User `" + u.AccessKey + "` is unchanged."
+	}
+	return console.Colorize("UserMessage", msg)
+}
+
+func (u userImportMessage) JSON() string {
+	jsonMessageBytes, e := json.MarshalIndent(u, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
+// checkAdminUserImportSyntax - validate all the passed arguments
+func checkAdminUserImportSyntax(ctx *cli.Context) {
+	if len(ctx.Args()) != 2 {
+		showCommandHelpAndExit(ctx, 1) // last argument is exit code
+	}
+}
+
+// readUserImportRecords decodes the JSON array of user records.
+func readUserImportRecords(r io.Reader) ([]userImportRecord, error) {
+	var records []userImportRecord
+	if e := json.NewDecoder(r).Decode(&records); e != nil {
+		return nil, e
+	}
+	return records, nil
+}
+
+// planUserImport validates a record against the current user, if any,
+// and returns the action importing it would take.
+func planUserImport(rec userImportRecord, existing *madmin.UserInfo) (string, error) {
+	switch {
+	case len(rec.AccessKey) < 3:
+		return "", errors.New("access key must be at least 3 characters")
+	case rec.SecretKey != "" && len(rec.SecretKey) < 8:
+		return "", errors.New("secret key must be at least 8 characters")
+	}
+	switch madmin.AccountStatus(rec.Status) {
+	case "", madmin.AccountEnabled, madmin.AccountDisabled:
+	default:
+		return "", fmt.Errorf("invalid status %q, expected enabled or disabled", rec.Status)
+	}
+
+	if existing == nil {
+		if rec.SecretKey == "" {
+			return "", errors.New("secret key is required to create a user")
+		}
+		return userImportCreate, nil
+	}
+	if rec.SecretKey != "" ||
+		(rec.Status != "" && madmin.AccountStatus(rec.Status) != existing.Status) ||
+		(rec.Policy != "" && rec.Policy != existing.PolicyName) {
+		return userImportUpdate, nil
+	}
+	return userImportUnchanged, nil
+}
+
+// applyUserImport creates or updates a user as planned by planUserImport.
+func applyUserImport(client *madmin.AdminClient, rec userImportRecord, existing *madmin.UserInfo) error {
+	status := madmin.AccountStatus(rec.Status)
+	if status == "" {
+		status = madmin.AccountEnabled
+		if existing != nil {
+			status = existing.Status
+		}
+	}
+
+	switch {
+	case rec.SecretKey != "":
+		if e := client.SetUser(globalContext, rec.AccessKey, rec.SecretKey, status); e != nil {
+			return e
+		}
+	case existing != nil && status != existing.Status:
+		if e := client.SetUserStatus(globalContext, rec.AccessKey, status); e != nil {
+			return e
+		}
+	}
+
+	if rec.Policy != "" && (existing == nil || rec.Policy != existing.PolicyName) {
+		return client.SetPolicy(globalContext, rec.Policy, rec.AccessKey, false)
+	}
+	return nil
+}
+
+// mainAdminUserImport is the handle for "mc admin user import" command.
+func mainAdminUserImport(ctx *cli.Context) error {
+	checkAdminUserImportSyntax(ctx)
+
+	console.SetColor("UserMessage", color.New(color.FgGreen))
+	console.SetColor("UserImportFailed", color.New(color.FgRed))
+
+	args := ctx.Args()
+	aliasedURL := args.Get(0)
+	importFile := args.Get(1)
+	dryRun := ctx.Bool("dry-run")
+
+	var reader io.Reader = os.Stdin
+	if importFile != "-" {
+		f, e := os.Open(importFile)
+		fatalIf(probe.NewError(e), "Unable to open users file `"+importFile+"`.")
+		defer f.Close()
+		reader = f
+	}
+	records, e := readUserImportRecords(reader)
+	fatalIf(probe.NewError(e), "Unable to parse users file `"+importFile+"`.")
+
+	// Create a new MinIO Admin Client
+	client, err := newAdminClient(aliasedURL)
+	fatalIf(err, "Unable to initialize admin connection.")
+
+	users, e := client.ListUsers(globalContext)
+	fatalIf(probe.NewError(e).Trace(args...), "Unable to list users.")
+
+	var failed bool
+	seen := make(map[string]struct{}, len(records))
+	for _, rec := range records {
+		msg := userImportMessage{Status: "success", AccessKey: rec.AccessKey, DryRun: dryRun}
+
+		var existing *madmin.UserInfo
+		if info, ok := users[rec.AccessKey]; ok {
+			existing = &info
+		}
+		action, e := planUserImport(rec, existing)
+		if e == nil {
+			if _, ok := seen[rec.AccessKey]; ok {
+				e = errors.New("duplicate access key")
+			}
+		}
+		seen[rec.AccessKey] = struct{}{}
+		if e == nil && !dryRun && action != userImportUnchanged {
+			e = applyUserImport(client, rec, existing)
+		}
+
+		msg.Action = action
+		if e != nil {
+			msg.Status = "error"
+			msg.Error = e.Error()
+			failed = true
+		}
+		printMsg(msg)
+	}
+
+	if failed {
+		return exitStatus(globalErrorExitStatus)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestReadUserImportRecords(t *testing.T) {
+	records, err := readUserImportRecords(strings.NewReader(`[
+ {"accessKey": "foobar", "secretKey": "foobar12345", "policy": "readwrite", "status": "enabled"},
+ {"accessKey": "barfoo", "status": "disabled"}
+]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Policy != "readwrite" || records[1].Status != "disabled" {
+		t.Fatalf("unexpected records %+v", records)
+	}
+	if _, err = readUserImportRecords(strings.NewReader(`{"accessKey": "foobar"}`)); err == nil {
+		t.Fatal("expected an error for a non-array document")
+	}
+}
+
+func TestPlanUserImport(t *testing.T) {
+	existing := &madmin.UserInfo{PolicyName: "readonly", Status: madmin.AccountEnabled}
+	testCases := []struct {
+		rec      userImportRecord
+		existing *madmin.UserInfo
+		action   string
+		wantErr  bool
+	}{
+		{userImportRecord{AccessKey: "foobar", SecretKey: "foobar12345"}, nil, userImportCreate, false},
+		{userImportRecord{AccessKey: "foobar"}, nil, "", true},
+		{userImportRecord{AccessKey: "fo", SecretKey: "foobar12345"}, nil, "", true},
+		{userImportRecord{AccessKey: "foobar", SecretKey: "short"}, nil, "", true},
+		{userImportRecord{AccessKey: "foobar", SecretKey: "foobar12345", Status: "on"}, nil, "", true},
+		{userImportRecord{AccessKey: "foobar"}, existing, userImportUnchanged, false},
+		{userImportRecord{AccessKey: "foobar", Policy: "readonly", Status: "enabled"}, existing, userImportUnchanged, false},
+		{userImportRecord{AccessKey: "foobar", Policy: "readwrite"}, existing, userImportUpdate, false},
+		{userImportRecord{AccessKey: "foobar", Status: "disabled"}, existing, userImportUpdate, false},
+		{userImportRecord{AccessKey: "foobar", SecretKey: "foobar12345"}, existing, userImportUpdate, false},
+	}
+	for i, tc := range testCases {
+		action, err := planUserImport(tc.rec, tc.existing)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, tc.wantErr, err)
+		}
+		if action != tc.action {
+			t.Errorf("Test %d: expected action %q, got %q", i+1, tc.action, action)
+		}
+	}
+}
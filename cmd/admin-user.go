@@ -26,6 +26,7 @@ var adminUserSubcommands = []cli.Command{
 	adminUserRemoveCmd,
 	adminUserListCmd,
 	adminUserInfoCmd,
+	adminUserImportCmd,
 	adminUserPolicyCmd,
 	adminUserSvcAcctCmd,
 }
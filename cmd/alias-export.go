@@ -0,0 +1,134 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+)
+
+var aliasExportFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "with-secrets",
+		Usage: "include secret keys, session tokens and license keys in the export",
+	},
+}
+
+var aliasExportCmd = cli.Command{
+	Name:            "export",
+	Usage:           "export aliases in a portable JSON format",
+	Action:          mainAliasExport,
+	OnUsageError:    onUsageError,
+	Before:          setGlobalsFromContext,
+	Flags:           append(aliasExportFlags, globalFlags...),
+	HideHelpCommand: true,
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} [FLAGS] [ALIAS...]
+
+  All aliases are exported if none are specified. Secrets are left out
+  unless --with-secrets is specified, use 'mc alias import' to merge the
+  exported aliases into the configuration of another machine.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Export all aliases, without their secrets, to aliases.json:
+     {{ .Prompt }} {{ .HelpName }} > aliases.json
+
+  2. Export the 'myminio' and 'play' aliases with their secrets and import them on another machine:
+     {{ .Prompt }} {{ .HelpName }} --with-secrets myminio play > aliases.json
+     {{ .Prompt }} mc alias import < aliases.json
+`,
+}
+
+// aliasExport - portable set of aliases written by 'mc alias export'.
+type aliasExport struct {
+	Version string                    `json:"version"`
+	Aliases map[string]aliasConfigV10 `json:"aliases"`
+	// Redacted is set when secrets were left out of the aliases.
+	Redacted bool `json:"redacted,omitempty"`
+}
+
+type aliasExportMessage struct {
+	Status string `json:"status"`
+	aliasExport
+}
+
+func (a aliasExportMessage) String() string {
+	msgBytes, e := json.MarshalIndent(a.aliasExport, "", " ")
+	fatalIf(probe.NewError(e), "Unable to export aliases.")
+	return string(msgBytes)
+}
+
+func (a aliasExportMessage) JSON() string {
+	a.Status = "success"
+	msgBytes, e := json.MarshalIndent(a, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// exportAliases - returns the named aliases of the config, or all of
+// them if no names are given, with the secrets removed unless asked for.
+func exportAliases(cfg *configV10, names []string, withSecrets bool) (aliasExport, error) {
+	if len(names) == 0 {
+		for name := range cfg.Aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	export := aliasExport{
+		Version:  globalMCConfigVersion,
+		Aliases:  make(map[string]aliasConfigV10, len(names)),
+		Redacted: !withSecrets,
+	}
+	for _, name := range names {
+		name = cleanAlias(name)
+		aliasCfg, ok := cfg.Aliases[name]
+		if !ok {
+			return export, fmt.Errorf("alias `%s` does not exist", name)
+		}
+		if !withSecrets {
+			aliasCfg.SecretKey = ""
+			aliasCfg.SessionToken = ""
+			aliasCfg.APIKey = ""
+			aliasCfg.License = ""
+		}
+		export.Aliases[name] = aliasCfg
+	}
+	return export, nil
+}
+
+func mainAliasExport(cli *cli.Context) error {
+	mcCfgV10, err := loadMcConfig()
+	fatalIf(err.Trace(globalMCConfigVersion), "Unable to load config `"+mustGetMcConfigPath()+"`.")
+
+	export, e := exportAliases(mcCfgV10, cli.Args(), cli.Bool("with-secrets"))
+	fatalIf(probe.NewError(e).Trace(cli.Args()...), "Unable to export aliases.")
+
+	printMsg(aliasExportMessage{aliasExport: export})
+	return nil
+}
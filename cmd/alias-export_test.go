@@ -0,0 +1,93 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestExportImportAliases(t *testing.T) {
+	local := aliasConfigV10{URL: "http://localhost:9000", AccessKey: "minioadmin", SecretKey: "minioadmin", API: "S3v4", Path: "auto"}
+	play := aliasConfigV10{URL: "https://play.min.io", AccessKey: "playkey", SecretKey: "playsecret", API: "S3v4", Path: "auto"}
+	src := &configV10{Version: globalMCConfigVersion, Aliases: map[string]aliasConfigV10{"local": local, "play": play}}
+
+	if _, e := exportAliases(src, []string{"missing"}, false); e == nil {
+		t.Fatal("expected exporting a missing alias to fail")
+	}
+	redacted, e := exportAliases(src, nil, false)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !redacted.Redacted || len(redacted.Aliases) != 2 || redacted.Aliases["play"].SecretKey != "" {
+		t.Fatalf("unexpected redacted export %+v", redacted)
+	}
+	full, e := exportAliases(src, []string{"play/"}, true)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if full.Redacted || full.Aliases["play"] != play {
+		t.Fatalf("unexpected export %+v", full)
+	}
+
+	changedPlay := play
+	changedPlay.URL = "https://play2.min.io"
+	dst := &configV10{Version: globalMCConfigVersion, Aliases: map[string]aliasConfigV10{"local": local, "play": changedPlay}}
+
+	// Redacted: local keeps its secret and is unchanged, play differs.
+	results := mergeAliases(dst, redacted, false)
+	if len(results) != 2 || results[0].Action != "unchanged" || results[1].Action != "skip" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	results = mergeAliases(dst, redacted, true)
+	if results[1].Action != "import" || dst.Aliases["play"] != play {
+		t.Fatalf("expected play to be overwritten with its local secret, got %+v", dst.Aliases["play"])
+	}
+
+	// Redacted aliases which do not exist locally have no secret to use.
+	empty := &configV10{Version: globalMCConfigVersion, Aliases: map[string]aliasConfigV10{}}
+	for _, result := range mergeAliases(empty, redacted, true) {
+		if result.Action != "skip" {
+			t.Errorf("expected %s to be skipped, got %s", result.Alias, result.Action)
+		}
+	}
+	results = mergeAliases(empty, full, false)
+	if len(results) != 1 || results[0].Action != "import" || empty.Aliases["play"] != play {
+		t.Fatalf("unexpected results %+v", results)
+	}
+
+	// STS aliases have no secret, they are imported from a redacted export
+	// and compared by their STS settings rather than by pointer.
+	sts := aliasConfigV10{URL: "https://sts.min.io", API: "S3v4", Path: "auto", STS: &aliasSTSConfigV10{Endpoint: "https://sts.min.io", TokenFile: "/var/run/token"}}
+	stsExport := aliasExport{Redacted: true, Aliases: map[string]aliasConfigV10{"sts": sts}}
+	results = mergeAliases(empty, stsExport, false)
+	if len(results) != 1 || results[0].Action != "import" {
+		t.Fatalf("expected the STS alias to be imported, got %+v", results)
+	}
+	stsCopy := sts
+	stsCopy.STS = &aliasSTSConfigV10{Endpoint: "https://sts.min.io", TokenFile: "/var/run/token"}
+	stsExport.Aliases["sts"] = stsCopy
+	results = mergeAliases(empty, stsExport, false)
+	if results[0].Action != "unchanged" {
+		t.Fatalf("expected an equal STS alias to be unchanged, got %+v", results)
+	}
+	stsCopy.STS = &aliasSTSConfigV10{Endpoint: "https://sts.min.io", TokenFile: "/var/run/other"}
+	stsExport.Aliases["sts"] = stsCopy
+	if results = mergeAliases(empty, stsExport, false); results[0].Action != "skip" {
+		t.Fatalf("expected a changed STS alias to be skipped, got %+v", results)
+	}
+}
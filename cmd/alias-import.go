@@ -19,14 +19,25 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/minio/mc/pkg/probe"
+	"golang.org/x/term"
 
 	"github.com/minio/cli"
 )
 
+var aliasImportFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "overwrite",
+		Usage: "replace existing aliases which differ from the imported ones",
+	},
+}
+
 var aliasImportCmd = cli.Command{
 	Name:            "import",
 	ShortName:       "i",
@@ -34,13 +45,14 @@ var aliasImportCmd = cli.Command{
 	Action:          mainAliasImport,
 	OnUsageError:    onUsageError,
 	Before:          setGlobalsFromContext,
-	Flags:           globalFlags,
+	Flags:           append(aliasImportFlags, globalFlags...),
 	HideHelpCommand: true,
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
   {{.HelpName}} ALIAS ./credentials.json
+  {{.HelpName}} [--overwrite] < ./aliases.json
 
   Credentials to be imported must be in the following JSON format:
   
@@ -52,6 +64,9 @@ USAGE:
     "path": "auto"
   }
 
+  Without ALIAS, all aliases written by 'mc alias export' are read from
+  standard input and merged into the config.
+
 FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
@@ -61,6 +76,9 @@ EXAMPLES:
 
   2. Import the credentials through standard input as 'myminio' to the config:
      {{ .Prompt }} cat credentials.json | {{ .HelpName }} myminio/
+
+  3. Merge the aliases exported by 'mc alias export', replacing existing aliases which differ:
+     {{ .Prompt }} {{ .HelpName }} --overwrite < aliases.json
 `,
 }
 
@@ -70,7 +88,12 @@ func checkAliasImportSyntax(ctx *cli.Context) {
 	argsNr := len(args)
 
 	if argsNr == 0 {
-		showCommandHelpAndExit(ctx, 1)
+		// Aliases are read from standard input, show help
+		// rather than waiting for the user to type them.
+		if term.IsTerminal(int(os.Stdin.Fd())) {
+			showCommandHelpAndExit(ctx, 1)
+		}
+		return
 	}
 	if argsNr > 2 {
 		fatalIf(errInvalidArgument().Trace(ctx.Args().Tail()...),
@@ -129,6 +152,109 @@ func importAlias(alias string, aliasCfgV10 aliasConfigV10) aliasMessage {
 	}
 }
 
+// aliasImportResult - outcome of merging one exported alias.
+type aliasImportResult struct {
+	Alias  string
+	Config aliasConfigV10
+	// Action is one of "import", "unchanged" or "skip".
+	Action string
+	Reason string
+}
+
+// mergeAliases - merges the exported aliases into cfg, existing aliases
+// which differ are replaced only with overwrite. Aliases exported without
+// secrets keep the secrets of the local alias with the same access key,
+// STS aliases have no secret and are imported without one.
+func mergeAliases(cfg *configV10, export aliasExport, overwrite bool) []aliasImportResult {
+	var names []string
+	for name := range export.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]aliasImportResult, 0, len(names))
+	for _, name := range names {
+		in := export.Aliases[name]
+		result := aliasImportResult{Alias: name, Config: in, Action: "skip"}
+		cur, exists := cfg.Aliases[name]
+		switch {
+		case !isValidAlias(name):
+			result.Reason = "invalid alias name"
+		case export.Redacted && in.STS == nil && (!exists || cur.AccessKey != in.AccessKey):
+			result.Reason = "secret key was not exported, export with --with-secrets"
+		default:
+			if export.Redacted && exists && cur.AccessKey == in.AccessKey {
+				in.SecretKey, in.SessionToken, in.APIKey, in.License = cur.SecretKey, cur.SessionToken, cur.APIKey, cur.License
+				result.Config = in
+			}
+			switch {
+			case exists && aliasConfigEqual(cur, in):
+				result.Action = "unchanged"
+			case exists && !overwrite:
+				result.Reason = "alias exists with a different configuration, use --overwrite to replace it"
+			default:
+				result.Action = "import"
+				cfg.Aliases[name] = in
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// aliasConfigEqual - compares two alias configs, including the STS
+// settings by value.
+func aliasConfigEqual(a, b aliasConfigV10) bool {
+	if (a.STS == nil) != (b.STS == nil) || (a.STS != nil && *a.STS != *b.STS) {
+		return false
+	}
+	a.STS, b.STS = nil, nil
+	return a == b
+}
+
+// importAliases - merges the aliases written by 'mc alias export' into
+// the config.
+func importAliases(r io.Reader, overwrite bool) error {
+	input, e := io.ReadAll(r)
+	fatalIf(probe.NewError(e), "Unable to read aliases")
+
+	var export aliasExport
+	e = json.Unmarshal(input, &export)
+	fatalIf(probe.NewError(e), "Unable to parse input aliases")
+	if len(export.Aliases) == 0 {
+		fatalIf(probe.NewError(errors.New("no aliases found")), "Unable to import aliases")
+	}
+
+	mcCfgV10, err := loadMcConfig()
+	fatalIf(err.Trace(globalMCConfigVersion), "Unable to load config `"+mustGetMcConfigPath()+"`.")
+
+	results := mergeAliases(mcCfgV10, export, overwrite)
+	for _, result := range results {
+		if result.Action == "import" {
+			checkCredentialsSyntax(result.Config)
+		}
+	}
+	fatalIf(saveMcConfig(mcCfgV10), "Unable to import aliases to `"+mustGetMcConfigPath()+"`.")
+
+	var cErr error
+	for _, result := range results {
+		if result.Action == "skip" {
+			errorIf(errInvalidArgument().Trace(result.Alias), "Skipped alias `"+result.Alias+"`: "+result.Reason+".")
+			cErr = exitStatus(globalErrorExitStatus)
+			continue
+		}
+		printMsg(aliasMessage{
+			op:        result.Action,
+			Alias:     result.Alias,
+			URL:       result.Config.URL,
+			AccessKey: result.Config.AccessKey,
+			API:       result.Config.API,
+			Path:      result.Config.Path,
+		})
+	}
+	return cErr
+}
+
 func mainAliasImport(cli *cli.Context) error {
 	var (
 		args  = cli.Args()
@@ -136,6 +262,9 @@ func mainAliasImport(cli *cli.Context) error {
 	)
 
 	checkAliasImportSyntax(cli)
+	if len(args) == 0 {
+		return importAliases(os.Stdin, cli.Bool("overwrite"))
+	}
 	var credentialsJSON aliasConfigV10
 
 	credsFile := strings.TrimSpace(args.Get(1))
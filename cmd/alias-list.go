@@ -18,14 +18,26 @@
 package cmd
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	"github.com/minio/pkg/console"
 )
 
+var aliasListFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "ping",
+		Usage: "check whether each alias is reachable and measure its latency",
+	},
+}
+
 var aliasListCmd = cli.Command{
 	Name:      "list",
 	ShortName: "ls",
@@ -34,7 +46,7 @@ var aliasListCmd = cli.Command{
 		return mainAliasList(ctx, false)
 	},
 	Before:          setGlobalsFromContext,
-	Flags:           globalFlags,
+	Flags:           append(aliasListFlags, globalFlags...),
 	OnUsageError:    onUsageError,
 	HideHelpCommand: true,
 	CustomHelpTemplate: `NAME:
@@ -52,6 +64,9 @@ EXAMPLES:
 
   2. List a specific alias.
      {{.Prompt}} {{.HelpName}} s3
+
+  3. List all aliases and check which of them are reachable.
+     {{.Prompt}} {{.HelpName}} --ping
 `,
 }
 
@@ -75,6 +90,8 @@ func mainAliasList(ctx *cli.Context, deprecated bool) error {
 	console.SetColor("SecretKey", color.New(color.FgCyan))
 	console.SetColor("API", color.New(color.FgBlue))
 	console.SetColor("Path", color.New(color.FgCyan))
+	console.SetColor("Reachable", color.New(color.FgGreen))
+	console.SetColor("Unreachable", color.New(color.FgRed))
 
 	alias := cleanAlias(ctx.Args().Get(0))
 
@@ -82,6 +99,9 @@ func mainAliasList(ctx *cli.Context, deprecated bool) error {
 	for i := range aliasesMsgs {
 		aliasesMsgs[i].op = "list"
 	}
+	if ctx.Bool("ping") {
+		pingAliases(globalContext, aliasesMsgs)
+	}
 	printAliases(aliasesMsgs...)
 	return nil
 }
@@ -99,7 +119,7 @@ func printAliases(aliases ...aliasMessage) {
 			// Format properly for alignment based on alias length only in non json mode.
 			alias.Alias = fmt.Sprintf("%-*.*s", maxAlias, maxAlias, alias.Alias)
 		}
-		if alias.AccessKey == "" || alias.SecretKey == "" {
+		if alias.STS == nil && (alias.AccessKey == "" || alias.SecretKey == "") {
 			alias.AccessKey = ""
 			alias.SecretKey = ""
 			alias.API = ""
@@ -130,6 +150,7 @@ func listAliases(alias string, deprecated bool) (aliases []aliasMessage) {
 				AccessKey:   v.AccessKey,
 				SecretKey:   v.SecretKey,
 				API:         v.API,
+				STS:         v.STS,
 			}
 
 			if deprecated {
@@ -151,6 +172,7 @@ func listAliases(alias string, deprecated bool) (aliases []aliasMessage) {
 			AccessKey:   v.AccessKey,
 			SecretKey:   v.SecretKey,
 			API:         v.API,
+			STS:         v.STS,
 		}
 
 		if deprecated {
@@ -166,3 +188,72 @@ func listAliases(alias string, deprecated bool) (aliases []aliasMessage) {
 	sort.Sort(byAlias(aliases))
 	return
 }
+
+// aliasPingTimeout - how long to wait for an alias to respond.
+const aliasPingTimeout = 5 * time.Second
+
+// aliasPingStatus - connectivity of an alias.
+type aliasPingStatus struct {
+	Reachable bool   `json:"reachable"`
+	Latency   string `json:"latency,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (p aliasPingStatus) String() string {
+	if !p.Reachable {
+		return "unreachable (" + p.Error + ")"
+	}
+	return "reachable (" + p.Latency + ")"
+}
+
+// pingAlias - sends a HEAD request to the alias URL, any HTTP response,
+// including an authentication failure, means the endpoint is reachable.
+func pingAlias(ctx context.Context, clnt *http.Client, url string) aliasPingStatus {
+	ctx, cancel := context.WithTimeout(ctx, aliasPingTimeout)
+	defer cancel()
+
+	req, e := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if e != nil {
+		return aliasPingStatus{Error: e.Error()}
+	}
+	start := time.Now()
+	resp, e := clnt.Do(req)
+	if e != nil {
+		return aliasPingStatus{Error: e.Error()}
+	}
+	resp.Body.Close()
+	return aliasPingStatus{Reachable: true, Latency: time.Since(start).Round(time.Millisecond / 10).String()}
+}
+
+// newPingClient - returns an HTTP client suitable for pingAlias.
+func newPingClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				RootCAs:            globalRootCAs,
+				MinVersion:         tls.VersionTLS12,
+				InsecureSkipVerify: globalInsecure,
+			},
+		},
+		// Redirects are a response as well.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// pingAliases - checks the connectivity of all aliases in parallel.
+func pingAliases(ctx context.Context, aliases []aliasMessage) {
+	clnt := newPingClient()
+	var wg sync.WaitGroup
+	for i := range aliases {
+		wg.Add(1)
+		go func(alias *aliasMessage) {
+			defer wg.Done()
+			status := pingAlias(ctx, clnt, alias.URL)
+			alias.Ping = &status
+		}(&aliases[i])
+	}
+	wg.Wait()
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPingAliases(t *testing.T) {
+	// An authentication failure still means the endpoint is reachable.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	aliases := []aliasMessage{
+		{Alias: "up", URL: srv.URL},
+		{Alias: "down", URL: "http://127.0.0.1:1"},
+	}
+	pingAliases(context.Background(), aliases)
+
+	if p := aliases[0].Ping; p == nil || !p.Reachable || p.Latency == "" {
+		t.Errorf("expected %s to be reachable, got %+v", aliases[0].Alias, p)
+	}
+	if p := aliases[1].Ping; p == nil || p.Reachable || p.Error == "" {
+		t.Errorf("expected %s to be unreachable, got %+v", aliases[1].Alias, p)
+	}
+}
@@ -39,9 +39,11 @@ var aliasFlags = []cli.Flag{}
 
 var aliasSubcommands = []cli.Command{
 	aliasSetCmd,
+	aliasSetSTSCmd,
 	aliasListCmd,
 	aliasRemoveCmd,
 	aliasImportCmd,
+	aliasExportCmd,
 }
 
 var aliasCmd = cli.Command{
@@ -65,13 +67,15 @@ func mainAlias(ctx *cli.Context) error {
 type aliasMessage struct {
 	op          string
 	prettyPrint bool
-	Status      string `json:"status"`
-	Alias       string `json:"alias"`
-	URL         string `json:"URL"`
-	AccessKey   string `json:"accessKey,omitempty"`
-	SecretKey   string `json:"secretKey,omitempty"`
-	API         string `json:"api,omitempty"`
-	Path        string `json:"path,omitempty"`
+	Status      string             `json:"status"`
+	Alias       string             `json:"alias"`
+	URL         string             `json:"URL"`
+	AccessKey   string             `json:"accessKey,omitempty"`
+	SecretKey   string             `json:"secretKey,omitempty"`
+	API         string             `json:"api,omitempty"`
+	Path        string             `json:"path,omitempty"`
+	STS         *aliasSTSConfigV10 `json:"sts,omitempty"`
+	Ping        *aliasPingStatus   `json:"ping,omitempty"`
 	// Deprecated field, replaced by Path
 	Lookup string `json:"lookup,omitempty"`
 }
@@ -82,21 +86,38 @@ type aliasMessage struct {
 func (h aliasMessage) String() string {
 	switch h.op {
 	case "list":
-		// Create a new pretty table with cols configuration
-		t := newPrettyRecord(2,
-			Row{"Alias", "Alias"},
-			Row{"URL", "URL"},
-			Row{"AccessKey", "AccessKey"},
-			Row{"SecretKey", "SecretKey"},
-			Row{"API", "API"},
-			Row{"Path", "Path"},
-		)
 		// Handle deprecated lookup
 		path := h.Path
 		if path == "" {
 			path = h.Lookup
 		}
-		return t.buildRecord(h.Alias, h.URL, h.AccessKey, h.SecretKey, h.API, path)
+		// Create a new pretty table with cols configuration
+		rows := []Row{
+			{"Alias", "Alias"},
+			{"URL", "URL"},
+			{"AccessKey", "AccessKey"},
+			{"SecretKey", "SecretKey"},
+			{"API", "API"},
+			{"Path", "Path"},
+		}
+		contents := []string{h.Alias, h.URL, h.AccessKey, h.SecretKey, h.API, path}
+		if h.STS != nil {
+			token := "file " + h.STS.TokenFile
+			if h.STS.TokenEnv != "" {
+				token = "env " + h.STS.TokenEnv
+			}
+			rows[2], rows[3] = Row{"RoleARN", "RoleARN"}, Row{"Token", "Token"}
+			contents[2], contents[3] = h.STS.RoleARN, token
+		}
+		if h.Ping != nil {
+			theme := "Reachable"
+			if !h.Ping.Reachable {
+				theme = "Unreachable"
+			}
+			rows = append(rows, Row{"Status", theme})
+			contents = append(contents, h.Ping.String())
+		}
+		return newPrettyRecord(2, rows...).buildRecord(contents...)
 	case "remove":
 		return console.Colorize("AliasMessage", "Removed `"+h.Alias+"` successfully.")
 	case "add": // add is deprecated
@@ -105,6 +126,8 @@ func (h aliasMessage) String() string {
 		return console.Colorize("AliasMessage", "Added `"+h.Alias+"` successfully.")
 	case "import":
 		return console.Colorize("AliasMessage", "Imported `"+h.Alias+"` successfully.")
+	case "unchanged":
+		return console.Colorize("AliasMessage", "`"+h.Alias+"` is already up to date.")
 	default:
 		return ""
 	}
@@ -0,0 +1,173 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/minio/cli"
+	"github.com/minio/pkg/console"
+)
+
+var aliasSetSTSFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "role-arn",
+		Usage: "ARN of the role to assume, optional when the server maps claims to policies",
+	},
+	cli.StringFlag{
+		Name:  "token-file",
+		Usage: "file holding the identity token (JWT), read again on every refresh",
+	},
+	cli.StringFlag{
+		Name:  "token-env",
+		Usage: "environment variable holding the identity token (JWT)",
+	},
+	cli.StringFlag{
+		Name:  "duration",
+		Usage: "requested session duration e.g. 1h, defaults to the server setting",
+	},
+	cli.StringFlag{
+		Name:  "sts-endpoint",
+		Usage: "STS endpoint, defaults to the alias URL",
+	},
+	cli.StringFlag{
+		Name:  "path",
+		Value: "auto",
+		Usage: "bucket path lookup supported by the server. Valid options are '[auto, on, off]'",
+	},
+	cli.BoolFlag{
+		Name:  "test",
+		Usage: "verify the endpoint and credentials by listing buckets after saving the alias",
+	},
+}
+
+var aliasSetSTSCmd = cli.Command{
+	Name:            "set-sts",
+	Usage:           "set a new alias using temporary credentials from an identity token",
+	Action:          mainAliasSetSTS,
+	OnUsageError:    onUsageError,
+	Before:          setGlobalsFromContext,
+	Flags:           append(aliasSetSTSFlags, globalFlags...),
+	HideHelpCommand: true,
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} ALIAS URL --token-file FILE | --token-env NAME [FLAGS]
+
+  Temporary credentials are requested with AssumeRoleWithWebIdentity when
+  the alias is first used and are refreshed automatically before the session
+  expires, no access or secret keys are stored in the configuration file.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Add MinIO service under "myminio" alias, using the identity token mounted by Kubernetes.
+     {{.Prompt}} {{.HelpName}} myminio https://minio.example.com \
+                 --token-file /var/run/secrets/tokens/minio-token
+
+  2. Add MinIO service under "myminio" alias, assuming a role for sessions of 12 hours
+     with the token in the environment variable OIDC_TOKEN.
+     {{.Prompt}} {{.HelpName}} myminio https://minio.example.com --token-env OIDC_TOKEN \
+                 --role-arn arn:minio:iam:::role/dashboard-role --duration 12h
+
+  3. Add MinIO service under "myminio" alias and verify that the token is accepted.
+     {{.Prompt}} {{.HelpName}} myminio https://minio.example.com --token-file ./token --test
+`,
+}
+
+// minSTSDuration - shortest session which STS servers accept.
+const minSTSDuration = 15 * time.Minute
+
+// parseAliasSetSTS - validates the arguments of 'alias set-sts' and
+// returns the STS configuration to save.
+func parseAliasSetSTS(ctx *cli.Context) (alias, url string, sts *aliasSTSConfigV10) {
+	args := ctx.Args()
+	if len(args) == 0 {
+		showCommandHelpAndExit(ctx, 1) // last argument is exit code
+	}
+	if len(args) != 2 {
+		fatalIf(errInvalidArgument().Trace(args...),
+			"Incorrect number of arguments for alias set-sts command.")
+	}
+
+	alias = cleanAlias(args.Get(0))
+	url = trimTrailingSeparator(args.Get(1))
+	if !isValidAlias(alias) {
+		fatalIf(errInvalidAlias(alias), "Invalid alias.")
+	}
+	if !isValidHostURL(url) {
+		fatalIf(errInvalidURL(url), "Invalid URL.")
+	}
+	if !isValidPath(ctx.String("path")) {
+		fatalIf(errInvalidArgument().Trace(ctx.String("path")),
+			"Unrecognized path value. Valid options are `[auto, on, off]`.")
+	}
+
+	sts = &aliasSTSConfigV10{
+		Endpoint:  trimTrailingSeparator(ctx.String("sts-endpoint")),
+		RoleARN:   ctx.String("role-arn"),
+		TokenFile: ctx.String("token-file"),
+		TokenEnv:  ctx.String("token-env"),
+	}
+	if (sts.TokenFile == "") == (sts.TokenEnv == "") {
+		fatalIf(errInvalidArgument(), "Exactly one of --token-file or --token-env is required.")
+	}
+	if sts.Endpoint != "" && !isValidHostURL(sts.Endpoint) {
+		fatalIf(errInvalidURL(sts.Endpoint), "Invalid STS endpoint.")
+	}
+	if d := ctx.String("duration"); d != "" {
+		duration, e := time.ParseDuration(d)
+		if e != nil || duration < minSTSDuration {
+			fatalIf(errInvalidArgument().Trace(d),
+				"Invalid session duration, must be at least "+minSTSDuration.String()+".")
+		}
+		sts.Duration = int(duration.Seconds())
+	}
+	return alias, url, sts
+}
+
+func mainAliasSetSTS(cliCtx *cli.Context) error {
+	console.SetColor("AliasMessage", color.New(color.FgGreen))
+	alias, url, sts := parseAliasSetSTS(cliCtx)
+
+	ctx, cancelAliasSet := context.WithCancel(globalContext)
+	defer cancelAliasSet()
+
+	mcCfgV10, err := loadMcConfig()
+	fatalIf(err.Trace(globalMCConfigVersion), "Unable to load config `"+mustGetMcConfigPath()+"`.")
+	prevCfg, existed := mcCfgV10.Aliases[alias]
+
+	// Admin APIs and STS only support signature v4.
+	msg := setAlias(alias, aliasConfigV10{
+		URL:  url,
+		API:  "S3v4",
+		Path: cliCtx.String("path"),
+		STS:  sts,
+	})
+	msg.op = "set"
+	printMsg(msg)
+
+	if cliCtx.Bool("test") {
+		return verifyAlias(ctx, alias, url, prevCfg, existed)
+	}
+	return nil
+}
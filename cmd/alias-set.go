@@ -32,6 +32,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/pkg/console"
@@ -51,6 +52,10 @@ var aliasSetFlags = []cli.Flag{
 		Name:  "api",
 		Usage: "API signature. Valid options are '[S3v4, S3v2]'",
 	},
+	cli.BoolFlag{
+		Name:  "test",
+		Usage: "verify the endpoint and credentials by listing buckets after saving the alias",
+	},
 }
 
 var aliasSetCmd = cli.Command{
@@ -97,6 +102,10 @@ EXAMPLES:
      {{.Prompt}} echo -e "BKIKJAA5BMMU2RHO6IBB\nV8f1CwQqAcwo80UEIJEjc5gVQUSSx5ohQ9GSrr12" | \
                  {{.HelpName}} mys3 https://s3.amazonaws.com --api "s3v4" --path "off"
      {{.EnableHistory}}
+  6. Add MinIO service under "myminio" alias and verify that the credentials work.
+     {{.DisableHistory}}
+     {{.Prompt}} {{.HelpName}} myminio http://localhost:9000 minio minio123 --test
+     {{.EnableHistory}}
 `,
 }
 
@@ -174,6 +183,7 @@ func setAlias(alias string, aliasCfgV10 aliasConfigV10) aliasMessage {
 		SecretKey: aliasCfgV10.SecretKey,
 		API:       aliasCfgV10.API,
 		Path:      aliasCfgV10.Path,
+		STS:       aliasCfgV10.STS,
 	}
 }
 
@@ -341,6 +351,10 @@ func mainAliasSet(cli *cli.Context, deprecated bool) error {
 	s3Config, err := BuildS3Config(ctx, url, alias, accessKey, secretKey, api, path, peerCert)
 	fatalIf(err.Trace(cli.Args()...), "Unable to initialize new alias from the provided credentials.")
 
+	mcCfgV10, err := loadMcConfig()
+	fatalIf(err.Trace(globalMCConfigVersion), "Unable to load config `"+mustGetMcConfigPath()+"`.")
+	prevCfg, existed := mcCfgV10.Aliases[alias]
+
 	msg := setAlias(alias, aliasConfigV10{
 		URL:       s3Config.HostURL,
 		AccessKey: s3Config.AccessKey,
@@ -355,9 +369,95 @@ func mainAliasSet(cli *cli.Context, deprecated bool) error {
 	}
 
 	printMsg(msg)
+
+	if cli.Bool("test") {
+		return verifyAlias(ctx, alias, s3Config.HostURL, prevCfg, existed)
+	}
 	return nil
 }
 
+// verifyAlias - tests a just saved alias, on failure offers to remove
+// it, or to restore its previous configuration.
+func verifyAlias(ctx context.Context, alias, url string, prevCfg aliasConfigV10, existed bool) error {
+	buckets, err := testAlias(ctx, alias)
+	listDenied := err != nil && minio.ToErrorResponse(err.ToGoError()).Code == "AccessDenied"
+	if err != nil && !listDenied {
+		errorIf(err.Trace(alias), "Unable to verify the credentials of `"+alias+"`.")
+		if !globalJSON && terminal.IsTerminal(int(os.Stdin.Fd())) && confirmAliasRevert(alias, existed) {
+			if existed {
+				setAlias(alias, prevCfg)
+				console.Infoln("Restored the previous configuration of `" + alias + "`.")
+			} else {
+				printMsg(aliasMessage{op: "remove", Alias: removeAlias(alias).Alias})
+			}
+		}
+		return exitStatus(globalErrorExitStatus)
+	}
+	printMsg(aliasTestMessage{Alias: alias, URL: url, Buckets: buckets, ListDenied: listDenied})
+	return nil
+}
+
+// aliasTestMessage - result of verifying a newly set alias.
+type aliasTestMessage struct {
+	Status  string `json:"status"`
+	Alias   string `json:"alias"`
+	URL     string `json:"URL"`
+	Buckets int    `json:"buckets"`
+	// ListDenied is set when the credentials are valid but not
+	// allowed to list buckets.
+	ListDenied bool `json:"listDenied,omitempty"`
+}
+
+func (a aliasTestMessage) String() string {
+	if a.ListDenied {
+		return console.Colorize("AliasMessage", fmt.Sprintf("Verified `%s`, %s accepted the credentials, listing buckets is not allowed.", a.Alias, a.URL))
+	}
+	return console.Colorize("AliasMessage", fmt.Sprintf("Verified `%s`, %s accepted the credentials and lists %d buckets.", a.Alias, a.URL, a.Buckets))
+}
+
+func (a aliasTestMessage) JSON() string {
+	a.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(a, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+// testAlias - lists the buckets of the alias, the cheapest authenticated
+// call which every S3 endpoint supports, returning how many there are.
+func testAlias(ctx context.Context, alias string) (int, *probe.Error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	clnt, err := newClient(alias)
+	if err != nil {
+		return 0, err
+	}
+	var buckets int
+	for content := range clnt.List(ctx, ListOptions{ShowDir: DirNone}) {
+		if content.Err != nil {
+			return 0, content.Err
+		}
+		buckets++
+	}
+	return buckets, nil
+}
+
+// confirmAliasRevert - asks whether to undo setting the alias after the
+// credentials failed verification.
+func confirmAliasRevert(alias string, existed bool) bool {
+	question := "Remove alias `" + alias + "`? y/N: "
+	if existed {
+		question = "Restore the previous configuration of `" + alias + "`? y/N: "
+	}
+	fmt.Print(question)
+	answer, e := bufio.NewReader(os.Stdin).ReadString('\n')
+	if e != nil {
+		return false
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y\n" || answer == "yes\n"
+}
+
 // configurePeerCertificate adds the peer certificate to the
 // TLS root CAs of s3Config. Once configured, any client
 // initialized with this config trusts the given peer certificate.
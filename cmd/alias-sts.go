@@ -0,0 +1,107 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// String - identifies the STS configuration, used as part of the
+// client cache key so that every alias keeps its own session.
+func (s *aliasSTSConfigV10) String() string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join([]string{s.Endpoint, s.RoleARN, s.TokenFile, s.TokenEnv, fmt.Sprint(s.Duration)}, "|")
+}
+
+// stsEndpoint - returns the configured STS endpoint, defaults to the
+// scheme and host of the alias URL.
+func stsEndpoint(sts *aliasSTSConfigV10, hostURL string) string {
+	if sts.Endpoint != "" {
+		return sts.Endpoint
+	}
+	u, e := url.Parse(hostURL)
+	if e != nil {
+		return hostURL
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// readWebIdentityToken - reads the identity token from its source, the
+// token is read again on every refresh so rotated tokens are picked up.
+func readWebIdentityToken(sts *aliasSTSConfigV10) (*credentials.WebIdentityToken, error) {
+	var token string
+	switch {
+	case sts.TokenFile != "":
+		data, e := os.ReadFile(sts.TokenFile)
+		if e != nil {
+			return nil, e
+		}
+		token = strings.TrimSpace(string(data))
+	case sts.TokenEnv != "":
+		token = strings.TrimSpace(os.Getenv(sts.TokenEnv))
+		if token == "" {
+			return nil, fmt.Errorf("environment variable %s is empty", sts.TokenEnv)
+		}
+	default:
+		return nil, errors.New("no identity token source configured")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("identity token file %s is empty", sts.TokenFile)
+	}
+	return &credentials.WebIdentityToken{Token: token, Expiry: sts.Duration}, nil
+}
+
+// newSTSCredentials - returns credentials fetched with
+// AssumeRoleWithWebIdentity, minio-go retrieves them on first use
+// and again when the session nears its expiry.
+func newSTSCredentials(config *Config) *credentials.Credentials {
+	tlsConfig := &tls.Config{
+		RootCAs:    globalRootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.Insecure {
+		tlsConfig.InsecureSkipVerify = true
+	}
+	sts := config.STS
+	return credentials.New(&credentials.STSWebIdentity{
+		Client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				DialContext:         newCustomDialContext(config),
+				TLSClientConfig:     tlsConfig,
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+		},
+		STSEndpoint: stsEndpoint(sts, config.HostURL),
+		RoleARN:     sts.RoleARN,
+		GetWebIDTokenExpiry: func() (*credentials.WebIdentityToken, error) {
+			return readWebIdentityToken(sts)
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSTSEndpoint(t *testing.T) {
+	testCases := []struct {
+		sts      aliasSTSConfigV10
+		hostURL  string
+		expected string
+	}{
+		{aliasSTSConfigV10{}, "https://minio.example.com", "https://minio.example.com"},
+		{aliasSTSConfigV10{}, "http://localhost:9000/bucket", "http://localhost:9000"},
+		{aliasSTSConfigV10{Endpoint: "https://sts.example.com"}, "http://localhost:9000", "https://sts.example.com"},
+	}
+	for i, testCase := range testCases {
+		if got := stsEndpoint(&testCase.sts, testCase.hostURL); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestReadWebIdentityToken(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if e := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+	emptyFile := filepath.Join(dir, "empty")
+	if e := os.WriteFile(emptyFile, nil, 0o600); e != nil {
+		t.Fatal(e)
+	}
+	t.Setenv("MC_TEST_STS_TOKEN", "env-token")
+
+	testCases := []struct {
+		sts         aliasSTSConfigV10
+		expected    string
+		shouldError bool
+	}{
+		{aliasSTSConfigV10{TokenFile: tokenFile, Duration: 3600}, "file-token", false},
+		{aliasSTSConfigV10{TokenEnv: "MC_TEST_STS_TOKEN"}, "env-token", false},
+		{aliasSTSConfigV10{TokenEnv: "MC_TEST_STS_TOKEN_UNSET"}, "", true},
+		{aliasSTSConfigV10{TokenFile: emptyFile}, "", true},
+		{aliasSTSConfigV10{TokenFile: filepath.Join(dir, "missing")}, "", true},
+		{aliasSTSConfigV10{}, "", true},
+	}
+	for i, testCase := range testCases {
+		token, e := readWebIdentityToken(&testCase.sts)
+		if testCase.shouldError {
+			if e == nil {
+				t.Errorf("Test %d: expected an error", i+1)
+			}
+			continue
+		}
+		if e != nil {
+			t.Fatalf("Test %d: unexpected error: %v", i+1, e)
+		}
+		if token.Token != testCase.expected || token.Expiry != testCase.sts.Duration {
+			t.Errorf("Test %d: expected %s/%d, got %s/%d", i+1, testCase.expected, testCase.sts.Duration, token.Token, token.Expiry)
+		}
+	}
+}
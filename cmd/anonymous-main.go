@@ -20,6 +20,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/url"
 	"os"
@@ -29,6 +30,7 @@ import (
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/bucket/policy"
 	"github.com/minio/pkg/console"
 )
 
@@ -52,7 +54,7 @@ var anonymousCmd = cli.Command{
 
 USAGE:
   {{.HelpName}} [FLAGS] set PERMISSION TARGET
-  {{.HelpName}} [FLAGS] set-json FILE TARGET
+  {{.HelpName}} [FLAGS] set-json [FILE] TARGET
   {{.HelpName}} [FLAGS] get TARGET
   {{.HelpName}} [FLAGS] get-json TARGET
   {{.HelpName}} [FLAGS] list TARGET
@@ -64,7 +66,7 @@ PERMISSION:
   Allowed policies are: [private, public, download, upload].
 
 FILE:
-  A valid S3 anonymous JSON filepath.
+  A valid S3 anonymous JSON filepath, the policy is read from STDIN if FILE is '-' or omitted.
 
 EXAMPLES:
   1. Set bucket to "download" on Amazon S3 cloud storage.
@@ -93,6 +95,9 @@ EXAMPLES:
 
   9. List public object URLs recursively.
      {{.Prompt}} {{.HelpName}} --recursive links s3/shared/
+
+  10. Set a bucket policy with conditions, such as a source IP restriction, read from STDIN.
+     {{.Prompt}} {{.HelpName}} set-json s3/shared < policy.json
 `,
 }
 
@@ -134,6 +139,9 @@ func (s anonymousMessage) String() string {
 			"Access permission for `"+s.Bucket+"`"+" is `"+string(s.Perms)+"`")
 	}
 	if s.Operation == "set-json" {
+		if s.Perms == "-" {
+			return console.Colorize("Anonymous", "Access permission for `"+s.Bucket+"` is set from STDIN")
+		}
 		return console.Colorize("Anonymous",
 			"Access permission for `"+s.Bucket+"`"+" is set from `"+string(s.Perms)+"`")
 	}
@@ -205,8 +213,9 @@ func checkAnonymousSyntax(ctx *cli.Context) {
 		}
 
 	case "set-json":
-		// Always expect three arguments when setting a anonymous permission.
-		if argsLength != 3 {
+		// Expect the policy file and the target, the policy is read
+		// from STDIN when the file is omitted.
+		if argsLength != 2 && argsLength != 3 {
 			showCommandHelpAndExit(ctx, 1)
 		}
 	case "get", "get-json":
@@ -266,11 +275,15 @@ func doSetAccessJSON(ctx context.Context, targetURL string, targetPERMS accessPe
 	if err != nil {
 		return err.Trace(targetURL)
 	}
-	fileReader, e := os.Open(string(targetPERMS))
-	if e != nil {
-		fatalIf(probe.NewError(e).Trace(), "Unable to set anonymous for `"+targetURL+"`.")
+	fileReader := io.Reader(os.Stdin)
+	if targetPERMS != "-" {
+		f, e := os.Open(string(targetPERMS))
+		if e != nil {
+			fatalIf(probe.NewError(e).Trace(), "Unable to set anonymous for `"+targetURL+"`.")
+		}
+		defer f.Close()
+		fileReader = f
 	}
-	defer fileReader.Close()
 
 	const maxJSONSize = 120 * 1024 // 120KiB
 	configBuf := make([]byte, maxJSONSize+1)
@@ -284,6 +297,14 @@ func doSetAccessJSON(ctx context.Context, targetURL string, targetPERMS accessPe
 	}
 
 	configBytes := configBuf[:n]
+
+	// Catch malformed policies before they reach the server.
+	clntURL := clnt.GetURL()
+	bucket, _ := url2BucketAndObject(&clntURL)
+	if _, e = policy.ParseConfig(bytes.NewReader(configBytes), bucket); e != nil {
+		return probe.NewError(fmt.Errorf("invalid bucket policy: %w", e)).Trace(targetURL)
+	}
+
 	if err = clnt.SetAccess(ctx, string(configBytes), true); err != nil {
 		return err.Trace(targetURL, string(targetPERMS))
 	}
@@ -429,7 +450,13 @@ func runAnonymousCmd(args cli.Args) {
 	var probeErr *probe.Error
 	perms := accessPerms(args.Get(1))
 	targetURL := args.Get(2)
-	if perms.isValidAccessPERM() {
+	if args.First() == "set-json" {
+		if len(args) == 2 {
+			perms, targetURL = "-", args.Get(1)
+		}
+		probeErr = doSetAccessJSON(ctx, targetURL, perms)
+		operation = "set-json"
+	} else if perms.isValidAccessPERM() {
 		operation = "set"
 		probeErr = doSetAccess(ctx, targetURL, perms)
 		if probeErr == nil {
@@ -287,6 +287,10 @@ var completeCmds = map[string]complete.Predictor{
 	"/version/enable":  s3Complete{deepLevel: 2},
 	"/version/suspend": s3Complete{deepLevel: 2},
 
+	"/quota/set":   s3Complete{deepLevel: 2},
+	"/quota/info":  s3Complete{deepLevel: 2},
+	"/quota/clear": s3Complete{deepLevel: 2},
+
 	"/lock/compliance": s3Completer,
 	"/lock/governance": s3Completer,
 	"/lock/clear":      s3Completer,
@@ -294,6 +298,7 @@ var completeCmds = map[string]complete.Predictor{
 
 	"/share/download": s3Completer,
 	"/share/list":     nil,
+	"/share/presign":  s3Completer,
 	"/share/upload":   s3Completer,
 
 	"/ilm/ls":      s3Complete{deepLevel: 2},
@@ -303,6 +308,7 @@ var completeCmds = map[string]complete.Predictor{
 	"/ilm/export":  s3Complete{deepLevel: 2},
 	"/ilm/import":  s3Complete{deepLevel: 2},
 	"/ilm/restore": s3Completer,
+	"/ilm/eval":    s3Complete{deepLevel: 2},
 
 	"/undo": s3Completer,
 
@@ -379,6 +385,8 @@ var completeCmds = map[string]complete.Predictor{
 	"/admin/policy/set":    aliasCompleter,
 	"/admin/policy/unset":  aliasCompleter,
 	"/admin/policy/update": aliasCompleter,
+	"/admin/policy/attach": aliasCompleter,
+	"/admin/policy/diff":   aliasCompleter,
 	"/admin/policy/add":    aliasCompleter,
 	"/admin/policy/list":   aliasCompleter,
 	"/admin/policy/remove": aliasCompleter,
@@ -389,6 +397,7 @@ var completeCmds = map[string]complete.Predictor{
 	"/admin/user/list":    aliasCompleter,
 	"/admin/user/remove":  aliasCompleter,
 	"/admin/user/info":    aliasCompleter,
+	"/admin/user/import":  aliasCompleter,
 	"/admin/user/policy":  aliasCompleter,
 
 	"/admin/user/svcacct/add":     aliasCompleter,
@@ -440,10 +449,12 @@ var completeCmds = map[string]complete.Predictor{
 	"/admin/cluster/iam/export":    aliasCompleter,
 	"/admin/cluster/iam/import":    aliasCompleter,
 
-	"/alias/set":    nil,
-	"/alias/list":   aliasCompleter,
-	"/alias/remove": aliasCompleter,
-	"/alias/import": nil,
+	"/alias/set":     nil,
+	"/alias/set-sts": nil,
+	"/alias/list":    aliasCompleter,
+	"/alias/remove":  aliasCompleter,
+	"/alias/import":  nil,
+	"/alias/export":  aliasCompleter,
 
 	"/support/callhome":     aliasCompleter,
 	"/support/logs/enable":  aliasCompleter,
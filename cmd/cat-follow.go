@@ -0,0 +1,69 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// defaultFollowInterval is how often `mc cat --follow` polls for new data.
+const defaultFollowInterval = time.Second
+
+// catFollow polls sourceURL every o.followInterval and writes any bytes
+// appended after offset to stdout, similar to `tail -f`. Only the new
+// bytes are fetched using ranged GETs. If the object shrinks, or its
+// ETag changes while the size stays the same, the object is considered
+// truncated or replaced and is streamed again from the beginning.
+func catFollow(ctx context.Context, sourceURL string, encKeyDB map[string][]prefixSSEPair, o catOpts, offset int64, etag string) *probe.Error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.followInterval):
+		}
+
+		_, content, err := url2Stat(ctx, sourceURL, "", false, encKeyDB, time.Time{}, false)
+		if err != nil {
+			return err.Trace(sourceURL)
+		}
+		if content.Size < offset || (content.Size == offset && content.ETag != etag) {
+			offset = 0
+		}
+		etag = content.ETag
+
+		length := content.Size - offset
+		if length == 0 {
+			continue
+		}
+		reader, err := getSourceStreamFromURL(ctx, sourceURL, encKeyDB, getSourceOpts{
+			GetOptions: GetOptions{RangeStart: offset, RangeLength: length},
+		})
+		if err != nil {
+			return err.Trace(sourceURL)
+		}
+		err = catOut(reader, length)
+		reader.Close()
+		if err != nil {
+			return err.Trace(sourceURL)
+		}
+		offset = content.Size
+	}
+}
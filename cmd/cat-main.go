@@ -49,12 +49,25 @@ var catFlags = []cli.Flag{
 	},
 	cli.Int64Flag{
 		Name:  "offset",
-		Usage: "start offset",
+		Usage: "start offset, negative values count from the end of the object",
+	},
+	cli.Int64Flag{
+		Name:  "length",
+		Usage: "number of bytes to display starting at offset",
 	},
 	cli.Int64Flag{
 		Name:  "tail",
 		Usage: "tail number of bytes at ending of file",
 	},
+	cli.BoolFlag{
+		Name:  "follow",
+		Usage: "keep polling the object and display newly appended data",
+	},
+	cli.DurationFlag{
+		Name:  "follow-interval",
+		Usage: "time to wait between polls with --follow",
+		Value: defaultFollowInterval,
+	},
 }
 
 // Display contents of a file.
@@ -99,6 +112,15 @@ EXAMPLES:
 
   7. Display the content of a particular object version
      {{.Prompt}} {{.HelpName}} --vid "3ddac055-89a7-40fa-8cd3-530a5581b6b8" play/my-bucket/my-object
+
+  8. Display 1KiB of an object starting at byte offset 4096, only the requested range is fetched.
+     {{.Prompt}} {{.HelpName}} --offset 4096 --length 1024 play/my-bucket/my-object
+
+  9. Display the first 512 bytes of the last 4KiB of an object.
+     {{.Prompt}} {{.HelpName}} --offset -4096 --length 512 play/my-bucket/my-object
+
+  10. Display the last 1KiB of a log object and keep displaying data appended to it every 5 seconds.
+      {{.Prompt}} {{.HelpName}} --tail 1024 --follow --follow-interval 5s myminio/logs/app.log
 `,
 }
 
@@ -156,9 +178,13 @@ type catOpts struct {
 	versionID string
 	timeRef   time.Time
 	startO    int64
+	lengthO   int64
 	tailO     int64
 	isZip     bool
 	stdinMode bool
+
+	follow         bool
+	followInterval time.Duration
 }
 
 // parseCatSyntax performs command-line input validation for cat command.
@@ -188,18 +214,33 @@ func parseCatSyntax(ctx *cli.Context) catOpts {
 	o.timeRef = parseRewindFlag(rewind)
 	o.isZip = ctx.Bool("zip")
 	o.startO = ctx.Int64("offset")
+	o.lengthO = ctx.Int64("length")
 	o.tailO = ctx.Int64("tail")
 	if o.tailO != 0 && o.startO != 0 {
 		fatalIf(errInvalidArgument().Trace(), "You cannot specify both --tail and --offset")
 	}
-	if o.tailO < 0 || o.startO < 0 {
-		fatalIf(errInvalidArgument().Trace(), "You cannot specify negative --tail or --offset")
+	if o.tailO < 0 || o.lengthO < 0 {
+		fatalIf(errInvalidArgument().Trace(), "You cannot specify negative --tail or --length")
 	}
-	if o.isZip && (o.tailO != 0 || o.startO != 0) {
-		fatalIf(errInvalidArgument().Trace(), "You cannot combine --zip with --tail or --offset")
+	if o.isZip && (o.tailO != 0 || o.startO != 0 || o.lengthO != 0) {
+		fatalIf(errInvalidArgument().Trace(), "You cannot combine --zip with --tail, --offset or --length")
 	}
-	if o.stdinMode && (o.isZip || o.startO != 0 || o.tailO != 0) {
-		fatalIf(errInvalidArgument().Trace(), "You cannot use --zip --tail or --offset with stdin")
+	if o.stdinMode && (o.isZip || o.startO != 0 || o.tailO != 0 || o.lengthO != 0) {
+		fatalIf(errInvalidArgument().Trace(), "You cannot use --zip, --tail, --offset or --length with stdin")
+	}
+
+	o.follow = ctx.Bool("follow")
+	o.followInterval = ctx.Duration("follow-interval")
+	if o.follow {
+		if o.stdinMode || len(o.args) != 1 || o.args[0] == "-" {
+			fatalIf(errInvalidArgument().Trace(), "You need to pass exactly one object with --follow")
+		}
+		if o.versionID != "" || rewind != "" || o.isZip || o.lengthO != 0 {
+			fatalIf(errInvalidArgument().Trace(), "You cannot combine --follow with --version-id, --rewind, --zip or --length")
+		}
+		if o.followInterval <= 0 {
+			fatalIf(errInvalidArgument().Trace(), "--follow-interval should be greater than zero")
+		}
 	}
 
 	return o
@@ -208,6 +249,7 @@ func parseCatSyntax(ctx *cli.Context) catOpts {
 // catURL displays contents of a URL to stdout.
 func catURL(ctx context.Context, sourceURL string, encKeyDB map[string][]prefixSSEPair, o catOpts) *probe.Error {
 	var reader io.ReadCloser
+	var etag string
 	size := int64(-1)
 	switch sourceURL {
 	case "-":
@@ -232,18 +274,37 @@ func catURL(ctx context.Context, sourceURL string, encKeyDB map[string][]prefixS
 					o.startO = 0
 				}
 			}
+			if o.startO < 0 {
+				// Negative offset counts from the end of the object.
+				o.startO += content.Size
+				if o.startO < 0 {
+					o.startO = 0
+				}
+			}
 
-			if client.GetURL().Type == objectStorage {
+			etag = content.ETag
+			// With --follow the exact size is needed for FS files as
+			// well, to know where to resume from on the next poll.
+			if client.GetURL().Type == objectStorage || o.follow {
 				size = content.Size - o.startO
 				if size < 0 {
 					err := probe.NewError(fmt.Errorf("specified offset (%d) bigger than file (%d)", o.startO, content.Size))
 					return err.Trace(sourceURL)
 				}
+				if o.lengthO > 0 && o.lengthO < size {
+					size = o.lengthO
+				}
 			}
 		} else {
 			return err.Trace(sourceURL)
 		}
-		gopts := GetOptions{VersionID: versionID, Zip: o.isZip, RangeStart: o.startO}
+		if o.follow {
+			if size == 0 {
+				return catFollow(ctx, sourceURL, encKeyDB, o, o.startO, etag).Trace(sourceURL)
+			}
+			o.lengthO = size
+		}
+		gopts := GetOptions{VersionID: versionID, Zip: o.isZip, RangeStart: o.startO, RangeLength: o.lengthO}
 		if reader, err = getSourceStreamFromURL(ctx, sourceURL, encKeyDB, getSourceOpts{
 			GetOptions: gopts,
 			fetchStat:  false,
@@ -253,7 +314,13 @@ func catURL(ctx context.Context, sourceURL string, encKeyDB map[string][]prefixS
 		}
 		defer reader.Close()
 	}
-	return catOut(reader, size).Trace(sourceURL)
+	if err := catOut(reader, size); err != nil {
+		return err.Trace(sourceURL)
+	}
+	if o.follow {
+		return catFollow(ctx, sourceURL, encKeyDB, o, o.startO+size, etag).Trace(sourceURL)
+	}
+	return nil
 }
 
 // catOut reads from reader stream and writes to stdout. Also check the length of the
@@ -58,7 +58,7 @@ func NewAdminFactory() func(config *Config) (*madmin.AdminClient, *probe.Error)
 
 		// Generate a hash out of s3Conf.
 		confHash := fnv.New32a()
-		confHash.Write([]byte(hostName + config.AccessKey + config.SecretKey))
+		confHash.Write([]byte(hostName + config.AccessKey + config.SecretKey + config.STS.String()))
 		confSum := confHash.Sum32()
 
 		// Lookup previous cache by hash.
@@ -69,6 +69,9 @@ func NewAdminFactory() func(config *Config) (*madmin.AdminClient, *probe.Error)
 		if api, found = clientCache[confSum]; !found {
 			// Admin API only supports signature v4.
 			creds := credentials.NewStaticV4(config.AccessKey, config.SecretKey, config.SessionToken)
+			if config.STS != nil {
+				creds = newSTSCredentials(config)
+			}
 
 			// Not found. Instantiate a new MinIO
 			var e error
@@ -107,6 +110,7 @@ func NewAdminFactory() func(config *Config) (*madmin.AdminClient, *probe.Error)
 			if config.Debug {
 				transport = httptracer.GetNewTraceTransport(newTraceV4(), transport)
 			}
+			transport = newRequestTimeoutTransport(transport, config.RequestTimeout)
 
 			// Set custom transport.
 			api.SetCustomTransport(transport)
@@ -214,6 +218,7 @@ func newAnonymousClient(aliasedURL string) (*madmin.AnonymousClient, *probe.Erro
 	if globalDebug {
 		transport = httptracer.GetNewTraceTransport(newTraceV4(), transport)
 	}
+	transport = newRequestTimeoutTransport(transport, globalRequestTimeout)
 	anonClient.SetCustomTransport(transport)
 
 	return anonClient, nil
@@ -22,7 +22,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"os/user"
 	"path"
 	"path/filepath"
 	"runtime"
@@ -195,6 +197,11 @@ func (f *fsClient) Watch(ctx context.Context, options WatchOptions) (*WatchObjec
 			if isIgnoredFile(event.Path()) {
 				continue
 			}
+			// Prefix is relative to the watched directory.
+			name := strings.TrimPrefix(strings.TrimPrefix(event.Path(), f.PathURL.Path), string(f.PathURL.Separator))
+			if !strings.HasPrefix(name, options.Prefix) || !strings.HasSuffix(name, options.Suffix) {
+				continue
+			}
 			var i os.FileInfo
 			if IsPutEvent(event.Event()) {
 				// Look for any writes, send a response to indicate a full copy.
@@ -245,36 +252,78 @@ func preserveAttributes(fd *os.File, attr map[string]string) *probe.Error {
 		mode, e := strconv.ParseUint(val, 0, 32)
 		if e == nil {
 			// Attempt to change the file mode.
-			if e = fd.Chmod(os.FileMode(mode)); e != nil {
+			if e = fd.Chmod(unixFileMode(uint32(mode))); e != nil {
 				return probe.NewError(e)
 			}
 		}
 	}
 
-	var uid, gid int
-	var e error
-	if val, ok := attr["uid"]; ok {
-		uid, e = strconv.Atoi(val)
-		if e != nil {
-			uid = -1
-		}
+	// Like tar, ownership is only restored when running as root,
+	// other users cannot give files away.
+	if os.Geteuid() != 0 {
+		return nil
 	}
-
-	if val, ok := attr["gid"]; ok {
-		gid, e = strconv.Atoi(val)
-		if e != nil {
-			gid = -1
-		}
+	uid, gid := attributeOwner(attr)
+	if uid == -1 && gid == -1 {
+		return nil
 	}
 
 	// Attempt to change the owner.
-	if e = fd.Chown(uid, gid); e != nil {
+	if e := fd.Chown(uid, gid); e != nil {
 		return probe.NewError(e)
 	}
 
 	return nil
 }
 
+// unixFileMode - converts the st_mode captured by GetFileSystemAttrs to
+// an os.FileMode, the setuid, setgid and sticky bits differ in value.
+func unixFileMode(mode uint32) os.FileMode {
+	fileMode := os.FileMode(mode).Perm()
+	if mode&syscall.S_ISUID != 0 {
+		fileMode |= os.ModeSetuid
+	}
+	if mode&syscall.S_ISGID != 0 {
+		fileMode |= os.ModeSetgid
+	}
+	if mode&syscall.S_ISVTX != 0 {
+		fileMode |= os.ModeSticky
+	}
+	return fileMode
+}
+
+// attributeOwner - returns the owner to restore from the preserved
+// attributes, user and group names take precedence over the numeric
+// ids when they exist locally. -1 leaves the owner unchanged.
+func attributeOwner(attr map[string]string) (uid, gid int) {
+	uid, gid = -1, -1
+	if val, ok := attr["uid"]; ok {
+		if id, e := strconv.Atoi(val); e == nil {
+			uid = id
+		}
+	}
+	if val, ok := attr["uname"]; ok {
+		if u, e := user.Lookup(val); e == nil {
+			if id, e := strconv.Atoi(u.Uid); e == nil {
+				uid = id
+			}
+		}
+	}
+	if val, ok := attr["gid"]; ok {
+		if id, e := strconv.Atoi(val); e == nil {
+			gid = id
+		}
+	}
+	if val, ok := attr["gname"]; ok {
+		if g, e := user.LookupGroup(val); e == nil {
+			if id, e := strconv.Atoi(g.Gid); e == nil {
+				gid = id
+			}
+		}
+	}
+	return uid, gid
+}
+
 /// Object operations.
 
 func (f *fsClient) put(ctx context.Context, reader io.Reader, size int64, progress io.Reader, opts PutOptions) (int64, *probe.Error) {
@@ -517,6 +566,14 @@ func (f *fsClient) ShareUpload(ctx context.Context, startsWith bool, expires tim
 	})
 }
 
+// SharePresign - share presign not implemented for filesystem.
+func (f *fsClient) SharePresign(ctx context.Context, method, versionID string, expires time.Duration, headers http.Header) (string, *probe.Error) {
+	return "", probe.NewError(APINotImplemented{
+		API:     "SharePresign",
+		APIType: "filesystem",
+	})
+}
+
 // Copy - copy data from source to destination
 func (f *fsClient) Copy(ctx context.Context, source string, opts CopyOptions, progress io.Reader) *probe.Error {
 	rc, e := os.Open(source)
@@ -552,10 +609,23 @@ func (f *fsClient) Get(ctx context.Context, opts GetOptions) (io.ReadCloser, *pr
 			return nil, err.Trace(f.PathURL.Path)
 		}
 	}
+	if opts.RangeLength > 0 {
+		return limitedReadCloser{
+			Reader: io.LimitReader(fileData, opts.RangeLength),
+			Closer: fileData,
+		}, nil
+	}
 
 	return fileData, nil
 }
 
+// limitedReadCloser reads at most a limited number of bytes
+// from the underlying reader and closes the original file.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
 // Check if the given error corresponds to ENOTEMPTY for unix
 // and ERROR_DIR_NOT_EMPTY for windows (directory not empty).
 func isSysErrNotEmpty(err error) bool {
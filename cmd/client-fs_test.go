@@ -330,6 +330,12 @@ func (s *TestSuite) TestGetRange(c *C) {
 	_, e = results.Write(buf)
 	c.Assert(e, IsNil)
 	c.Assert([]byte("hello"), DeepEquals, results.Bytes())
+
+	reader, err = fsClient.Get(context.Background(), GetOptions{RangeStart: 6, RangeLength: 3})
+	c.Assert(err, IsNil)
+	got, e := ioutil.ReadAll(reader)
+	c.Assert(e, IsNil)
+	c.Assert(string(got), Equals, "wor")
 }
 
 // Test stat file.
@@ -383,3 +389,42 @@ func (s *TestSuite) TestCopy(c *C) {
 	err = fsClientTarget.Copy(context.Background(), sourcePath, CopyOptions{size: int64(len(data))}, nil)
 	c.Assert(err, IsNil)
 }
+
+// Test put restoring preserved attributes.
+func (s *TestSuite) TestPutPreserve(c *C) {
+	root, e := ioutil.TempDir(os.TempDir(), "fs-")
+	c.Assert(e, IsNil)
+	defer os.RemoveAll(root)
+
+	objectPath := filepath.Join(root, "object")
+	fsClient, err := fsNew(objectPath)
+	c.Assert(err, IsNil)
+
+	data := "hello world"
+	reader := bytes.NewReader([]byte(data))
+	_, err = fsClient.Put(context.Background(), reader, int64(len(data)), nil, PutOptions{
+		metadata: map[string]string{
+			metadataKey: "atime:1600000000#0/gid:0/mode:33184/mtime:1600000000#0/uid:0",
+		},
+		isPreserve: true,
+	})
+	c.Assert(err, IsNil)
+
+	st, e := os.Stat(objectPath)
+	c.Assert(e, IsNil)
+	c.Assert(st.Mode().Perm(), Equals, os.FileMode(0o640))
+}
+
+// Test resolving the owner of preserved attributes.
+func (s *TestSuite) TestAttributeOwner(c *C) {
+	uid, gid := attributeOwner(map[string]string{})
+	c.Assert(uid, Equals, -1)
+	c.Assert(gid, Equals, -1)
+
+	uid, gid = attributeOwner(map[string]string{"uid": "1001", "uname": "no-such-user-mc", "gid": "1002"})
+	c.Assert(uid, Equals, 1001)
+	c.Assert(gid, Equals, 1002)
+
+	c.Assert(unixFileMode(0o104755), Equals, os.ModeSetuid|0o755)
+	c.Assert(unixFileMode(0o41777), Equals, os.ModeSticky|0o777)
+}
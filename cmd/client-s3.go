@@ -20,7 +20,9 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,6 +41,7 @@ import (
 	"time"
 
 	"github.com/minio/mc/pkg/deadlineconn"
+	"github.com/minio/mc/pkg/hookreader"
 	"github.com/minio/mc/pkg/httptracer"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
@@ -112,6 +115,45 @@ func newCustomDialContext(c *Config) dialContext {
 	}
 }
 
+// requestTimeoutTransport bounds every request, including reading the
+// response body, by a deadline.
+type requestTimeoutTransport struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+}
+
+// newRequestTimeoutTransport wraps transport with a per-request
+// deadline, a zero timeout returns transport as is.
+func newRequestTimeoutTransport(transport http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if timeout <= 0 {
+		return transport
+	}
+	return &requestTimeoutTransport{transport: transport, timeout: timeout}
+}
+
+func (t *requestTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The deadline must outlive RoundTrip until the body is consumed.
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelReadCloser releases the request context on Close.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
 var timeSentinel = time.Unix(0, 0).UTC()
 
 // newFactory encloses New function with client cache.
@@ -147,7 +189,7 @@ func newFactory() func(config *Config) (Client, *probe.Error) {
 		}
 		// Generate a hash out of s3Conf.
 		confHash := fnv.New32a()
-		confHash.Write([]byte(hostName + config.AccessKey + config.SecretKey + config.SessionToken))
+		confHash.Write([]byte(hostName + config.AccessKey + config.SecretKey + config.SessionToken + config.STS.String()))
 		confSum := confHash.Sum32()
 
 		// Lookup previous cache by hash.
@@ -162,6 +204,11 @@ func newFactory() func(config *Config) (Client, *probe.Error) {
 			if strings.ToUpper(config.Signature) == "S3V2" {
 				creds = credentials.NewStaticV2(config.AccessKey, config.SecretKey, "")
 			}
+			// Temporary credentials are fetched, and refreshed before
+			// they expire, from the STS endpoint.
+			if config.STS != nil {
+				creds = newSTSCredentials(config)
+			}
 
 			var transport http.RoundTripper
 
@@ -172,6 +219,7 @@ func newFactory() func(config *Config) (Client, *probe.Error) {
 					Proxy:                 http.ProxyFromEnvironment,
 					DialContext:           newCustomDialContext(config),
 					MaxIdleConnsPerHost:   1024,
+					MaxConnsPerHost:       globalMaxConnsPerHost,
 					WriteBufferSize:       32 << 10, // 32KiB moving up from 4KiB default
 					ReadBufferSize:        32 << 10, // 32KiB moving up from 4KiB default
 					IdleConnTimeout:       90 * time.Second,
@@ -219,6 +267,9 @@ func newFactory() func(config *Config) (Client, *probe.Error) {
 				}
 			}
 
+			transport = newRequestTimeoutTransport(transport, config.RequestTimeout)
+			transport = newConditionalPutTransport(transport)
+
 			// Not found. Instantiate a new MinIO
 			var e error
 
@@ -296,6 +347,11 @@ func (c *S3Client) AddNotificationConfig(ctx context.Context, arn string, events
 			nc.AddEvents(notification.EventType("s3:ObjectRestore:*"))
 			nc.AddEvents(notification.EventType("s3:ObjectTransition:*"))
 		default:
+			// Full event names as printed by 'mc event list'.
+			if strings.HasPrefix(event, "s3:") {
+				nc.AddEvents(notification.EventType(event))
+				continue
+			}
 			return errInvalidArgument().Trace(events...)
 		}
 	}
@@ -417,11 +473,18 @@ func (c *S3Client) RemoveNotificationConfig(ctx context.Context, arn string, eve
 
 // NotificationConfig notification config
 type NotificationConfig struct {
-	ID     string   `json:"id"`
-	Arn    string   `json:"arn"`
-	Events []string `json:"events"`
-	Prefix string   `json:"prefix"`
-	Suffix string   `json:"suffix"`
+	ID     string                   `json:"id"`
+	Arn    string                   `json:"arn"`
+	Events []string                 `json:"events"`
+	Prefix string                   `json:"prefix"`
+	Suffix string                   `json:"suffix"`
+	Rules  []NotificationFilterRule `json:"rules,omitempty"`
+}
+
+// NotificationFilterRule - object key name filter rule of a notification config
+type NotificationFilterRule struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 // ListNotificationConfigs - List notification configs
@@ -442,11 +505,12 @@ func (c *S3Client) ListNotificationConfigs(ctx context.Context, arn string) ([]N
 		return result
 	}
 
-	getFilters := func(config notification.Config) (prefix, suffix string) {
+	getFilters := func(config notification.Config) (prefix, suffix string, rules []NotificationFilterRule) {
 		if config.Filter == nil {
 			return
 		}
 		for _, filter := range config.Filter.S3Key.FilterRules {
+			rules = append(rules, NotificationFilterRule{Name: filter.Name, Value: filter.Value})
 			if strings.ToLower(filter.Name) == "prefix" {
 				prefix = filter.Value
 			}
@@ -455,20 +519,21 @@ func (c *S3Client) ListNotificationConfigs(ctx context.Context, arn string) ([]N
 			}
 
 		}
-		return prefix, suffix
+		return prefix, suffix, rules
 	}
 
 	for _, config := range mb.TopicConfigs {
 		if arn != "" && config.Topic != arn {
 			continue
 		}
-		prefix, suffix := getFilters(config.Config)
+		prefix, suffix, rules := getFilters(config.Config)
 		configs = append(configs, NotificationConfig{
 			ID:     config.ID,
 			Arn:    config.Topic,
 			Events: prettyEventNames(config.Events),
 			Prefix: prefix,
 			Suffix: suffix,
+			Rules:  rules,
 		})
 	}
 
@@ -476,13 +541,14 @@ func (c *S3Client) ListNotificationConfigs(ctx context.Context, arn string) ([]N
 		if arn != "" && config.Queue != arn {
 			continue
 		}
-		prefix, suffix := getFilters(config.Config)
+		prefix, suffix, rules := getFilters(config.Config)
 		configs = append(configs, NotificationConfig{
 			ID:     config.ID,
 			Arn:    config.Queue,
 			Events: prettyEventNames(config.Events),
 			Prefix: prefix,
 			Suffix: suffix,
+			Rules:  rules,
 		})
 	}
 
@@ -490,13 +556,14 @@ func (c *S3Client) ListNotificationConfigs(ctx context.Context, arn string) ([]N
 		if arn != "" && config.Lambda != arn {
 			continue
 		}
-		prefix, suffix := getFilters(config.Config)
+		prefix, suffix, rules := getFilters(config.Config)
 		configs = append(configs, NotificationConfig{
 			ID:     config.ID,
 			Arn:    config.Lambda,
 			Events: prettyEventNames(config.Events),
 			Prefix: prefix,
 			Suffix: suffix,
+			Rules:  rules,
 		})
 	}
 
@@ -749,6 +816,7 @@ func (c *S3Client) notificationToEventsInfo(ninfo notification.Info) []EventInfo
 				UserAgent:    record.Source.UserAgent,
 			}
 		}
+		eventsInfo[i].Record = &ninfo.Records[i]
 	}
 	return eventsInfo
 }
@@ -804,7 +872,7 @@ func (c *S3Client) Watch(ctx context.Context, options WatchOptions) (*WatchObjec
 		}
 		eventsCh = c.api.ListenBucketNotification(listenCtx, bucket, options.Prefix, options.Suffix, events)
 	} else {
-		eventsCh = c.api.ListenNotification(listenCtx, "", "", events)
+		eventsCh = c.api.ListenNotification(listenCtx, options.Prefix, options.Suffix, events)
 	}
 
 	go func() {
@@ -829,9 +897,17 @@ func (c *S3Client) Watch(ctx context.Context, options WatchOptions) (*WatchObjec
 					} else {
 						perr = probe.NewError(notificationInfo.Err)
 					}
-					wo.Errors() <- perr
+					select {
+					case wo.Errors() <- perr:
+					case <-wo.DoneChan:
+						return
+					}
 				} else {
-					wo.Events() <- c.notificationToEventsInfo(notificationInfo)
+					select {
+					case wo.Events() <- c.notificationToEventsInfo(notificationInfo):
+					case <-wo.DoneChan:
+						return
+					}
 				}
 			case <-wo.DoneChan:
 				return
@@ -852,8 +928,12 @@ func (c *S3Client) Get(ctx context.Context, opts GetOptions) (io.ReadCloser, *pr
 	if opts.Zip {
 		o.Set("x-minio-extract", "true")
 	}
-	if opts.RangeStart != 0 {
-		err := o.SetRange(opts.RangeStart, 0)
+	if opts.RangeStart != 0 || opts.RangeLength > 0 {
+		var rangeEnd int64
+		if opts.RangeLength > 0 {
+			rangeEnd = opts.RangeStart + opts.RangeLength - 1
+		}
+		err := o.SetRange(opts.RangeStart, rangeEnd)
 		if err != nil {
 			return nil, probe.NewError(err)
 		}
@@ -1072,7 +1152,13 @@ func (c *S3Client) Put(ctx context.Context, reader io.Reader, size int64, progre
 		opts.SendContentMd5 = true
 	}
 
-	ui, e := c.api.PutObject(ctx, bucket, object, reader, size, opts)
+	var ui minio.UploadInfo
+	var e error
+	if putOpts.resume != nil && size > 0 && !putOpts.disableMultipart {
+		ui, e = c.putObjectResumable(ctx, bucket, object, reader, size, progress, opts, putOpts.resume)
+	} else {
+		ui, e = c.api.PutObject(ctx, bucket, object, reader, size, opts)
+	}
 	if e != nil {
 		errResponse := minio.ToErrorResponse(e)
 		if errResponse.Code == "UnexpectedEOF" || e == io.EOF {
@@ -1114,6 +1200,153 @@ func (c *S3Client) Put(ctx context.Context, reader io.Reader, size int64, progre
 	return ui.Size, nil
 }
 
+// putObjectResumable - uploads an object part by part, recording every
+// uploaded part in the resume state so that an interrupted upload can
+// continue where it left off. Parts are uploaded sequentially.
+func (c *S3Client) putObjectResumable(ctx context.Context, bucket, object string, reader io.Reader, size int64, progress io.Reader, opts minio.PutObjectOptions, state *resumeState) (minio.UploadInfo, error) {
+	core := minio.Core{Client: c.api}
+
+	if state.staleUploadID != "" {
+		// Best effort, the upload may have been aborted already.
+		core.AbortMultipartUpload(ctx, bucket, object, state.staleUploadID)
+		state.staleUploadID = ""
+	}
+
+	if state.UploadID != "" {
+		parts, e := c.listUploadedParts(ctx, bucket, object, state.UploadID)
+		if e != nil {
+			if minio.ToErrorResponse(e).Code != "NoSuchUpload" {
+				return minio.UploadInfo{}, e
+			}
+			state.reset()
+		} else {
+			// Only keep the leading parts the server agrees on.
+			for i, part := range state.Parts {
+				if parts[part.PartNumber] != part.ETag || part.PartNumber != i+1 {
+					state.Parts = state.Parts[:i]
+					break
+				}
+			}
+		}
+	}
+
+	if state.UploadID == "" {
+		_, partSize, _, e := minio.OptimalPartInfo(size, opts.PartSize)
+		if e != nil {
+			return minio.UploadInfo{}, e
+		}
+		uploadID, e := core.NewMultipartUpload(ctx, bucket, object, opts)
+		if e != nil {
+			return minio.UploadInfo{}, e
+		}
+		state.UploadID = uploadID
+		state.PartSize = partSize
+		if err := state.save(); err != nil {
+			return minio.UploadInfo{}, err.ToGoError()
+		}
+	}
+
+	// Skip over the data which was already uploaded.
+	uploaded := state.uploadedSize()
+	if uploaded > 0 {
+		if e := skipUploadedBytes(reader, uploaded, progress); e != nil {
+			return minio.UploadInfo{Size: uploaded}, e
+		}
+	}
+
+	var sse encrypt.ServerSide
+	if opts.ServerSideEncryption != nil && opts.ServerSideEncryption.Type() == encrypt.SSEC {
+		sse = opts.ServerSideEncryption
+	}
+
+	buf := make([]byte, state.PartSize)
+	for partNumber := len(state.Parts) + 1; uploaded < size; partNumber++ {
+		partSize := state.PartSize
+		if remaining := size - uploaded; remaining < partSize {
+			partSize = remaining
+		}
+		n, e := io.ReadFull(reader, buf[:partSize])
+		if e != nil {
+			return minio.UploadInfo{Size: uploaded}, e
+		}
+
+		var md5Base64 string
+		if opts.SendContentMd5 {
+			sum := md5.Sum(buf[:n])
+			md5Base64 = base64.StdEncoding.EncodeToString(sum[:])
+		}
+
+		part, e := core.PutObjectPart(ctx, bucket, object, state.UploadID, partNumber,
+			hookreader.NewHook(bytes.NewReader(buf[:n]), progress), int64(n), md5Base64, "", sse)
+		if e != nil {
+			return minio.UploadInfo{Size: uploaded}, e
+		}
+
+		uploaded += int64(n)
+		state.Parts = append(state.Parts, resumePart{PartNumber: partNumber, ETag: part.ETag, Size: int64(n)})
+		if err := state.save(); err != nil {
+			return minio.UploadInfo{Size: uploaded}, err.ToGoError()
+		}
+	}
+
+	completeParts := make([]minio.CompletePart, 0, len(state.Parts))
+	for _, part := range state.Parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	etag, e := core.CompleteMultipartUpload(ctx, bucket, object, state.UploadID, completeParts, opts)
+	if e != nil {
+		return minio.UploadInfo{Size: uploaded}, e
+	}
+	state.remove()
+
+	return minio.UploadInfo{Bucket: bucket, Key: object, ETag: etag, Size: uploaded}, nil
+}
+
+// listUploadedParts - returns the ETag of every part of an upload.
+func (c *S3Client) listUploadedParts(ctx context.Context, bucket, object, uploadID string) (map[int]string, error) {
+	core := minio.Core{Client: c.api}
+	parts := make(map[int]string)
+	marker := 0
+	for {
+		result, e := core.ListObjectParts(ctx, bucket, object, uploadID, marker, 1000)
+		if e != nil {
+			return nil, e
+		}
+		for _, part := range result.ObjectParts {
+			parts[part.PartNumber] = strings.Trim(part.ETag, "\"")
+		}
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// skipUploadedBytes - advances reader and progress past the bytes
+// which were uploaded by a previous run.
+func skipUploadedBytes(reader io.Reader, n int64, progress io.Reader) error {
+	if seeker, ok := reader.(io.Seeker); ok {
+		if _, e := seeker.Seek(n, io.SeekCurrent); e != nil {
+			return e
+		}
+	} else if _, e := io.CopyN(io.Discard, reader, n); e != nil {
+		return e
+	}
+	if progress == nil {
+		return nil
+	}
+	buf := make([]byte, 1<<20)
+	for n > 0 {
+		m := int64(len(buf))
+		if n < m {
+			m = n
+		}
+		progress.Read(buf[:m])
+		n -= m
+	}
+	return nil
+}
+
 // PutPart - upload an object with custom metadata. (Same as Put)
 func (c *S3Client) PutPart(ctx context.Context, reader io.Reader, size int64, progress io.Reader, putOpts PutOptions) (int64, *probe.Error) {
 	return c.Put(ctx, reader, size, progress, putOpts)
@@ -1607,6 +1840,7 @@ func (c *S3Client) Stat(ctx context.Context, opts StatOptions) (*ClientContent,
 		// Issue HEAD request first but ignore no such key error
 		// so we can check if there is such prefix which exists
 		o := minio.StatObjectOptions{ServerSideEncryption: opts.sse, VersionID: opts.versionID}
+		o.Checksum = opts.checksum
 		if opts.isZip {
 			o.Set("x-minio-extract", "true")
 		}
@@ -2104,6 +2338,20 @@ func (c *S3Client) objectInfo2ClientContent(bucket string, entry minio.ObjectInf
 	content.Metadata = map[string]string{}
 	content.UserMetadata = map[string]string{}
 	content.ReplicationStatus = entry.ReplicationStatus
+	for algo, v := range map[string]string{
+		"CRC32":  entry.ChecksumCRC32,
+		"CRC32C": entry.ChecksumCRC32C,
+		"SHA1":   entry.ChecksumSHA1,
+		"SHA256": entry.ChecksumSHA256,
+	} {
+		if v == "" {
+			continue
+		}
+		if content.Checksum == nil {
+			content.Checksum = map[string]string{}
+		}
+		content.Checksum[algo] = v
+	}
 	for k, v := range entry.UserMetadata {
 		content.UserMetadata[k] = v
 	}
@@ -2277,6 +2525,21 @@ func (c *S3Client) ShareDownload(ctx context.Context, versionID string, expires
 	return presignedURL.String(), nil
 }
 
+// SharePresign - get a presigned object url for the given http method,
+// headers are signed and must be sent as is with the request.
+func (c *S3Client) SharePresign(ctx context.Context, method, versionID string, expires time.Duration, headers http.Header) (string, *probe.Error) {
+	bucket, object := c.url2BucketAndObject()
+	reqParams := make(url.Values)
+	if versionID != "" {
+		reqParams.Set("versionId", versionID)
+	}
+	presignedURL, e := c.api.PresignHeader(ctx, method, bucket, object, expires, reqParams, headers)
+	if e != nil {
+		return "", probe.NewError(e)
+	}
+	return presignedURL.String(), nil
+}
+
 // ShareUpload - get data for presigned post http form upload.
 func (c *S3Client) ShareUpload(ctx context.Context, isRecursive bool, expires time.Duration, contentType string) (string, map[string]string, *probe.Error) {
 	bucket, object := c.url2BucketAndObject()
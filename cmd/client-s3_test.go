@@ -25,6 +25,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"testing"
+	"time"
 
 	minio "github.com/minio/minio-go/v7"
 	. "gopkg.in/check.v1"
@@ -264,3 +266,34 @@ func (s *TestSuite) TestSelectCompressionType(c *C) {
 		c.Assert(cType, DeepEquals, test.compressionType)
 	}
 }
+
+func TestRequestTimeoutTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	clnt := &http.Client{Transport: newRequestTimeoutTransport(http.DefaultTransport, 100*time.Millisecond)}
+	resp, e := clnt.Get(srv.URL + "/fast")
+	if e != nil {
+		t.Fatalf("expected fast request to succeed, got %v", e)
+	}
+	if body, e := io.ReadAll(resp.Body); e != nil || string(body) != "ok" {
+		t.Fatalf("unexpected body %q, %v", body, e)
+	}
+	resp.Body.Close()
+
+	if _, e = clnt.Get(srv.URL + "/slow"); e == nil {
+		t.Fatal("expected slow request to time out")
+	}
+
+	if newRequestTimeoutTransport(http.DefaultTransport, 0) != http.DefaultTransport {
+		t.Fatal("expected a zero timeout to leave the transport unchanged")
+	}
+}
@@ -49,6 +49,9 @@ type GetOptions struct {
 	VersionID  string
 	Zip        bool
 	RangeStart int64
+	// RangeLength limits the number of bytes returned
+	// starting at RangeStart, zero means till the end.
+	RangeLength int64
 }
 
 // PutOptions holds options for PUT operation
@@ -60,6 +63,7 @@ type PutOptions struct {
 	storageClass          string
 	multipartSize         uint64
 	multipartThreads      uint
+	resume                *resumeState
 }
 
 // StatOptions holds options of the HEAD operation
@@ -70,6 +74,7 @@ type StatOptions struct {
 	timeRef    time.Time
 	versionID  string
 	isZip      bool
+	checksum   bool
 }
 
 // ListOptions holds options for listing operation
@@ -134,6 +139,7 @@ type Client interface {
 	// I/O operations with expiration
 	ShareDownload(ctx context.Context, versionID string, expires time.Duration) (string, *probe.Error)
 	ShareUpload(context.Context, bool, time.Duration, string) (string, map[string]string, *probe.Error)
+	SharePresign(ctx context.Context, method, versionID string, expires time.Duration, headers http.Header) (string, *probe.Error)
 
 	// Watch events
 	Watch(ctx context.Context, options WatchOptions) (*WatchObject, *probe.Error)
@@ -190,6 +196,7 @@ type ClientContent struct {
 	Metadata     map[string]string
 	UserMetadata map[string]string
 	ETag         string
+	Checksum     map[string]string // Checksum algorithm to value, only set when requested
 	Expires      time.Time
 
 	Expiration       time.Time
@@ -225,7 +232,9 @@ type Config struct {
 	Lookup            minio.BucketLookupType
 	ConnReadDeadline  time.Duration
 	ConnWriteDeadline time.Duration
+	RequestTimeout    time.Duration
 	Transport         *http.Transport
+	STS               *aliasSTSConfigV10
 }
 
 // SelectObjectOpts - opts entered for select API
@@ -36,6 +36,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/minio/cli"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/mc/pkg/ratelimit"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/pkg/env"
@@ -417,6 +418,68 @@ func getAllMetadata(ctx context.Context, sourceAlias, sourceURLStr string, srcSS
 	return filterMetadata(metadata), nil
 }
 
+// setRateLimitsFromContext - parses --limit-upload and --limit-download
+// and initializes the limiters shared by all transfers of this command.
+func setRateLimitsFromContext(cliCtx *cli.Context) *probe.Error {
+	for _, limit := range []struct {
+		flag    string
+		limiter **ratelimit.Limiter
+	}{
+		{"limit-upload", &globalLimitUpload},
+		{"limit-download", &globalLimitDownload},
+	} {
+		v := cliCtx.String(limit.flag)
+		if v == "" {
+			continue
+		}
+		rate, e := humanize.ParseBytes(v)
+		if e != nil {
+			return probe.NewError(e).Trace(limit.flag, v)
+		}
+		*limit.limiter = ratelimit.NewLimiter(rate)
+	}
+	return nil
+}
+
+// rateLimitPostfix - returns a progress bar postfix describing the
+// active rate limits, empty when no limit is configured.
+func rateLimitPostfix() string {
+	var limits []string
+	if rate := globalLimitUpload.Rate(); rate > 0 {
+		limits = append(limits, "upload "+humanize.IBytes(rate)+"/s")
+	}
+	if rate := globalLimitDownload.Rate(); rate > 0 {
+		limits = append(limits, "download "+humanize.IBytes(rate)+"/s")
+	}
+	if len(limits) == 0 {
+		return ""
+	}
+	return " (limit: " + strings.Join(limits, ", ") + ")"
+}
+
+// isSameDeployment - returns true if both aliases point to the same
+// endpoint with the same credentials, objects can then be copied between
+// them using a server side copy.
+func isSameDeployment(sourceAlias, targetAlias string) bool {
+	if sourceAlias == targetAlias {
+		return true
+	}
+	if sourceAlias == "" || targetAlias == "" {
+		return false
+	}
+	sourceCfg, targetCfg := mustGetHostConfig(sourceAlias), mustGetHostConfig(targetAlias)
+	if sourceCfg == nil || targetCfg == nil {
+		return false
+	}
+	return strings.TrimSuffix(sourceCfg.URL, "/") == strings.TrimSuffix(targetCfg.URL, "/") &&
+		sourceCfg.AccessKey == targetCfg.AccessKey &&
+		sourceCfg.SecretKey == targetCfg.SecretKey &&
+		sourceCfg.SessionToken == targetCfg.SessionToken &&
+		sourceCfg.STS.String() == targetCfg.STS.String() &&
+		sourceCfg.API == targetCfg.API &&
+		sourceCfg.Path == targetCfg.Path
+}
+
 // uploadSourceToTargetURL - uploads to targetURL from source.
 // optionally optimizes copy for object sizes <= 5GiB by using
 // server side copy operation.
@@ -482,7 +545,7 @@ func uploadSourceToTargetURL(ctx context.Context, urls URLs, progress io.Reader,
 	}
 
 	// Optimize for server side copy if the host is same.
-	if sourceAlias == targetAlias && !isZip {
+	if !urls.DisableServerSideCopy && isSameDeployment(sourceAlias, targetAlias) && !isZip {
 		// preserve new metadata and save existing ones.
 		if preserve {
 			currentMetadata, err := getAllMetadata(ctx, sourceAlias, sourceURL.String(), srcSSE, urls)
@@ -599,12 +662,32 @@ func uploadSourceToTargetURL(ctx context.Context, urls URLs, progress io.Reader,
 			multipartThreads: uint(multipartThreads),
 		}
 
-		if isReadAt(reader) {
+		// Record uploaded parts, so that an interrupted upload
+		// can be resumed by a later run. Smaller objects are
+		// uploaded with a single PUT and have nothing to resume.
+		if globalResume && !urls.DisableMultipart && isResumableSize(length, multipartSize) {
+			putOpts.resume, err = loadResumeState(sourcePath, targetPath, urls.SourceContent, globalResumeExpiry)
+			if err != nil {
+				return urls.WithError(err.Trace(sourceURL.String()))
+			}
+		}
+
+		// Throttle the transfer when rate limits are configured, the
+		// limiters are shared by all concurrent transfers.
+		var source io.Reader = reader
+		if sourceAlias != "" && globalLimitDownload != nil {
+			source = ratelimit.NewReader(ctx, source, globalLimitDownload)
+		}
+		if targetAlias != "" && globalLimitUpload != nil {
+			source = ratelimit.NewReader(ctx, source, globalLimitUpload)
+		}
+
+		if isReadAt(source) {
 			_, err = putTargetStream(ctx, targetAlias, targetURL.String(), mode, until,
-				legalHold, reader, length, progress, putOpts)
+				legalHold, source, length, progress, putOpts)
 		} else {
 			_, err = putTargetStream(ctx, targetAlias, targetURL.String(), mode, until,
-				legalHold, io.LimitReader(reader, length), length, progress, putOpts)
+				legalHold, io.LimitReader(source, length), length, progress, putOpts)
 		}
 	}
 	if err != nil {
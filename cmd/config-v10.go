@@ -46,6 +46,19 @@ type aliasConfigV10 struct {
 	Path         string `json:"path"`
 	License      string `json:"license,omitempty"`
 	APIKey       string `json:"apiKey,omitempty"`
+
+	STS *aliasSTSConfigV10 `json:"sts,omitempty"`
+}
+
+// aliasSTSConfigV10 - temporary credentials of an alias, fetched from
+// the STS endpoint with AssumeRoleWithWebIdentity instead of static keys.
+type aliasSTSConfigV10 struct {
+	Endpoint  string `json:"endpoint,omitempty"`
+	RoleARN   string `json:"roleArn,omitempty"`
+	TokenFile string `json:"tokenFile,omitempty"`
+	TokenEnv  string `json:"tokenEnv,omitempty"`
+	// Duration of the session in seconds, zero lets the server decide.
+	Duration int `json:"duration,omitempty"`
 }
 
 // configV10 config version.
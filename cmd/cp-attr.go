@@ -0,0 +1,111 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/mimedb"
+	"github.com/minio/pkg/wildcard"
+)
+
+// Placeholders which can be used in --attr values, they are replaced
+// for every object being copied.
+const (
+	attrTemplateExtMime = "{{ext_mime}}" // MIME type inferred from the extension
+	attrTemplateExt     = "{{ext}}"      // extension without the leading dot
+)
+
+// expandAttrTemplate - replaces the placeholders in an --attr value
+// using the path of the object being copied.
+func expandAttrTemplate(value, objectPath string) string {
+	if !strings.Contains(value, "{{") {
+		return value
+	}
+	ext := filepath.Ext(objectPath)
+	return strings.NewReplacer(
+		attrTemplateExtMime, mimedb.TypeByExtension(ext),
+		attrTemplateExt, strings.TrimPrefix(ext, "."),
+	).Replace(value)
+}
+
+// attrRule - metadata to set on the objects matching pattern, read
+// from an --attr-file.
+type attrRule struct {
+	pattern  string
+	metadata map[string]string
+}
+
+// parseAttrFile - reads the rules of an --attr-file. Every line holds a
+// wildcard pattern matched against the target object path, followed by
+// metadata in the same format as --attr. Empty lines and lines starting
+// with '#' are ignored.
+func parseAttrFile(attrFile string) ([]attrRule, *probe.Error) {
+	if attrFile == "" {
+		return nil, nil
+	}
+	f, e := os.Open(attrFile)
+	if e != nil {
+		return nil, probe.NewError(e)
+	}
+	defer f.Close()
+
+	var rules []attrRule
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			return nil, probe.NewError(fmt.Errorf("line %d: missing metadata after pattern `%s`", lineNum, line))
+		}
+		metadata, err := getMetaDataEntry(strings.TrimSpace(line[i:]))
+		if err != nil {
+			return nil, err.Trace(fmt.Sprintf("line %d", lineNum))
+		}
+		rules = append(rules, attrRule{pattern: line[:i], metadata: metadata})
+	}
+	if e := scanner.Err(); e != nil {
+		return nil, probe.NewError(e)
+	}
+	return rules, nil
+}
+
+// applyAttrs - sets the metadata of --attr and of the matching
+// --attr-file rules on an object, later rules take precedence.
+func applyAttrs(userMetadata, attrs map[string]string, rules []attrRule, objectPath string) {
+	for k, v := range attrs {
+		userMetadata[k] = expandAttrTemplate(v, objectPath)
+	}
+	key := strings.TrimPrefix(filepath.ToSlash(objectPath), "/")
+	for _, rule := range rules {
+		if !wildcard.Match(rule.pattern, key) {
+			continue
+		}
+		for k, v := range rule.metadata {
+			userMetadata[k] = expandAttrTemplate(v, objectPath)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExpandAttrTemplate(t *testing.T) {
+	testCases := []struct {
+		value      string
+		objectPath string
+		expected   string
+	}{
+		{"max-age=90000", "/bucket/index.html", "max-age=90000"},
+		{"{{ext_mime}}", "/bucket/index.html", "text/html"},
+		{"{{ext_mime}}; charset=utf-8", "/bucket/app.js", "application/javascript; charset=utf-8"},
+		{"{{ext}}", "/bucket/photo.JPG", "JPG"},
+		{"{{ext_mime}}", "/bucket/README", "application/octet-stream"},
+	}
+
+	for i, testCase := range testCases {
+		if got := expandAttrTemplate(testCase.value, testCase.objectPath); got != testCase.expected {
+			t.Errorf("Test %d: expected `%s`, got `%s`", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestApplyAttrs(t *testing.T) {
+	dir := t.TempDir()
+	attrFile := filepath.Join(dir, "attrs.txt")
+	content := "# comment\n\n*.html Cache-Control=no-cache\nwebsite/assets/*\tCache-Control=max-age=31536000;Content-Type={{ext_mime}}\n"
+	if e := os.WriteFile(attrFile, []byte(content), 0o644); e != nil {
+		t.Fatal(e)
+	}
+	rules, err := parseAttrFile(attrFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+
+	testCases := []struct {
+		objectPath string
+		expected   map[string]string
+	}{
+		{"/website/index.html", map[string]string{"Content-Type": "text/plain", "Cache-Control": "no-cache"}},
+		{"/website/assets/logo.png", map[string]string{"Content-Type": "image/png", "Cache-Control": "max-age=31536000"}},
+		{"/website/robots.txt", map[string]string{"Content-Type": "text/plain"}},
+	}
+	for i, testCase := range testCases {
+		metadata := map[string]string{}
+		applyAttrs(metadata, map[string]string{"Content-Type": "text/plain"}, rules, testCase.objectPath)
+		if !reflect.DeepEqual(metadata, testCase.expected) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, metadata)
+		}
+	}
+
+	if e := os.WriteFile(attrFile, []byte("*.html\n"), 0o644); e != nil {
+		t.Fatal(e)
+	}
+	if _, err = parseAttrFile(attrFile); err == nil {
+		t.Fatal("expected an error for a pattern without metadata")
+	}
+}
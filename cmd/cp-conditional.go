@@ -0,0 +1,108 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+)
+
+// putPreconditions - conditions the target object must satisfy for a
+// copy to overwrite it, set by --if-match and --if-none-match.
+type putPreconditions struct {
+	ifMatch     string
+	ifNoneMatch bool
+}
+
+func (p putPreconditions) isSet() bool {
+	return p.ifMatch != "" || p.ifNoneMatch
+}
+
+type putPreconditionsKey struct{}
+
+// withPutPreconditions - returns a context carrying the preconditions
+// which are added to the object writes done with it.
+func withPutPreconditions(ctx context.Context, p putPreconditions) context.Context {
+	if !p.isSet() {
+		return ctx
+	}
+	return context.WithValue(ctx, putPreconditionsKey{}, p)
+}
+
+// conditionalPutTransport adds the If-Match and If-None-Match headers
+// found in the request context to the requests creating an object, that
+// is a PutObject, CopyObject or CompleteMultipartUpload request.
+type conditionalPutTransport struct {
+	transport http.RoundTripper
+}
+
+func newConditionalPutTransport(transport http.RoundTripper) http.RoundTripper {
+	return &conditionalPutTransport{transport: transport}
+}
+
+// isObjectWrite - returns true if req writes the object itself rather
+// than one of its parts or subresources.
+func isObjectWrite(req *http.Request) bool {
+	query := req.URL.Query()
+	switch req.Method {
+	case http.MethodPut:
+		return len(query) == 0
+	case http.MethodPost:
+		return len(query) == 1 && query.Has("uploadId")
+	}
+	return false
+}
+
+func (t *conditionalPutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	p, ok := req.Context().Value(putPreconditionsKey{}).(putPreconditions)
+	if !ok || !isObjectWrite(req) {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	if p.ifMatch != "" {
+		req.Header.Set("If-Match", "\""+strings.Trim(p.ifMatch, "\"")+"\"")
+	}
+	if p.ifNoneMatch {
+		req.Header.Set("If-None-Match", "*")
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// isErrPreconditionFailed - returns true if err reports that the
+// preconditions of a conditional write were not met.
+func isErrPreconditionFailed(err *probe.Error) bool {
+	if err == nil {
+		return false
+	}
+	errResp := minio.ToErrorResponse(err.ToGoError())
+	return errResp.Code == "PreconditionFailed" || errResp.StatusCode == http.StatusPreconditionFailed ||
+		errResp.Code == "ConditionalRequestConflict"
+}
+
+// preconditionFailedError - describes the conflict of a failed conditional copy.
+func preconditionFailedError(targetPath string, p putPreconditions) *probe.Error {
+	if p.ifNoneMatch {
+		return probe.NewError(fmt.Errorf("target `%s` already exists (--if-none-match)", targetPath))
+	}
+	return probe.NewError(fmt.Errorf("target `%s` was modified concurrently, its ETag does not match `%s` (--if-match)", targetPath, p.ifMatch))
+}
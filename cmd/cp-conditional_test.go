@@ -0,0 +1,79 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type recordHeadersTransport struct {
+	header http.Header
+}
+
+func (t *recordHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.header = req.Header
+	return httptest.NewRecorder().Result(), nil
+}
+
+func TestConditionalPutTransport(t *testing.T) {
+	testCases := []struct {
+		method        string
+		url           string
+		preconditions putPreconditions
+		ifMatch       string
+		ifNoneMatch   string
+	}{
+		{http.MethodPut, "http://localhost:9000/bucket/object", putPreconditions{ifMatch: "abc"}, `"abc"`, ""},
+		{http.MethodPut, "http://localhost:9000/bucket/object", putPreconditions{ifMatch: `"abc"`}, `"abc"`, ""},
+		{http.MethodPut, "http://localhost:9000/bucket/object", putPreconditions{ifNoneMatch: true}, "", "*"},
+		{http.MethodPost, "http://localhost:9000/bucket/object?uploadId=1", putPreconditions{ifNoneMatch: true}, "", "*"},
+		// Parts and subresources are left untouched.
+		{http.MethodPut, "http://localhost:9000/bucket/object?partNumber=1&uploadId=1", putPreconditions{ifNoneMatch: true}, "", ""},
+		{http.MethodPut, "http://localhost:9000/bucket/object?tagging", putPreconditions{ifMatch: "abc"}, "", ""},
+		{http.MethodPost, "http://localhost:9000/bucket/object?uploads", putPreconditions{ifNoneMatch: true}, "", ""},
+		{http.MethodGet, "http://localhost:9000/bucket/object", putPreconditions{ifMatch: "abc"}, "", ""},
+		// No preconditions in the context.
+		{http.MethodPut, "http://localhost:9000/bucket/object", putPreconditions{}, "", ""},
+	}
+	for i, tc := range testCases {
+		recorder := &recordHeadersTransport{}
+		transport := newConditionalPutTransport(recorder)
+		ctx := withPutPreconditions(context.Background(), tc.preconditions)
+		req, e := http.NewRequestWithContext(ctx, tc.method, tc.url, nil)
+		if e != nil {
+			t.Fatal(e)
+		}
+		resp, e := transport.RoundTrip(req)
+		if e != nil {
+			t.Fatal(e)
+		}
+		resp.Body.Close()
+		if got := recorder.header.Get("If-Match"); got != tc.ifMatch {
+			t.Errorf("Test %d: expected If-Match %q, got %q", i+1, tc.ifMatch, got)
+		}
+		if got := recorder.header.Get("If-None-Match"); got != tc.ifNoneMatch {
+			t.Errorf("Test %d: expected If-None-Match %q, got %q", i+1, tc.ifNoneMatch, got)
+		}
+		if req.Header.Get("If-Match") != "" || req.Header.Get("If-None-Match") != "" {
+			t.Errorf("Test %d: original request must not be modified", i+1)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// parseFilesEntry - validates a line read from --files, entries are
+// object keys relative to the source folder, they cannot be absolute
+// or point outside of it. Anything after a TAB is ignored, which allows
+// passing the error log of 'mc mirror --error-log' as is. Lines starting
+// with '#' are comments and skipped by the caller, keys starting with '#'
+// or '\' are escaped with a leading '\', see escapeFilesEntry.
+func parseFilesEntry(line string) (string, error) {
+	key := strings.TrimSuffix(line, "\r")
+	key, _, _ = strings.Cut(key, "\t")
+	if strings.HasPrefix(key, "\\#") || strings.HasPrefix(key, "\\\\") {
+		key = key[1:]
+	}
+	switch {
+	case !utf8.ValidString(key):
+		return "", errors.New("key is not valid UTF-8")
+	case strings.ContainsRune(key, 0):
+		return "", errors.New("key contains a NUL character")
+	case strings.HasPrefix(key, "/"):
+		return "", errors.New("key must be relative to the source folder")
+	case strings.HasSuffix(key, "/"):
+		return "", errors.New("key is a folder")
+	}
+	for _, elem := range strings.Split(key, "/") {
+		if elem == ".." {
+			return "", errors.New("key must not contain '..'")
+		}
+	}
+	return key, nil
+}
+
+// escapeFilesEntry - escapes a key written to a list read by --files, so
+// that keys starting with '#' are not taken for comments.
+func escapeFilesEntry(key string) string {
+	if strings.HasPrefix(key, "#") || strings.HasPrefix(key, "\\") {
+		return "\\" + key
+	}
+	return key
+}
+
+// isFilesComment - reports whether a line read from --files carries no
+// key, that is a blank line or a comment starting with '#'.
+func isFilesComment(line string) bool {
+	return strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#")
+}
+
+// prepareCopyURLsFromFiles - prepares source and target URLs for every
+// key listed in --files, keys are copied from the source folder to the
+// same relative path below the target folder.
+func prepareCopyURLsFromFiles(ctx context.Context, o prepareCopyURLsOpts) <-chan URLs {
+	copyURLsCh := make(chan URLs)
+	go func() {
+		defer close(copyURLsCh)
+
+		var reader io.Reader = os.Stdin
+		if o.filesManifest != "-" {
+			f, e := os.Open(o.filesManifest)
+			if e != nil {
+				copyURLsCh <- URLs{Error: probe.NewError(e).Trace(o.filesManifest)}
+				return
+			}
+			defer f.Close()
+			reader = f
+		}
+
+		// skip reports an invalid entry and carries on, unless --strict
+		// is set in which case the first invalid entry is fatal.
+		skip := func(lineNum int, err *probe.Error) {
+			if o.isStrict {
+				fatalIf(err, fmt.Sprintf("Unable to copy line %d of `%s`.", lineNum, o.filesManifest))
+			}
+			errorIf(err, fmt.Sprintf("Skipping line %d of `%s`.", lineNum, o.filesManifest))
+		}
+
+		scanner := bufio.NewScanner(reader)
+		for lineNum := 1; scanner.Scan(); lineNum++ {
+			if isFilesComment(scanner.Text()) {
+				continue
+			}
+			key, e := parseFilesEntry(scanner.Text())
+			if e != nil {
+				skip(lineNum, probe.NewError(e).Trace(o.filesManifest, scanner.Text()))
+				continue
+			}
+
+			sourceURL := urlJoinPath(o.sourceURLs[0], key)
+			targetURL := urlJoinPath(o.targetURL, key)
+			cpURLs := prepareCopyURLsTypeA(ctx, sourceURL, "", targetURL, o.encKeyDB, o.isZip)
+			if cpURLs.Error != nil {
+				skip(lineNum, cpURLs.Error.Trace(o.filesManifest))
+				continue
+			}
+			copyURLsCh <- cpURLs
+		}
+		if e := scanner.Err(); e != nil {
+			copyURLsCh <- URLs{Error: probe.NewError(e).Trace(o.filesManifest)}
+		}
+	}()
+	return copyURLsCh
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestParseFilesEntry(t *testing.T) {
+	testCases := []struct {
+		line        string
+		expectedKey string
+		shouldPass  bool
+	}{
+		{"photos/2022/a.jpg", "photos/2022/a.jpg", true},
+		{"a.jpg\r", "a.jpg", true},
+		{"..a/b", "..a/b", true},
+		{"photos/a.jpg\tUnable to read object.", "photos/a.jpg", true},
+		{"/photos/a.jpg", "", false},
+		{"photos/", "", false},
+		{"photos/../../a.jpg", "", false},
+		{"a\x00b", "", false},
+		{"\xff\xfe", "", false},
+		{"\\#notes.txt\tUnable to read object.", "#notes.txt", true},
+		{"\\\\a.txt", "\\a.txt", true},
+		{"\\a.txt", "\\a.txt", true},
+	}
+	for i, testCase := range testCases {
+		key, err := parseFilesEntry(testCase.line)
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+		if !testCase.shouldPass && err == nil {
+			t.Errorf("Test %d: expected an error for %q", i+1, testCase.line)
+		}
+		if key != testCase.expectedKey {
+			t.Errorf("Test %d: expected key %q, got %q", i+1, testCase.expectedKey, key)
+		}
+	}
+}
+
+func TestEscapeFilesEntry(t *testing.T) {
+	for i, key := range []string{"a.txt", "#notes.txt", "\\a.txt", "a#b.txt"} {
+		line := escapeFilesEntry(key)
+		if isFilesComment(line) {
+			t.Errorf("Test %d: %q is taken for a comment", i+1, line)
+		}
+		got, err := parseFilesEntry(line)
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+		if got != key {
+			t.Errorf("Test %d: expected key %q, got %q", i+1, key, got)
+		}
+	}
+}
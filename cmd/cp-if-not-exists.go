@@ -0,0 +1,119 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/pkg/console"
+)
+
+// copySkipMessage container for an object skipped by --if-not-exists.
+type copySkipMessage struct {
+	Status string `json:"status"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func (m copySkipMessage) String() string {
+	return console.Colorize("Copy", fmt.Sprintf("`%s` skipped, `%s` already exists.", m.Source, m.Target))
+}
+
+func (m copySkipMessage) JSON() string {
+	m.Status = "skipped"
+	msgBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// copySkipSummaryMessage container for the number of objects skipped by --if-not-exists.
+type copySkipSummaryMessage struct {
+	Status  string `json:"status"`
+	Skipped int64  `json:"skipped"`
+}
+
+func (m copySkipSummaryMessage) String() string {
+	return console.Colorize("Copy", fmt.Sprintf("Skipped %d object(s) already present on the target.", m.Skipped))
+}
+
+func (m copySkipSummaryMessage) JSON() string {
+	m.Status = "success"
+	msgBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// isErrNotExist - returns true if the error means that the stat'ed
+// object or file does not exist.
+func isErrNotExist(err *probe.Error) bool {
+	switch e := err.ToGoError().(type) {
+	case ObjectMissing, PathNotFound, BucketDoesNotExist:
+		return true
+	case minio.ErrorResponse:
+		return e.Code == "NoSuchKey" || e.Code == "NoSuchBucket"
+	}
+	return false
+}
+
+// targetExists - returns true if the target object of cpURLs already
+// exists, a folder with the same name does not count as an object.
+func targetExists(ctx context.Context, cpURLs URLs, encKeyDB map[string][]prefixSSEPair) (bool, *probe.Error) {
+	targetAlias := cpURLs.TargetAlias
+	targetURL := cpURLs.TargetContent.URL.String()
+	targetPath := filepath.ToSlash(filepath.Join(targetAlias, cpURLs.TargetContent.URL.Path))
+
+	clnt, err := newClientFromAlias(targetAlias, targetURL)
+	if err != nil {
+		return false, err.Trace(targetURL)
+	}
+	content, err := clnt.Stat(ctx, StatOptions{sse: getSSE(targetPath, encKeyDB[targetAlias])})
+	if err != nil {
+		if isErrNotExist(err) {
+			return false, nil
+		}
+		return false, err.Trace(targetURL)
+	}
+	return !content.Type.IsDir(), nil
+}
+
+// doCopyIfNotExists - copies cpURLs only when its target does not exist yet.
+func doCopyIfNotExists(ctx context.Context, cpURLs URLs, pg ProgressReader, encKeyDB map[string][]prefixSSEPair, isMvCmd bool, preserve, isZip, isVerify bool) URLs {
+	exists, err := targetExists(ctx, cpURLs, encKeyDB)
+	if err != nil {
+		return cpURLs.WithError(err)
+	}
+	if !exists {
+		return doCopy(ctx, cpURLs, pg, encKeyDB, isMvCmd, preserve, isZip, isVerify)
+	}
+
+	if progressReader, ok := pg.(*progressBar); ok {
+		progressReader.ProgressBar.Add64(cpURLs.SourceContent.Size)
+	} else {
+		printMsg(copySkipMessage{
+			Source: filepath.ToSlash(filepath.Join(cpURLs.SourceAlias, cpURLs.SourceContent.URL.Path)),
+			Target: filepath.ToSlash(filepath.Join(cpURLs.TargetAlias, cpURLs.TargetContent.URL.Path)),
+		})
+	}
+	cpURLs.Skipped = true
+	return cpURLs
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+)
+
+func TestIsErrNotExist(t *testing.T) {
+	testCases := []struct {
+		err      error
+		notExist bool
+	}{
+		{ObjectMissing{}, true},
+		{PathNotFound{Path: "/tmp/a"}, true},
+		{BucketDoesNotExist{Bucket: "bucket"}, true},
+		{minio.ErrorResponse{Code: "NoSuchKey"}, true},
+		{minio.ErrorResponse{Code: "AccessDenied"}, false},
+		{errors.New("connection refused"), false},
+	}
+	for i, tc := range testCases {
+		if got := isErrNotExist(probe.NewError(tc.err)); got != tc.notExist {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.notExist, got)
+		}
+	}
+}
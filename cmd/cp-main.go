@@ -58,6 +58,14 @@ var (
 			Name:  "newer-than",
 			Usage: "copy objects newer than value in duration string (e.g. 7d10h31s)",
 		},
+		cli.StringFlag{
+			Name:  "smaller-than",
+			Usage: "copy objects smaller than value in size string (e.g. 10MiB)",
+		},
+		cli.StringFlag{
+			Name:  "larger-than",
+			Usage: "copy objects larger than value in size string (e.g. 10MiB)",
+		},
 		cli.StringFlag{
 			Name:  "storage-class, sc",
 			Usage: "set storage class for new object(s) on target",
@@ -68,7 +76,11 @@ var (
 		},
 		cli.StringFlag{
 			Name:  "attr",
-			Usage: "add custom metadata for the object",
+			Usage: "add custom metadata for the object, values may use {{ext_mime}} and {{ext}}",
+		},
+		cli.StringFlag{
+			Name:  "attr-file",
+			Usage: "add custom metadata for the objects matching the patterns listed in a file",
 		},
 		cli.BoolFlag{
 			Name:  "continue, c",
@@ -86,6 +98,10 @@ var (
 			Name:  "md5",
 			Usage: "force all upload(s) to calculate md5sum checksum",
 		},
+		cli.BoolFlag{
+			Name:  "no-server-side-copy",
+			Usage: "always download and upload objects, even between aliases of the same deployment",
+		},
 		cli.StringFlag{
 			Name:  "tags",
 			Usage: "apply one or more tags to the uploaded objects",
@@ -106,6 +122,61 @@ var (
 			Name:  "zip",
 			Usage: "Extract from remote zip file (MinIO server source only)",
 		},
+		cli.StringFlag{
+			Name:  "limit-upload",
+			Usage: "limits uploads to a maximum rate in KiB/s, MiB/s, GiB/s. (default: unlimited)",
+		},
+		cli.StringFlag{
+			Name:  "limit-download",
+			Usage: "limits downloads to a maximum rate in KiB/s, MiB/s, GiB/s. (default: unlimited)",
+		},
+		cli.StringFlag{
+			Name:  "files",
+			Usage: "copy objects listed in a file, one key relative to SOURCE per line, text after a TAB and lines starting with '#' are ignored, escape a leading '#' as '\\#' ('-' for STDIN)",
+		},
+		cli.BoolFlag{
+			Name:  "strict",
+			Usage: "fail on the first malformed or missing entry of --files instead of skipping it",
+		},
+		cli.BoolFlag{
+			Name:  "verify",
+			Usage: "verify the checksums of source and target after copying, fail on mismatch",
+		},
+		cli.BoolFlag{
+			Name:  "if-not-exists",
+			Usage: "skip objects which already exist on the target, without comparing them",
+		},
+		cli.StringFlag{
+			Name:  "if-match",
+			Usage: "overwrite the target only if its current ETag matches the given value",
+		},
+		cli.BoolFlag{
+			Name:  "if-none-match",
+			Usage: "write the target only if it does not exist, checked by the server",
+		},
+		cli.BoolFlag{
+			Name:  "resume",
+			Usage: "resume interrupted multipart uploads, skipping parts already uploaded",
+		},
+		cli.DurationFlag{
+			Name:  "resume-expiry",
+			Usage: "discard state of interrupted uploads older than this duration",
+			Value: defaultResumeExpiry,
+		},
+		cli.IntFlag{
+			Name:  "retry",
+			Usage: "retry failed transfers up to N times on server and network errors",
+		},
+		cli.DurationFlag{
+			Name:  "retry-delay",
+			Usage: "delay before the first retry, doubled after every retry",
+			Value: defaultRetryDelay,
+		},
+		cli.StringFlag{
+			Name:   "progress-file",
+			Usage:  "append the transfer progress as JSON lines to a file or named pipe every second",
+			EnvVar: "MC_PROGRESS_FILE",
+		},
 	}
 )
 
@@ -136,8 +207,9 @@ FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
 ENVIRONMENT VARIABLES:
-  MC_ENCRYPT:      list of comma delimited prefixes
-  MC_ENCRYPT_KEY:  list of comma delimited prefix=secret values
+  MC_ENCRYPT:        list of comma delimited prefixes
+  MC_ENCRYPT_KEY:    list of comma delimited prefix=secret values
+  MC_PROGRESS_FILE:  file or named pipe to append the transfer progress to, same as --progress-file
 
 EXAMPLES:
   01. Copy a list of objects from local file system to Amazon S3 cloud storage.
@@ -201,6 +273,52 @@ EXAMPLES:
   20. Set tags to the uploaded objects
       {{.Prompt}} {{.HelpName}} -r --tags "category=prod&type=backup" ./data/ play/another-bucket/
 
+  21. Copy a folder recursively to an object storage while limiting the upload rate to 10MiB/s.
+      {{.Prompt}} {{.HelpName}} -r --limit-upload 10MiB ./data/ play/another-bucket/
+
+  22. Copy a large file to an object storage, re-running the same command after an interruption
+      only uploads the parts which are missing.
+      {{.Prompt}} {{.HelpName}} --resume ./backup.tar play/mybucket/
+
+  23. Copy an object between two clusters and verify that the checksums of source and target match.
+      {{.Prompt}} {{.HelpName}} --verify siteA/mybucket/object.tar siteB/mybucket/
+
+  24. Copy only objects between 1MiB and 1GiB that were modified in the last 7 days. Size and age
+      filters combine, an object is copied only when it matches all of them.
+      {{.Prompt}} {{.HelpName}} -r --larger-than 1MiB --smaller-than 1GiB --newer-than 7d play/mybucket/ /tmp/dest/
+
+  25. Copy the objects listed in 'keys.txt', one key per line, from a backup bucket to a local folder,
+      keeping the folder structure of the keys.
+      {{.Prompt}} {{.HelpName}} --files keys.txt play/backup/ /tmp/restore/
+
+  26. Copy a folder recursively from a busy cluster, retrying objects failing with server errors up to 5 times.
+      {{.Prompt}} {{.HelpName}} -r --retry 5 --retry-delay 2s siteA/photos/ /tmp/photos/
+
+  27. Copy a folder between two aliases of the same deployment without using server side copy.
+      {{.Prompt}} {{.HelpName}} -r --no-server-side-copy admin/mybucket/photos/ teamA/archive/photos/
+
+  28. Copy a website folder, setting the Content-Type of every object from its extension.
+      {{.Prompt}} {{.HelpName}} -r --attr "Content-Type={{"{{"}}ext_mime{{"}}"}}" ./public/ play/website/
+
+  29. Copy a website folder, setting the metadata of the objects matching the patterns listed in 'attrs.txt'.
+      Every line holds a pattern followed by metadata, e.g. '*.html Cache-Control=no-cache'.
+      {{.Prompt}} {{.HelpName}} -r --attr-file attrs.txt ./public/ play/website/
+
+  30. Run several copies in the background, appending their progress to the same file. Every
+      line holds the pid, status, transferred and total bytes of one mc process.
+      {{.Prompt}} export MC_PROGRESS_FILE=/tmp/backup-progress
+      {{.Prompt}} {{.HelpName}} --quiet -r /data/photos/ play/backup/photos/ &
+      {{.Prompt}} {{.HelpName}} --quiet -r /data/videos/ play/backup/videos/ &
+
+  31. Seed a bucket with default objects, leaving any object already present on the target untouched.
+      {{.Prompt}} {{.HelpName}} -r --if-not-exists ./defaults/ play/config/
+
+  32. Update an object only if nobody changed it since it was read, failing with a conflict otherwise.
+      {{.Prompt}} {{.HelpName}} --if-match "2b6b1e7c9d1ea3ffa7d8b8d7f1b3e9a0" state.json play/config/state.json
+
+  33. Create an object only if it does not exist yet, the check is done atomically by the server.
+      {{.Prompt}} {{.HelpName}} --if-none-match lock.json play/config/lock.json
+
 `,
 }
 
@@ -243,7 +361,7 @@ type ProgressReader interface {
 }
 
 // doCopy - Copy a single file from source to destination
-func doCopy(ctx context.Context, cpURLs URLs, pg ProgressReader, encKeyDB map[string][]prefixSSEPair, isMvCmd bool, preserve, isZip bool) URLs {
+func doCopy(ctx context.Context, cpURLs URLs, pg ProgressReader, encKeyDB map[string][]prefixSSEPair, isMvCmd bool, preserve, isZip, isVerify bool) URLs {
 	if cpURLs.Error != nil {
 		cpURLs.Error = cpURLs.Error.Trace()
 		return cpURLs
@@ -269,7 +387,13 @@ func doCopy(ctx context.Context, cpURLs URLs, pg ProgressReader, encKeyDB map[st
 		})
 	}
 
-	urls := uploadSourceToTargetURL(ctx, cpURLs, pg, encKeyDB, preserve, isZip)
+	urls := retryTransfer(ctx, pg, func(progress io.Reader) URLs {
+		return uploadSourceToTargetURL(ctx, cpURLs, progress, encKeyDB, preserve, isZip)
+	})
+	if isVerify && urls.Error == nil {
+		// The target is left in place on a mismatch for investigation.
+		urls.Error = verifyCopy(ctx, urls, encKeyDB, isZip)
+	}
 	if isMvCmd && urls.Error == nil {
 		rmManager.add(ctx, sourceAlias, sourceURL.String())
 	}
@@ -299,6 +423,8 @@ func doPrepareCopyURLs(ctx context.Context, session *sessionV8, cancelCopy conte
 	versionID := session.Header.CommandStringFlags["version-id"]
 	olderThan := session.Header.CommandStringFlags["older-than"]
 	newerThan := session.Header.CommandStringFlags["newer-than"]
+	smallerThan := parseSizeFilter("smaller-than", session.Header.CommandStringFlags["smaller-than"])
+	largerThan := parseSizeFilter("larger-than", session.Header.CommandStringFlags["larger-than"])
 	encryptKeys := session.Header.CommandStringFlags["encrypt-key"]
 	encrypt := session.Header.CommandStringFlags["encrypt"]
 	encKeyDB, err := parseAndValidateEncryptionKeys(encryptKeys, encrypt)
@@ -319,6 +445,8 @@ func doPrepareCopyURLs(ctx context.Context, session *sessionV8, cancelCopy conte
 		encKeyDB:    encKeyDB,
 		olderThan:   olderThan,
 		newerThan:   newerThan,
+		smallerThan: smallerThan,
+		largerThan:  largerThan,
 		timeRef:     parseRewindFlag(rewind),
 		versionID:   versionID,
 	}
@@ -380,6 +508,9 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 	var isCopied func(string) bool
 	var totalObjects, totalBytes int64
 
+	attrRules, err := parseAttrFile(cli.String("attr-file"))
+	fatalIf(err.Trace(cli.String("attr-file")), "Unable to parse attribute file.")
+
 	cpURLsCh := make(chan URLs, 10000)
 
 	// Store a progress bar or an accounter
@@ -392,6 +523,12 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 		pg = newAccounter(totalBytes)
 	}
 
+	var pgFile *progressFile
+	if path := cli.String("progress-file"); path != "" {
+		pgFile, err = newProgressFile(path, pg)
+		fatalIf(err.Trace(path), "Unable to open progress file.")
+	}
+
 	sourceURLs := cli.Args()[:len(cli.Args())-1]
 	targetURL := cli.Args()[len(cli.Args())-1] // Last one is target
 
@@ -410,6 +547,7 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 		}
 
 		pg.SetTotal(totalBytes)
+		pgFile.SetTotal(totalBytes, totalObjects)
 
 		go func() {
 			jsoniter := jsoniter.ConfigCompatibleWithStandardLibrary
@@ -441,15 +579,19 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 		go func() {
 			totalBytes := int64(0)
 			opts := prepareCopyURLsOpts{
-				sourceURLs:  sourceURLs,
-				targetURL:   targetURL,
-				isRecursive: isRecursive,
-				encKeyDB:    encKeyDB,
-				olderThan:   olderThan,
-				newerThan:   newerThan,
-				timeRef:     parseRewindFlag(rewind),
-				versionID:   versionID,
-				isZip:       cli.Bool("zip"),
+				sourceURLs:    sourceURLs,
+				targetURL:     targetURL,
+				isRecursive:   isRecursive,
+				encKeyDB:      encKeyDB,
+				olderThan:     olderThan,
+				newerThan:     newerThan,
+				smallerThan:   parseSizeFilter("smaller-than", cli.String("smaller-than")),
+				largerThan:    parseSizeFilter("larger-than", cli.String("larger-than")),
+				timeRef:       parseRewindFlag(rewind),
+				versionID:     versionID,
+				isZip:         cli.Bool("zip"),
+				filesManifest: cli.String("files"),
+				isStrict:      cli.Bool("strict"),
 			}
 			for cpURLs := range prepareCopyURLs(ctx, opts) {
 				if cpURLs.Error != nil {
@@ -471,6 +613,7 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 					totalBytes += cpURLs.SourceContent.Size
 					pg.SetTotal(totalBytes)
 					totalObjects++
+					pgFile.SetTotal(totalBytes, totalObjects)
 				}
 				cpURLsCh <- cpURLs
 			}
@@ -535,24 +678,42 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 
 				preserve := cli.Bool("preserve")
 				isZip := cli.Bool("zip")
+				isVerify := cli.Bool("verify")
+				preconditions := putPreconditions{
+					ifMatch:     cli.String("if-match"),
+					ifNoneMatch: cli.Bool("if-none-match"),
+				}
+				var userMetaMap map[string]string
 				if cli.String("attr") != "" {
-					userMetaMap, _ := getMetaDataEntry(cli.String("attr"))
-					for metadataKey, metaDataVal := range userMetaMap {
-						cpURLs.TargetContent.UserMetadata[metadataKey] = metaDataVal
-					}
+					userMetaMap, _ = getMetaDataEntry(cli.String("attr"))
 				}
+				applyAttrs(cpURLs.TargetContent.UserMetadata, userMetaMap, attrRules, cpURLs.TargetContent.URL.Path)
 
 				cpURLs.MD5 = cli.Bool("md5") || withLock
 				cpURLs.DisableMultipart = cli.Bool("disable-multipart")
+				cpURLs.DisableServerSideCopy = cli.Bool("no-server-side-copy")
 
 				// Verify if previously copied, notify progress bar.
 				if isCopied != nil && isCopied(cpURLs.SourceContent.URL.String()) {
 					parallel.queueTask(func() URLs {
 						return doCopyFake(ctx, cpURLs, pg)
 					}, 0)
+				} else if cli.Bool("if-not-exists") {
+					parallel.queueTask(func() URLs {
+						return doCopyIfNotExists(ctx, cpURLs, pg, encKeyDB, isMvCmd, preserve, isZip, isVerify)
+					}, cpURLs.SourceContent.Size)
+				} else if preconditions.isSet() {
+					parallel.queueTask(func() URLs {
+						urls := doCopy(withPutPreconditions(ctx, preconditions), cpURLs, pg, encKeyDB, isMvCmd, preserve, isZip, isVerify)
+						if isErrPreconditionFailed(urls.Error) {
+							targetPath := filepath.ToSlash(filepath.Join(urls.TargetAlias, urls.TargetContent.URL.Path))
+							urls.Error = preconditionFailedError(targetPath, preconditions)
+						}
+						return urls
+					}, cpURLs.SourceContent.Size)
 				} else {
 					parallel.queueTask(func() URLs {
-						return doCopy(ctx, cpURLs, pg, encKeyDB, isMvCmd, preserve, isZip)
+						return doCopy(ctx, cpURLs, pg, encKeyDB, isMvCmd, preserve, isZip, isVerify)
 					}, cpURLs.SourceContent.Size)
 				}
 			}
@@ -560,6 +721,7 @@ func doCopySession(ctx context.Context, cancelCopy context.CancelFunc, cli *cli.
 	}()
 
 	var retErr error
+	var skipped int64
 	errSeen := false
 	cpAllFilesErr := true
 
@@ -587,6 +749,10 @@ loop:
 					session.Header.LastCopied = cpURLs.SourceContent.URL.String()
 					session.Save()
 				}
+				if cpURLs.Skipped {
+					skipped++
+				}
+				pgFile.AddObject()
 				cpAllFilesErr = false
 			} else {
 
@@ -628,6 +794,8 @@ loop:
 		}
 	}
 
+	pgFile.Close(retErr != nil)
+
 	if progressReader, ok := pg.(*progressBar); ok {
 		if (errSeen && totalObjects == 1) || (cpAllFilesErr && totalObjects > 1) {
 			console.Eraseline()
@@ -640,6 +808,14 @@ loop:
 		}
 	}
 
+	if cli.Bool("if-not-exists") {
+		printMsg(copySkipSummaryMessage{Skipped: skipped})
+	}
+
+	if msg, ok := getRetryMessage(); ok {
+		printMsg(msg)
+	}
+
 	return retErr
 }
 
@@ -652,6 +828,15 @@ func mainCopy(cliCtx *cli.Context) error {
 	encKeyDB, err := getEncKeys(cliCtx)
 	fatalIf(err, "Unable to parse encryption keys.")
 
+	// Parse upload and download rate limits.
+	fatalIf(setRateLimitsFromContext(cliCtx), "Unable to parse rate limits.")
+
+	globalResume = cliCtx.Bool("resume")
+	globalResumeExpiry = cliCtx.Duration("resume-expiry")
+
+	globalRetry = cliCtx.Int("retry")
+	globalRetryDelay = cliCtx.Duration("retry-delay")
+
 	// Parse metadata.
 	userMetaMap := make(map[string]string)
 	if cliCtx.String("attr") != "" {
@@ -700,6 +885,8 @@ func mainCopy(cliCtx *cli.Context) error {
 			session.Header.CommandStringFlags["version-id"] = versionID
 			session.Header.CommandStringFlags["older-than"] = olderThan
 			session.Header.CommandStringFlags["newer-than"] = newerThan
+			session.Header.CommandStringFlags["smaller-than"] = cliCtx.String("smaller-than")
+			session.Header.CommandStringFlags["larger-than"] = cliCtx.String("larger-than")
 			session.Header.CommandStringFlags["storage-class"] = storageClass
 			session.Header.CommandStringFlags["tags"] = tags
 			session.Header.CommandStringFlags[rmFlag] = retentionMode
@@ -715,6 +902,10 @@ func mainCopy(cliCtx *cli.Context) error {
 			session.Header.UserMetaData = userMetaMap
 			session.Header.CommandBoolFlags["md5"] = cliCtx.Bool("md5")
 			session.Header.CommandBoolFlags["disable-multipart"] = cliCtx.Bool("disable-multipart")
+			session.Header.CommandBoolFlags["no-server-side-copy"] = cliCtx.Bool("no-server-side-copy")
+			session.Header.CommandBoolFlags["if-not-exists"] = cliCtx.Bool("if-not-exists")
+			session.Header.CommandBoolFlags["if-none-match"] = cliCtx.Bool("if-none-match")
+			session.Header.CommandStringFlags["if-match"] = cliCtx.String("if-match")
 
 			var e error
 			if session.Header.RootPath, e = os.Getwd(); e != nil {
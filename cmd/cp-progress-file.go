@@ -0,0 +1,132 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// progressFileInterval - how often the transfer progress is written
+// to the --progress-file.
+const progressFileInterval = time.Second
+
+// progressFileRecord - a line of the --progress-file, every mc process
+// writes its own lines identified by its pid. The last line of a pid
+// has the status "done" or "failed".
+type progressFileRecord struct {
+	PID          int    `json:"pid"`
+	Status       string `json:"status"`
+	Transferred  int64  `json:"transferred"`
+	Total        int64  `json:"total"`
+	Objects      int64  `json:"objects"`
+	TotalObjects int64  `json:"totalObjects"`
+}
+
+// progressFile - periodically appends the progress of a transfer to
+// a file or a named pipe, so that a wrapper running many mc processes
+// can aggregate it. All methods can be called on a nil *progressFile.
+type progressFile struct {
+	f            *os.File
+	pg           Progress
+	total        int64
+	objects      int64
+	totalObjects int64
+
+	doneCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// newProgressFile - opens path for appending, opening a named pipe
+// blocks until it is opened for reading.
+func newProgressFile(path string, pg Progress) (*progressFile, *probe.Error) {
+	f, e := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if e != nil {
+		return nil, probe.NewError(e)
+	}
+	p := &progressFile{f: f, pg: pg, doneCh: make(chan struct{})}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(progressFileInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.doneCh:
+				return
+			case <-ticker.C:
+				p.write("running")
+			}
+		}
+	}()
+	return p, nil
+}
+
+// SetTotal - sets the total number of bytes and objects to transfer.
+func (p *progressFile) SetTotal(bytes, objects int64) {
+	if p == nil {
+		return
+	}
+	atomic.StoreInt64(&p.total, bytes)
+	atomic.StoreInt64(&p.totalObjects, objects)
+}
+
+// AddObject - counts a transferred object.
+func (p *progressFile) AddObject() {
+	if p == nil {
+		return
+	}
+	atomic.AddInt64(&p.objects, 1)
+}
+
+// write - appends a single line, lines are kept short so that
+// concurrent writers do not interleave.
+func (p *progressFile) write(status string) {
+	b, e := json.Marshal(progressFileRecord{
+		PID:          os.Getpid(),
+		Status:       status,
+		Transferred:  p.pg.Get(),
+		Total:        atomic.LoadInt64(&p.total),
+		Objects:      atomic.LoadInt64(&p.objects),
+		TotalObjects: atomic.LoadInt64(&p.totalObjects),
+	})
+	if e != nil {
+		return
+	}
+	p.f.Write(append(b, '\n'))
+}
+
+// Close - writes the final progress line and closes the file.
+func (p *progressFile) Close(failed bool) {
+	if p == nil {
+		return
+	}
+	close(p.doneCh)
+	p.wg.Wait()
+	status := "done"
+	if failed {
+		status = "failed"
+	}
+	p.write(status)
+	p.f.Close()
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProgressFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress")
+	pg := newAccounter(0)
+	p, err := newProgressFile(path, pg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.SetTotal(100, 2)
+	pg.Add(100)
+	p.AddObject()
+	p.AddObject()
+	p.Close(false)
+
+	// A nil progress file is a no-op.
+	var np *progressFile
+	np.SetTotal(1, 1)
+	np.AddObject()
+	np.Close(true)
+
+	f, e := os.Open(path)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer f.Close()
+	var last progressFileRecord
+	for s := bufio.NewScanner(f); s.Scan(); {
+		if e = json.Unmarshal(s.Bytes(), &last); e != nil {
+			t.Fatal(e)
+		}
+	}
+	expected := progressFileRecord{PID: os.Getpid(), Status: "done", Transferred: 100, Total: 100, Objects: 2, TotalObjects: 2}
+	if last != expected {
+		t.Fatalf("expected %+v, got %+v", expected, last)
+	}
+}
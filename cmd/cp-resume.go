@@ -0,0 +1,184 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+	"github.com/minio/pkg/quick"
+)
+
+const (
+	globalResumeDir          = "resume"
+	globalResumeStateVersion = "1"
+	defaultResumeExpiry      = 7 * 24 * time.Hour
+
+	// Smallest part size of a multipart upload, objects below it
+	// are uploaded with a single PUT.
+	defaultResumePartSize = 16 * humanize.MiByte
+)
+
+// resumePart is a part which was successfully uploaded.
+type resumePart struct {
+	PartNumber int    `json:"partNumber"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+}
+
+// resumeState records the progress of a multipart upload so that an
+// interrupted `mc cp --resume` can skip the parts already uploaded.
+type resumeState struct {
+	Version string `json:"version"`
+	Source  string `json:"source"`
+	Target  string `json:"target"`
+
+	// Identity of the source at the time the upload was started.
+	SourceSize    int64     `json:"sourceSize"`
+	SourceETag    string    `json:"sourceETag,omitempty"`
+	SourceModTime time.Time `json:"sourceModTime"`
+
+	UploadID string       `json:"uploadID"`
+	PartSize int64        `json:"partSize"`
+	Parts    []resumePart `json:"parts"`
+	Updated  time.Time    `json:"updated"`
+
+	// Upload ID of a stale state that was discarded, it is
+	// aborted on the target before a new upload is started.
+	staleUploadID string
+	file          string
+}
+
+// isResumableSize - returns true if an upload of size bytes is a
+// multipart upload for the given part size, zero is the default.
+func isResumableSize(size int64, partSize uint64) bool {
+	if partSize == 0 {
+		partSize = defaultResumePartSize
+	}
+	return size > 0 && size >= int64(partSize)
+}
+
+// getResumeDir - get resume state directory.
+func getResumeDir() (string, *probe.Error) {
+	configDir, err := getMcConfigDir()
+	if err != nil {
+		return "", err.Trace()
+	}
+	return filepath.Join(configDir, globalResumeDir), nil
+}
+
+// getResumeFile - returns the state file for a source and target pair.
+func getResumeFile(source, target string) (string, *probe.Error) {
+	resumeDir, err := getResumeDir()
+	if err != nil {
+		return "", err.Trace()
+	}
+	sum := sha256.Sum256([]byte(source + "\x00" + target))
+	return filepath.Join(resumeDir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// loadResumeState - loads the resume state of source and target, a new
+// state is returned if none exists. States older than timeout, or
+// recorded for a source which has since changed, are discarded.
+func loadResumeState(source, target string, content *ClientContent, timeout time.Duration) (*resumeState, *probe.Error) {
+	file, err := getResumeFile(source, target)
+	if err != nil {
+		return nil, err.Trace(source, target)
+	}
+
+	fresh := &resumeState{
+		Version:       globalResumeStateVersion,
+		Source:        source,
+		Target:        target,
+		SourceSize:    content.Size,
+		SourceETag:    content.ETag,
+		SourceModTime: content.Time,
+		file:          file,
+	}
+
+	state := &resumeState{}
+	if _, e := quick.LoadConfig(file, nil, state); e != nil {
+		if os.IsNotExist(e) {
+			return fresh, nil
+		}
+		// A corrupted state is no better than no state.
+		fresh.remove()
+		return fresh, nil
+	}
+	state.file = file
+
+	switch {
+	case state.Version != globalResumeStateVersion,
+		timeout > 0 && time.Since(state.Updated) > timeout:
+		fresh.staleUploadID = state.UploadID
+		fresh.remove()
+	case state.SourceSize != content.Size,
+		state.SourceETag != content.ETag,
+		!state.SourceModTime.Equal(content.Time):
+		if !globalQuiet && !globalJSON {
+			console.Infoln("Source `" + source + "` has changed since the interrupted upload, starting over.")
+		}
+		fresh.staleUploadID = state.UploadID
+		fresh.remove()
+	default:
+		return state, nil
+	}
+	return fresh, nil
+}
+
+// uploadedSize - returns the number of bytes already uploaded.
+func (s *resumeState) uploadedSize() (size int64) {
+	for _, part := range s.Parts {
+		size += part.Size
+	}
+	return size
+}
+
+// reset - forgets the current upload and its parts.
+func (s *resumeState) reset() {
+	s.UploadID = ""
+	s.PartSize = 0
+	s.Parts = nil
+}
+
+// save - persists the resume state.
+func (s *resumeState) save() *probe.Error {
+	if e := os.MkdirAll(filepath.Dir(s.file), 0o700); e != nil {
+		return probe.NewError(e)
+	}
+	s.Updated = UTCNow()
+	qs, e := quick.NewConfig(s, nil)
+	if e != nil {
+		return probe.NewError(e).Trace(s.file)
+	}
+	if e = qs.Save(s.file); e != nil {
+		return probe.NewError(e).Trace(s.file)
+	}
+	return nil
+}
+
+// remove - removes the resume state file.
+func (s *resumeState) remove() {
+	os.Remove(s.file)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestResumeState(c *C) {
+	defer func(dir string) { mcCustomConfigDir = dir }(mcCustomConfigDir)
+	mcCustomConfigDir = c.MkDir()
+
+	content := &ClientContent{Size: 64 << 20, ETag: "abc", Time: time.Unix(1600000000, 0).UTC()}
+	state, err := loadResumeState("src/obj", "play/bucket/obj", content, time.Hour)
+	c.Assert(err, IsNil)
+	c.Assert(state.UploadID, Equals, "")
+
+	state.UploadID = "upload-1"
+	state.PartSize = 16 << 20
+	state.Parts = []resumePart{{PartNumber: 1, ETag: "e1", Size: 16 << 20}}
+	c.Assert(state.save(), IsNil)
+
+	// Same source, the recorded parts are kept.
+	state, err = loadResumeState("src/obj", "play/bucket/obj", content, time.Hour)
+	c.Assert(err, IsNil)
+	c.Assert(state.UploadID, Equals, "upload-1")
+	c.Assert(state.uploadedSize(), Equals, int64(16<<20))
+
+	// Source changed, the upload starts over and the old one is aborted.
+	changed := *content
+	changed.ETag = "def"
+	state, err = loadResumeState("src/obj", "play/bucket/obj", &changed, time.Hour)
+	c.Assert(err, IsNil)
+	c.Assert(state.UploadID, Equals, "")
+	c.Assert(state.staleUploadID, Equals, "upload-1")
+
+	// Expired state is discarded.
+	state.UploadID = "upload-2"
+	c.Assert(state.save(), IsNil)
+	state, err = loadResumeState("src/obj", "play/bucket/obj", &changed, time.Nanosecond)
+	c.Assert(err, IsNil)
+	c.Assert(state.UploadID, Equals, "")
+	c.Assert(state.staleUploadID, Equals, "upload-2")
+}
+
+func (s *TestSuite) TestIsResumableSize(c *C) {
+	c.Assert(isResumableSize(0, 0), Equals, false)
+	c.Assert(isResumableSize(-1, 0), Equals, false)
+	c.Assert(isResumableSize(16<<20-1, 0), Equals, false)
+	c.Assert(isResumableSize(16<<20, 0), Equals, true)
+	c.Assert(isResumableSize(8<<20, 5<<20), Equals, true)
+	c.Assert(isResumableSize(4<<20, 5<<20), Equals, false)
+}
@@ -53,12 +53,25 @@ func checkCopySyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 		fatalIf(errDummy().Trace(cliCtx.Args()...), "Unable to pass --version flag with multiple copy sources arguments.")
 	}
 
+	// Fail on invalid size filters before anything is listed.
+	parseSizeFilter("smaller-than", cliCtx.String("smaller-than"))
+	parseSizeFilter("larger-than", cliCtx.String("larger-than"))
+
 	if isZip && cliCtx.String("rewind") != "" {
 		fatalIf(errDummy().Trace(cliCtx.Args()...), "--zip and --rewind cannot be used together")
 	}
 
+	filesManifest := cliCtx.String("files")
+	if filesManifest != "" && (len(srcURLs) != 1 || isRecursive || versionID != "" || cliCtx.Bool("continue")) {
+		fatalIf(errDummy().Trace(cliCtx.Args()...), "--files requires a single source folder and cannot be used with --recursive, --version-id and --continue.")
+	}
+
 	// Verify if source(s) exists.
 	for _, srcURL := range srcURLs {
+		if filesManifest != "" {
+			// Objects listed in --files are verified when they are copied.
+			break
+		}
 		var err *probe.Error
 		if !isRecursive {
 			_, _, err = url2Stat(ctx, srcURL, versionID, false, encKeyDB, timeRef, isZip)
@@ -83,6 +96,23 @@ func checkCopySyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 		}
 	}
 
+	if cliCtx.String("if-match") != "" || cliCtx.Bool("if-none-match") {
+		if cliCtx.String("if-match") != "" && cliCtx.Bool("if-none-match") {
+			fatalIf(errInvalidArgument().Trace(), "--if-match and --if-none-match cannot be specified together.")
+		}
+		if cliCtx.String("if-match") != "" && (len(srcURLs) > 1 || isRecursive || filesManifest != "") {
+			fatalIf(errInvalidArgument().Trace(), "--if-match can only be used to copy a single object.")
+		}
+		if cliCtx.Bool("if-not-exists") {
+			fatalIf(errInvalidArgument().Trace(), "--if-not-exists cannot be used with --if-match or --if-none-match.")
+		}
+		clnt, err := newClient(tgtURL)
+		fatalIf(err.Trace(tgtURL), "Unable to initialize target `"+tgtURL+"`.")
+		if clnt.GetURL().Type != objectStorage {
+			fatalIf(errInvalidArgument().Trace(tgtURL), "--if-match and --if-none-match require an object storage target.")
+		}
+	}
+
 	if cliCtx.String(rdFlag) != "" && cliCtx.String(rmFlag) == "" {
 		fatalIf(errInvalidArgument().Trace(), fmt.Sprintf("Both object retention flags `--%s` and `--%s` are required.\n", rdFlag, rmFlag))
 	}
@@ -91,6 +121,16 @@ func checkCopySyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 		fatalIf(errInvalidArgument().Trace(), fmt.Sprintf("Both object retention flags `--%s` and `--%s` are required.\n", rdFlag, rmFlag))
 	}
 
+	// Preserve functionality not supported for windows
+	if cliCtx.Bool("preserve") && runtime.GOOS == "windows" {
+		fatalIf(errInvalidArgument().Trace(), "Permissions are not preserved on windows platform.")
+	}
+
+	// Sources are read from --files, relative to the source folder.
+	if filesManifest != "" {
+		return
+	}
+
 	operation := "copy"
 	if isMvCmd {
 		operation = "move"
@@ -104,6 +144,8 @@ func checkCopySyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 		encKeyDB:    encKeyDB,
 		olderThan:   "",
 		newerThan:   "",
+		smallerThan: -1,
+		largerThan:  -1,
 		timeRef:     timeRef,
 		versionID:   versionID,
 		isZip:       isZip,
@@ -133,11 +175,6 @@ func checkCopySyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 	default:
 		fatalIf(errInvalidArgument().Trace(), "Unable to guess the type of "+operation+" operation.")
 	}
-
-	// Preserve functionality not supported for windows
-	if cliCtx.Bool("preserve") && runtime.GOOS == "windows" {
-		fatalIf(errInvalidArgument().Trace(), "Permissions are not preserved on windows platform.")
-	}
 }
 
 // checkCopySyntaxTypeA verifies if the source and target are valid file arguments.
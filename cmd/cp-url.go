@@ -226,9 +226,13 @@ type prepareCopyURLsOpts struct {
 	isRecursive          bool
 	encKeyDB             map[string][]prefixSSEPair
 	olderThan, newerThan string
+	smallerThan          int64
+	largerThan           int64
 	timeRef              time.Time
 	versionID            string
 	isZip                bool
+	filesManifest        string
+	isStrict             bool
 }
 
 // prepareCopyURLs - prepares target and source clientURLs for copying.
@@ -236,6 +240,12 @@ func prepareCopyURLs(ctx context.Context, o prepareCopyURLsOpts) chan URLs {
 	copyURLsCh := make(chan URLs)
 	go func(o prepareCopyURLsOpts) {
 		defer close(copyURLsCh)
+		if o.filesManifest != "" {
+			for cURLs := range prepareCopyURLsFromFiles(ctx, o) {
+				copyURLsCh <- cURLs
+			}
+			return
+		}
 		cpType, cpVersion, err := guessCopyURLType(ctx, o)
 		fatalIf(err.Trace(), "Unable to guess the type of copy operation.")
 
@@ -271,6 +281,13 @@ func prepareCopyURLs(ctx context.Context, o prepareCopyURLsOpts) chan URLs {
 				continue
 			}
 
+			// Skip objects not matching --smaller-than and --larger-than if specified
+			if cpURLs.SourceContent != nil {
+				if !isSmallerThan(cpURLs.SourceContent.Size, o.smallerThan) || !isLargerThan(cpURLs.SourceContent.Size, o.largerThan) {
+					continue
+				}
+			}
+
 			finalCopyURLsCh <- cpURLs
 		}
 	}()
@@ -0,0 +1,141 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// Checksum algorithms in order of preference when verifying a copy.
+var verifyChecksumAlgorithms = []string{"CRC32C", "SHA256", "CRC32", "SHA1"}
+
+// checksumPartsCount - returns the parts count suffix of a composite
+// checksum, empty for a full object checksum.
+func checksumPartsCount(checksum string) string {
+	if i := strings.LastIndex(checksum, "-"); i >= 0 {
+		return checksum[i+1:]
+	}
+	return ""
+}
+
+// commonChecksum - returns the preferred checksum algorithm reported for
+// both source and target. Composite checksums of multipart objects can
+// only be compared when both objects have the same number of parts.
+func commonChecksum(source, target map[string]string) (string, bool) {
+	for _, algo := range verifyChecksumAlgorithms {
+		s, t := source[algo], target[algo]
+		if s == "" || t == "" {
+			continue
+		}
+		if checksumPartsCount(s) != checksumPartsCount(t) {
+			continue
+		}
+		return algo, true
+	}
+	return "", false
+}
+
+// computeCRC32C - reads the whole object and returns its base64
+// encoded CRC32C checksum.
+func computeCRC32C(ctx context.Context, alias, urlStr string, opts GetOptions) (string, *probe.Error) {
+	reader, _, err := getSourceStream(ctx, alias, urlStr, getSourceOpts{GetOptions: opts})
+	if err != nil {
+		return "", err.Trace(alias, urlStr)
+	}
+	defer reader.Close()
+
+	hasher := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	if _, e := io.Copy(hasher, reader); e != nil {
+		return "", probe.NewError(e).Trace(alias, urlStr)
+	}
+	sum := make([]byte, 4)
+	binary.BigEndian.PutUint32(sum, hasher.Sum32())
+	return base64.StdEncoding.EncodeToString(sum), nil
+}
+
+// verifyCopy - compares the checksums of source and target after a
+// successful copy. Checksums reported by the servers are used when both
+// sides have a comparable one, otherwise both objects are read back and
+// their CRC32C is computed locally.
+func verifyCopy(ctx context.Context, urls URLs, encKeyDB map[string][]prefixSSEPair, isZip bool) *probe.Error {
+	sourceAlias, targetAlias := urls.SourceAlias, urls.TargetAlias
+	sourceURL, targetURL := urls.SourceContent.URL.String(), urls.TargetContent.URL.String()
+	sourcePath := filepath.ToSlash(filepath.Join(sourceAlias, urls.SourceContent.URL.Path))
+	targetPath := filepath.ToSlash(filepath.Join(targetAlias, urls.TargetContent.URL.Path))
+
+	sourceOpts := GetOptions{
+		SSE:       getSSE(sourcePath, encKeyDB[sourceAlias]),
+		VersionID: urls.SourceContent.VersionID,
+		Zip:       isZip,
+	}
+	targetOpts := GetOptions{
+		SSE: getSSE(targetPath, encKeyDB[targetAlias]),
+	}
+
+	sourceClnt, err := newClientFromAlias(sourceAlias, sourceURL)
+	if err != nil {
+		return err.Trace(sourceURL)
+	}
+	targetClnt, err := newClientFromAlias(targetAlias, targetURL)
+	if err != nil {
+		return err.Trace(targetURL)
+	}
+
+	sourceContent, err := sourceClnt.Stat(ctx, StatOptions{
+		sse:       sourceOpts.SSE,
+		versionID: sourceOpts.VersionID,
+		isZip:     isZip,
+		checksum:  true,
+	})
+	if err != nil {
+		return err.Trace(sourceURL)
+	}
+	targetContent, err := targetClnt.Stat(ctx, StatOptions{sse: targetOpts.SSE, checksum: true})
+	if err != nil {
+		return err.Trace(targetURL)
+	}
+
+	if algo, ok := commonChecksum(sourceContent.Checksum, targetContent.Checksum); ok {
+		sourceSum, targetSum := sourceContent.Checksum[algo], targetContent.Checksum[algo]
+		if sourceSum != targetSum {
+			return errChecksumMismatch(sourcePath, targetPath, algo, sourceSum, targetSum)
+		}
+		return nil
+	}
+
+	sourceSum, err := computeCRC32C(ctx, sourceAlias, sourceURL, sourceOpts)
+	if err != nil {
+		return err.Trace(sourceURL)
+	}
+	targetSum, err := computeCRC32C(ctx, targetAlias, targetURL, targetOpts)
+	if err != nil {
+		return err.Trace(targetURL)
+	}
+	if sourceSum != targetSum {
+		return errChecksumMismatch(sourcePath, targetPath, "CRC32C", sourceSum, targetSum)
+	}
+	return nil
+}
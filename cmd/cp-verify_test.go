@@ -0,0 +1,41 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestCommonChecksum(c *C) {
+	algo, ok := commonChecksum(
+		map[string]string{"CRC32C": "AAAAAA==", "SHA256": "abc"},
+		map[string]string{"SHA256": "abc"},
+	)
+	c.Assert(ok, Equals, true)
+	c.Assert(algo, Equals, "SHA256")
+
+	// Composite checksums with different parts count are not comparable.
+	_, ok = commonChecksum(
+		map[string]string{"CRC32C": "AAAAAA==-4"},
+		map[string]string{"CRC32C": "AAAAAA==-2"},
+	)
+	c.Assert(ok, Equals, false)
+
+	_, ok = commonChecksum(nil, map[string]string{"CRC32C": "AAAAAA=="})
+	c.Assert(ok, Equals, false)
+}
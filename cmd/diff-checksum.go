@@ -0,0 +1,104 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// diffChecksumOpts holds the options used to compare object contents
+// with `mc diff --checksum`.
+type diffChecksumOpts struct {
+	firstAlias  string
+	secondAlias string
+	encKeyDB    map[string][]prefixSSEPair
+	deep        bool
+}
+
+// checksumDiffers - reports whether the contents of the two objects of
+// a diff message differ. ETags are compared first, then checksums
+// reported by the servers. Composite ETags and checksums of multipart
+// objects are only comparable when both objects were uploaded with the
+// same part size, with deep set a mismatch between them or missing
+// checksums are resolved by reading both objects and computing their
+// CRC32C locally.
+func checksumDiffers(ctx context.Context, d diffMessage, o diffChecksumOpts) (bool, *probe.Error) {
+	first, second := d.firstContent, d.secondContent
+
+	firstETag, secondETag := strings.Trim(first.ETag, "\""), strings.Trim(second.ETag, "\"")
+	if firstETag != "" && secondETag != "" && checksumPartsCount(firstETag) == checksumPartsCount(secondETag) {
+		if firstETag == secondETag {
+			return false, nil
+		}
+		if checksumPartsCount(firstETag) == "" || !o.deep {
+			return true, nil
+		}
+	}
+
+	firstPath := filepath.ToSlash(filepath.Join(o.firstAlias, first.URL.Path))
+	secondPath := filepath.ToSlash(filepath.Join(o.secondAlias, second.URL.Path))
+	firstOpts := GetOptions{SSE: getSSE(firstPath, o.encKeyDB[o.firstAlias])}
+	secondOpts := GetOptions{SSE: getSSE(secondPath, o.encKeyDB[o.secondAlias])}
+
+	firstStat, err := statChecksum(ctx, o.firstAlias, d.FirstURL, firstOpts)
+	if err != nil {
+		return false, err.Trace(d.FirstURL)
+	}
+	secondStat, err := statChecksum(ctx, o.secondAlias, d.SecondURL, secondOpts)
+	if err != nil {
+		return false, err.Trace(d.SecondURL)
+	}
+	if algo, ok := commonChecksum(firstStat.Checksum, secondStat.Checksum); ok {
+		firstSum, secondSum := firstStat.Checksum[algo], secondStat.Checksum[algo]
+		if firstSum == secondSum {
+			return false, nil
+		}
+		if checksumPartsCount(firstSum) == "" || !o.deep {
+			return true, nil
+		}
+	}
+
+	if !o.deep {
+		return false, probe.NewError(errors.New("no comparable checksum available, use --deep to compare contents")).Trace(d.FirstURL, d.SecondURL)
+	}
+
+	firstSum, err := computeCRC32C(ctx, o.firstAlias, d.FirstURL, firstOpts)
+	if err != nil {
+		return false, err.Trace(d.FirstURL)
+	}
+	secondSum, err := computeCRC32C(ctx, o.secondAlias, d.SecondURL, secondOpts)
+	if err != nil {
+		return false, err.Trace(d.SecondURL)
+	}
+	return firstSum != secondSum, nil
+}
+
+// statChecksum - returns the object information along with the
+// checksums reported by the server.
+func statChecksum(ctx context.Context, alias, urlStr string, opts GetOptions) (*ClientContent, *probe.Error) {
+	clnt, err := newClientFromAlias(alias, urlStr)
+	if err != nil {
+		return nil, err.Trace(alias, urlStr)
+	}
+	return clnt.Stat(ctx, StatOptions{sse: opts.SSE, checksum: true})
+}
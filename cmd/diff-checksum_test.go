@@ -0,0 +1,50 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+)
+
+func TestChecksumDiffersETag(t *testing.T) {
+	testCases := []struct {
+		firstETag  string
+		secondETag string
+		differs    bool
+	}{
+		{`"5d41402abc4b2a76b9719d911017c592"`, "5d41402abc4b2a76b9719d911017c592", false},
+		{"5d41402abc4b2a76b9719d911017c592", "7d793037a0760186574b0282f2f435e7", true},
+		{"1b6453892473a467d07372d45eb05abc-4", "1b6453892473a467d07372d45eb05abc-4", false},
+		{"1b6453892473a467d07372d45eb05abc-4", "9f4b3a6a5e8d1c2b7a6f5e4d3c2b1a09-4", true},
+	}
+
+	for i, testCase := range testCases {
+		d := diffMessage{
+			firstContent:  &ClientContent{ETag: testCase.firstETag},
+			secondContent: &ClientContent{ETag: testCase.secondETag},
+		}
+		differs, err := checksumDiffers(context.Background(), d, diffChecksumOpts{})
+		if err != nil {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if differs != testCase.differs {
+			t.Fatalf("Test %d: expected %t, got %t", i+1, testCase.differs, differs)
+		}
+	}
+}
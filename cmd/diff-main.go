@@ -32,7 +32,20 @@ import (
 
 // diff specific flags.
 var (
-	diffFlags = []cli.Flag{}
+	diffFlags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  "checksum",
+			Usage: "compare ETags or checksums of objects with the same size instead of their modification time",
+		},
+		cli.BoolFlag{
+			Name:  "deep",
+			Usage: "read and checksum objects whose ETags or checksums are not comparable, requires --checksum",
+		},
+		cli.BoolFlag{
+			Name:  "emit-actions",
+			Usage: "print the cp or rm action which makes TARGET identical to SOURCE for each difference",
+		},
+	}
 )
 
 // Compute differences in object name, size, and date between two buckets.
@@ -53,12 +66,16 @@ FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
 DESCRIPTION:
-  Diff only calculates differences in object name, size and time. It *DOES NOT* compare objects' contents.
+  Diff only calculates differences in object name, size and time. It *DOES NOT* compare objects' contents
+  unless --checksum is specified. With --checksum, ETags or checksums reported by the servers are compared
+  instead of the modification time. ETags and checksums of multipart objects are only comparable when both
+  objects were uploaded using the same part size, pass --deep to read and checksum both objects when they
+  differ or are not available, for example on a local filesystem.
 
 LEGEND:
   < - object is only in source.
   > - object is only in destination.
-  ! - newer object is in source.
+  ! - newer object is in source, or contents differ with --checksum.
 
 EXAMPLES:
   1. Compare a local folder with a folder on Amazon S3 cloud storage.
@@ -66,15 +83,60 @@ EXAMPLES:
 
   2. Compare two folders on a local filesystem.
      {{.Prompt}} {{.HelpName}} ~/Photos /Media/Backup/Photos
+
+  3. Compare the contents of two buckets using ETags and server side checksums.
+     {{.Prompt}} {{.HelpName}} --checksum s3/mybucket play/mybucket
+
+  4. Compare the contents of a local folder with a folder on Amazon S3 cloud storage.
+     {{.Prompt}} {{.HelpName}} --checksum --deep ~/Photos s3/mybucket/Photos
+
+  5. Generate a script with the commands which make a bucket on Amazon S3 cloud storage identical to a local folder.
+     {{.Prompt}} {{.HelpName}} --emit-actions ~/Photos s3/mybucket/Photos > reconcile.sh
 `,
 }
 
+// diffAction is an operation which reconciles a difference by
+// making the second URL identical to the first one.
+type diffAction struct {
+	Op     string `json:"op"`
+	Source string `json:"source,omitempty"`
+	Target string `json:"target"`
+}
+
+// String returns the action as a shell command.
+func (a diffAction) String() string {
+	if a.Source == "" {
+		return "mc " + a.Op + " " + shellQuote(a.Target)
+	}
+	return "mc " + a.Op + " " + shellQuote(a.Source) + " " + shellQuote(a.Target)
+}
+
+// newDiffAction returns the action reconciling the difference d, the
+// aliased URLs are used so that the action can be run with mc.
+func newDiffAction(d diffMessage, firstURL, secondURL, aliasedFirstURL, aliasedSecondURL string) *diffAction {
+	switch d.Diff {
+	case differInNone:
+		return nil
+	case differInSecond:
+		suffix := strings.TrimPrefix(d.SecondURL, secondURL)
+		return &diffAction{Op: "rm", Target: urlJoinPath(aliasedSecondURL, suffix)}
+	}
+	suffix := strings.TrimPrefix(d.FirstURL, firstURL)
+	return &diffAction{
+		Op:     "cp",
+		Source: urlJoinPath(aliasedFirstURL, suffix),
+		Target: urlJoinPath(aliasedSecondURL, suffix),
+	}
+}
+
 // diffMessage json container for diff messages
 type diffMessage struct {
 	Status        string       `json:"status"`
 	FirstURL      string       `json:"first"`
 	SecondURL     string       `json:"second"`
 	Diff          differType   `json:"diff"`
+	Type          string       `json:"type,omitempty"`
+	Action        *diffAction  `json:"action,omitempty"`
 	Error         *probe.Error `json:"error,omitempty"`
 	firstContent  *ClientContent
 	secondContent *ClientContent
@@ -82,6 +144,9 @@ type diffMessage struct {
 
 // String colorized diff message
 func (d diffMessage) String() string {
+	if d.Action != nil {
+		return d.Action.String()
+	}
 	msg := ""
 	switch d.Diff {
 	case differInFirst:
@@ -96,6 +161,8 @@ func (d diffMessage) String() string {
 		msg = console.Colorize("DiffMetadata", "! "+d.SecondURL)
 	case differInAASourceMTime:
 		msg = console.Colorize("DiffMMSourceMTime", "! "+d.SecondURL)
+	case differInChecksum:
+		msg = console.Colorize("DiffChecksum", "! "+d.SecondURL)
 	case differInNone:
 		msg = console.Colorize("DiffInNone", "= "+d.FirstURL)
 	default:
@@ -108,6 +175,7 @@ func (d diffMessage) String() string {
 // JSON jsonified diff message
 func (d diffMessage) JSON() string {
 	d.Status = "success"
+	d.Type = d.Diff.String()
 	diffJSONBytes, e := json.MarshalIndent(d, "", " ")
 	fatalIf(probe.NewError(e),
 		"Unable to marshal diff message `"+d.FirstURL+"`, `"+d.SecondURL+"` and `"+fmt.Sprint(d.Diff)+"`.")
@@ -127,6 +195,17 @@ func checkDiffSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 	firstURL := URLs[0]
 	secondURL := URLs[1]
 
+	if cliCtx.Bool("deep") && !cliCtx.Bool("checksum") {
+		fatalIf(errInvalidArgument().Trace(), "--deep can only be used with --checksum.")
+	}
+	if cliCtx.Bool("checksum") && !cliCtx.Bool("deep") {
+		for _, url := range URLs {
+			if newClientURL(url).Type == fileSystem {
+				fatalIf(errInvalidArgument().Trace(url), "Local folders have no checksums, please use --checksum with --deep.")
+			}
+		}
+	}
+
 	// Diff only works between two directories, verify them below.
 
 	// Verify if firstURL is accessible.
@@ -156,7 +235,7 @@ func checkDiffSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 }
 
 // doDiffMain runs the diff.
-func doDiffMain(ctx context.Context, firstURL, secondURL string) error {
+func doDiffMain(ctx context.Context, firstURL, secondURL string, isChecksum, emitActions bool, checksumOpts diffChecksumOpts) error {
 	// Source and targets are always directories
 	sourceSeparator := string(newClientURL(firstURL).Separator)
 	if !strings.HasSuffix(firstURL, sourceSeparator) {
@@ -167,6 +246,8 @@ func doDiffMain(ctx context.Context, firstURL, secondURL string) error {
 		secondURL = secondURL + targetSeparator
 	}
 
+	aliasedFirstURL, aliasedSecondURL := firstURL, secondURL
+
 	// Expand aliased urls.
 	firstAlias, firstURL, _ := mustExpandAlias(firstURL)
 	secondAlias, secondURL, _ := mustExpandAlias(secondURL)
@@ -183,13 +264,43 @@ func doDiffMain(ctx context.Context, firstURL, secondURL string) error {
 			fmt.Sprintf("Failed to diff '%s' and '%s'", firstURL, secondURL))
 	}
 
+	var diffCh chan diffMessage
+	if isChecksum {
+		// Similar objects are needed as well to compare their contents.
+		checksumOpts.firstAlias, checksumOpts.secondAlias = firstAlias, secondAlias
+		diffCh = difference(ctx, firstClient, secondClient, true, true, true, DirNone)
+	} else {
+		diffCh = objectDifference(ctx, firstClient, secondClient, true)
+	}
+
 	// Diff first and second urls.
-	for diffMsg := range objectDifference(ctx, firstClient, secondClient, true) {
+	for diffMsg := range diffCh {
 		if diffMsg.Error != nil {
 			errorIf(diffMsg.Error, "Unable to calculate objects difference.")
 			// Ignore error and proceed to next object.
 			continue
 		}
+		if isChecksum {
+			switch diffMsg.Diff {
+			case differInNone, differInAASourceMTime:
+				// Contents decide instead of modification time.
+				differs, err := checksumDiffers(ctx, diffMsg, checksumOpts)
+				if err != nil {
+					errorIf(err, "Unable to compare checksums.")
+					continue
+				}
+				if !differs {
+					continue
+				}
+				diffMsg.Diff = differInChecksum
+			}
+		}
+		if emitActions {
+			diffMsg.Action = newDiffAction(diffMsg, firstClient.GetURL().String(), secondClient.GetURL().String(), aliasedFirstURL, aliasedSecondURL)
+			if diffMsg.Action == nil {
+				continue
+			}
+		}
 		printMsg(diffMsg)
 	}
 
@@ -216,10 +327,14 @@ func mainDiff(cliCtx *cli.Context) error {
 	console.SetColor("DiffSize", color.New(color.FgYellow, color.Bold))
 	console.SetColor("DiffMetadata", color.New(color.FgYellow, color.Bold))
 	console.SetColor("DiffMMSourceMTime", color.New(color.FgYellow, color.Bold))
+	console.SetColor("DiffChecksum", color.New(color.FgYellow, color.Bold))
 
 	URLs := cliCtx.Args()
 	firstURL := URLs.Get(0)
 	secondURL := URLs.Get(1)
 
-	return doDiffMain(ctx, firstURL, secondURL)
+	return doDiffMain(ctx, firstURL, secondURL, cliCtx.Bool("checksum"), cliCtx.Bool("emit-actions"), diffChecksumOpts{
+		encKeyDB: encKeyDB,
+		deep:     cliCtx.Bool("deep"),
+	})
 }
@@ -42,6 +42,7 @@ const (
 	differInFirst                    // only in source (FIRST)
 	differInSecond                   // only in target (SECOND)
 	differInAASourceMTime            // differs in active-active source modtime
+	differInChecksum                 // differs in content checksum
 )
 
 func (d differType) String() string {
@@ -54,6 +55,8 @@ func (d differType) String() string {
 		return "metadata"
 	case differInAASourceMTime:
 		return "mm-source-mtime"
+	case differInChecksum:
+		return "checksum"
 	case differInType:
 		return "type"
 	case differInFirst:
@@ -296,10 +299,8 @@ func differenceInternal(ctx context.Context, sourceClnt, targetClnt Client, isMe
 					firstContent:  srcCtnt,
 					secondContent: tgtCtnt,
 				}
-			}
-
-			// No differ
-			if returnSimilar {
+			} else if returnSimilar {
+				// No differ
 				diffCh <- diffMessage{
 					FirstURL:      srcCtnt.URL.String(),
 					SecondURL:     tgtCtnt.URL.String(),
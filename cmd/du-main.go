@@ -87,6 +87,9 @@ EXAMPLES:
 
   4. Summarize disk usage of 'jazz-songs' bucket with all objects versions
      {{.Prompt}} {{.HelpName}} --versions s3/jazz-songs/
+
+  5. Summarize disk usage of 'jazz-songs' bucket upto two levels as a single JSON document.
+     {{.Prompt}} {{.HelpName}} --json --depth=2 s3/jazz-songs/
 `,
 }
 
@@ -97,6 +100,16 @@ type duMessage struct {
 	Objects    int64  `json:"objects"`
 	Status     string `json:"status"`
 	IsVersions bool   `json:"isVersions"`
+
+	// Per prefix breakdown below Prefix, only set in JSON mode.
+	Prefixes []duPrefix `json:"prefixes,omitempty"`
+}
+
+// duPrefix is the disk usage of a single prefix.
+type duPrefix struct {
+	Prefix  string `json:"prefix"`
+	Size    int64  `json:"size"`
+	Objects int64  `json:"objects"`
 }
 
 // Colorized message for console printing.
@@ -121,7 +134,10 @@ func (r duMessage) JSON() string {
 	return string(msgBytes)
 }
 
-func du(ctx context.Context, urlStr string, timeRef time.Time, withVersions bool, depth int, encKeyDB map[string][]prefixSSEPair) (sz, objs int64, err error) {
+// du prints the disk usage of urlStr and of its prefixes up to depth levels
+// below it. When prefixes is not nil, the usage is collected into it instead,
+// the deepest prefixes first and urlStr itself last.
+func du(ctx context.Context, urlStr string, timeRef time.Time, withVersions bool, depth int, encKeyDB map[string][]prefixSSEPair, prefixes *[]duPrefix) (sz, objs int64, err error) {
 	targetAlias, targetURL, _ := mustExpandAlias(urlStr)
 
 	if !strings.HasSuffix(targetURL, "/") {
@@ -176,7 +192,7 @@ func du(ctx context.Context, urlStr string, timeRef time.Time, withVersions bool
 			if targetAlias != "" {
 				subDirAlias = targetAlias + "/" + content.URL.Path
 			}
-			used, n, err := du(ctx, subDirAlias, timeRef, withVersions, depth, encKeyDB)
+			used, n, err := du(ctx, subDirAlias, timeRef, withVersions, depth, encKeyDB, prefixes)
 			if err != nil {
 				return 0, 0, err
 			}
@@ -196,13 +212,21 @@ func du(ctx context.Context, urlStr string, timeRef time.Time, withVersions bool
 			panic(err)
 		}
 
-		printMsg(duMessage{
-			Prefix:     strings.Trim(u.Path, "/"),
-			Size:       size,
-			Objects:    objects,
-			Status:     "success",
-			IsVersions: withVersions,
-		})
+		if prefixes != nil {
+			*prefixes = append(*prefixes, duPrefix{
+				Prefix:  strings.Trim(u.Path, "/"),
+				Size:    size,
+				Objects: objects,
+			})
+		} else {
+			printMsg(duMessage{
+				Prefix:     strings.Trim(u.Path, "/"),
+				Size:       size,
+				Objects:    objects,
+				Status:     "success",
+				IsVersions: withVersions,
+			})
+		}
 	}
 
 	return size, objects, nil
@@ -248,9 +272,34 @@ func mainDu(cliCtx *cli.Context) error {
 			fatalIf(errInvalidArgument().Trace(urlStr), fmt.Sprintf("Source `%s` is not a folder. Only folders are supported by 'du' command.", urlStr))
 		}
 
-		if _, _, err := du(ctx, urlStr, timeRef, withVersions, depth, encKeyDB); duErr == nil {
-			duErr = err
+		if !globalJSON {
+			if _, _, err := du(ctx, urlStr, timeRef, withVersions, depth, encKeyDB, nil); duErr == nil {
+				duErr = err
+			}
+			continue
+		}
+
+		// In JSON mode print a single document per argument, with the
+		// total at the top level as before and the per prefix usage below.
+		var prefixes []duPrefix
+		size, objects, err := du(ctx, urlStr, timeRef, withVersions, depth, encKeyDB, &prefixes)
+		if err != nil {
+			if duErr == nil {
+				duErr = err
+			}
+			continue
+		}
+		msg := duMessage{
+			Size:       size,
+			Objects:    objects,
+			Status:     "success",
+			IsVersions: withVersions,
+		}
+		if n := len(prefixes); n > 0 {
+			msg.Prefix = prefixes[n-1].Prefix
+			msg.Prefixes = prefixes[:n-1]
 		}
+		printMsg(msg)
 	}
 
 	return duErr
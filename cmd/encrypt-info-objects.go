@@ -0,0 +1,197 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/pkg/console"
+)
+
+// Object encryption types as shown by 'mc encrypt info --recursive'.
+const (
+	sseNone = "none"
+	sseS3   = "SSE-S3"
+	sseKMS  = "SSE-KMS"
+	sseC    = "SSE-C"
+)
+
+// objectSSE - derives the server side encryption type and KMS key id of
+// an object from its metadata.
+func objectSSE(metadata map[string]string) (sseType, keyID string) {
+	for k, v := range metadata {
+		switch strings.ToLower(k) {
+		case "x-amz-server-side-encryption-customer-algorithm":
+			return sseC, ""
+		case "x-amz-server-side-encryption":
+			switch v {
+			case "aws:kms":
+				sseType = sseKMS
+			case "AES256":
+				if sseType == "" {
+					sseType = sseS3
+				}
+			}
+		case "x-amz-server-side-encryption-aws-kms-key-id":
+			sseType, keyID = sseKMS, v
+		}
+	}
+	if sseType == "" {
+		sseType = sseNone
+	}
+	return sseType, keyID
+}
+
+// bucketDefaultSSE - maps the bucket encryption algorithm to an object
+// encryption type.
+func bucketDefaultSSE(algorithm, keyID string) string {
+	switch {
+	case keyID != "" || algorithm == "aws:kms":
+		return sseKMS
+	case algorithm != "":
+		return sseS3
+	}
+	return sseNone
+}
+
+type encryptObjectMessage struct {
+	Status    string `json:"status"`
+	Key       string `json:"key"`
+	VersionID string `json:"versionId,omitempty"`
+	SSE       string `json:"sse"`
+	KeyID     string `json:"keyId,omitempty"`
+}
+
+func (m encryptObjectMessage) JSON() string {
+	m.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+func (m encryptObjectMessage) String() string {
+	sse := m.SSE
+	if m.KeyID != "" {
+		sse += " " + m.KeyID
+	}
+	theme := "encryptInfoMessage"
+	if m.SSE == sseNone {
+		theme = "encryptInfoNone"
+	}
+	return console.Colorize(theme, fmt.Sprintf("[%s] ", sse)) + m.Key
+}
+
+type encryptObjectsSummaryMessage struct {
+	Status        string         `json:"status"`
+	URL           string         `json:"url"`
+	BucketDefault string         `json:"bucketDefault"`
+	Total         int            `json:"total"`
+	Objects       map[string]int `json:"objects"`
+}
+
+func (m encryptObjectsSummaryMessage) JSON() string {
+	m.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+func (m encryptObjectsSummaryMessage) String() string {
+	var types []string
+	for sseType := range m.Objects {
+		types = append(types, sseType)
+	}
+	sort.Strings(types)
+	var counts []string
+	for _, sseType := range types {
+		counts = append(counts, fmt.Sprintf("%d %s", m.Objects[sseType], sseType))
+	}
+	msg := fmt.Sprintf("Bucket default: %s, %d objects", m.BucketDefault, m.Total)
+	if len(counts) > 0 {
+		msg += ": " + strings.Join(counts, ", ")
+	}
+	if unencrypted := m.Objects[sseNone]; unencrypted > 0 && m.BucketDefault != sseNone {
+		return console.Colorize("encryptInfoNone", msg+fmt.Sprintf(", %d objects are not covered by the bucket default", unencrypted))
+	}
+	return console.Colorize("encryptInfoMessage", msg)
+}
+
+// encryptInfoObjects - prints the encryption type of every object under
+// aliasedURL followed by a summary against the bucket default.
+func encryptInfoObjects(ctx context.Context, cliCtx *cli.Context, aliasedURL string) error {
+	client, err := newClient(aliasedURL)
+	fatalIf(err, "Unable to initialize connection.")
+
+	summary := encryptObjectsSummaryMessage{
+		URL:     aliasedURL,
+		Objects: map[string]int{},
+	}
+	algorithm, keyID, err := client.GetEncryption(ctx)
+	if err != nil && minio.ToErrorResponse(err.ToGoError()).Code != "ServerSideEncryptionConfigurationNotFoundError" {
+		fatalIf(err, "Unable to get encryption info")
+	}
+	summary.BucketDefault = bucketDefaultSSE(algorithm, keyID)
+
+	targetAlias, _, _ := mustExpandAlias(aliasedURL)
+	var cErr error
+	for content := range client.List(ctx, ListOptions{
+		Recursive:         true,
+		WithOlderVersions: cliCtx.Bool("versions"),
+		ShowDir:           DirNone,
+	}) {
+		if content.Err != nil {
+			errorIf(content.Err.Trace(aliasedURL), "Unable to list folder.")
+			cErr = exitStatus(globalErrorExitStatus)
+			continue
+		}
+		msg := encryptObjectMessage{
+			Key:       getKey(content),
+			VersionID: content.VersionID,
+		}
+		objectURL := targetAlias + getKey(content)
+		clnt, err := newClient(objectURL)
+		if err == nil {
+			var st *ClientContent
+			st, err = clnt.Stat(ctx, StatOptions{versionID: content.VersionID})
+			if err == nil {
+				msg.SSE, msg.KeyID = objectSSE(st.Metadata)
+			} else if minio.ToErrorResponse(err.ToGoError()).StatusCode == http.StatusBadRequest {
+				// HEAD of an SSE-C encrypted object fails without its key.
+				msg.SSE, err = sseC, nil
+			}
+		}
+		if err != nil {
+			errorIf(err.Trace(objectURL), "Unable to get encryption of the object.")
+			cErr = exitStatus(globalErrorExitStatus)
+			continue
+		}
+		printMsg(msg)
+		summary.Total++
+		summary.Objects[msg.SSE]++
+	}
+	printMsg(summary)
+	return cErr
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestObjectSSE(t *testing.T) {
+	testCases := []struct {
+		metadata map[string]string
+		sseType  string
+		keyID    string
+	}{
+		{map[string]string{"Content-Type": "text/plain"}, sseNone, ""},
+		{map[string]string{"X-Amz-Server-Side-Encryption": "AES256"}, sseS3, ""},
+		{map[string]string{
+			"X-Amz-Server-Side-Encryption":                "aws:kms",
+			"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "my-key",
+		}, sseKMS, "my-key"},
+		{map[string]string{"X-Amz-Server-Side-Encryption-Customer-Algorithm": "AES256"}, sseC, ""},
+	}
+	for i, tc := range testCases {
+		sseType, keyID := objectSSE(tc.metadata)
+		if sseType != tc.sseType || keyID != tc.keyID {
+			t.Errorf("%d: expected (%s, %s), got (%s, %s)", i, tc.sseType, tc.keyID, sseType, keyID)
+		}
+	}
+
+	for _, tc := range []struct{ algorithm, keyID, sseType string }{
+		{"", "", sseNone},
+		{"AES256", "", sseS3},
+		{"aws:kms", "my-key", sseKMS},
+	} {
+		if sseType := bucketDefaultSSE(tc.algorithm, tc.keyID); sseType != tc.sseType {
+			t.Errorf("%s: expected %s, got %s", tc.algorithm, tc.sseType, sseType)
+		}
+	}
+}
@@ -28,18 +28,29 @@ import (
 	"github.com/minio/pkg/console"
 )
 
+var encryptInfoFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "recursive, r",
+		Usage: "show the encryption of every object under the prefix",
+	},
+	cli.BoolFlag{
+		Name:  "versions",
+		Usage: "include all object versions, requires --recursive",
+	},
+}
+
 var encryptInfoCmd = cli.Command{
 	Name:         "info",
 	Usage:        "show bucket encryption status",
 	Action:       mainEncryptInfo,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(encryptInfoFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -47,6 +58,9 @@ FLAGS:
 EXAMPLES:
   1. Display bucket encryption status for bucket "mybucket".
      {{.Prompt}} {{.HelpName}} myminio/mybucket
+
+  2. Audit the encryption (SSE-S3, SSE-KMS key id, SSE-C) of all objects under "mybucket/docs/".
+     {{.Prompt}} {{.HelpName}} --recursive myminio/mybucket/docs/
 `,
 }
 
@@ -55,6 +69,9 @@ func checkEncryptInfoSyntax(ctx *cli.Context) {
 	if len(ctx.Args()) != 1 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
+	if ctx.Bool("versions") && !ctx.Bool("recursive") {
+		fatalIf(errInvalidArgument(), "--versions requires --recursive.")
+	}
 }
 
 type encryptInfoMessage struct {
@@ -93,12 +110,16 @@ func mainEncryptInfo(cliCtx *cli.Context) error {
 	defer cancelEncryptInfo()
 
 	console.SetColor("encryptInfoMessage", color.New(color.FgGreen))
+	console.SetColor("encryptInfoNone", color.New(color.FgYellow))
 
 	checkEncryptInfoSyntax(cliCtx)
 
 	// Get the alias parameter from cli
 	args := cliCtx.Args()
 	aliasedURL := args.Get(0)
+	if cliCtx.Bool("recursive") {
+		return encryptInfoObjects(ctx, cliCtx, aliasedURL)
+	}
 	// Create a new Client
 	client, err := newClient(aliasedURL)
 	fatalIf(err, "Unable to initialize connection.")
@@ -144,17 +144,26 @@ func errorIf(err *probe.Error, msg string, data ...interface{}) {
 			errorMsg.CallTrace = err.CallTrace
 			errorMsg.SysInfo = err.SysInfo
 		}
-		json, e := json.MarshalIndent(struct {
+		errorRecord := struct {
 			Status string       `json:"status"`
 			Error  errorMessage `json:"error"`
 		}{
 			Status: "error",
 			Error:  errorMsg,
-		}, "", " ")
+		}
+		var errorJSON []byte
+		var e error
+		if globalJSONLine {
+			// Keep the error a single line record, so that it can be
+			// told apart from the surrounding JSON lines output.
+			errorJSON, e = json.Marshal(errorRecord)
+		} else {
+			errorJSON, e = json.MarshalIndent(errorRecord, "", " ")
+		}
 		if e != nil {
 			console.Fatalln(probe.NewError(e))
 		}
-		console.Println(string(json))
+		console.Println(string(errorJSON))
 		return
 	}
 	msg = fmt.Sprintf(msg, data...)
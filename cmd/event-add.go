@@ -46,6 +46,10 @@ var eventAddFlags = []cli.Flag{
 		Name:  "ignore-existing, p",
 		Usage: "ignore if event already exists",
 	},
+	cli.StringFlag{
+		Name:  "import",
+		Usage: "add all notification configs from a file written by 'mc event list --json', '-' reads from stdin",
+	},
 }
 
 var eventAddCmd = cli.Command{
@@ -60,6 +64,7 @@ var eventAddCmd = cli.Command{
 
 USAGE:
   {{.HelpName}} TARGET ARN [FLAGS]
+  {{.HelpName}} TARGET --import FILE [FLAGS]
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
@@ -76,16 +81,41 @@ EXAMPLES:
 
   4. Enable bucket notification for Replication and ILM transition events to a specific ARN
     {{.Prompt}} {{.HelpName}} myminio/mysourcebucket arn:aws:sqs:us-west-2:444455556666:your-queue --event replica,ilm
+
+  5. Enable bucket notification to a MinIO webhook target, the target must be configured on the server
+    {{.Prompt}} {{.HelpName}} myminio/mybucket arn:minio:sqs::primary:webhook --event put
+
+  6. Restore bucket notifications backed up with 'mc event list --json'
+    {{.Prompt}} {{.HelpName}} myminio/mybucket --import events.json
 `,
 }
 
 // checkEventAddSyntax - validate all the passed arguments
 func checkEventAddSyntax(ctx *cli.Context) {
+	if ctx.String("import") != "" {
+		if len(ctx.Args()) != 1 {
+			showCommandHelpAndExit(ctx, 1) // last argument is exit code
+		}
+		return
+	}
 	if len(ctx.Args()) != 2 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
 }
 
+// checkEventTarget - verifies that a MinIO target exists before pointing
+// events at it and returns its delivery parameters. Falls back to adding
+// without validation if the server config is not readable with these
+// credentials.
+func checkEventTarget(ctx context.Context, targets *notifyTargetConfigs, arn string) map[string]string {
+	delivery, checked, err := targets.delivery(ctx, arn)
+	if checked {
+		fatalIf(err.Trace(arn), "Unable to use notification target `%s`.", arn)
+	}
+	errorIf(err.Trace(arn), "Unable to verify notification target `%s`, adding it without validation.", arn)
+	return delivery
+}
+
 // eventAddMessage container
 type eventAddMessage struct {
 	ARN    string   `json:"arn"`
@@ -93,6 +123,8 @@ type eventAddMessage struct {
 	Prefix string   `json:"prefix"`
 	Suffix string   `json:"suffix"`
 	Status string   `json:"status"`
+
+	Delivery map[string]string `json:"delivery,omitempty"`
 }
 
 // JSON jsonified update message.
@@ -105,6 +137,9 @@ func (u eventAddMessage) JSON() string {
 
 func (u eventAddMessage) String() string {
 	msg := console.Colorize("Event", "Successfully added "+u.ARN)
+	if len(u.Delivery) > 0 {
+		msg += console.Colorize("Event", "   Delivery: "+deliveryString(u.Delivery))
+	}
 	return msg
 }
 
@@ -117,9 +152,10 @@ func mainEventAdd(cliCtx *cli.Context) error {
 	checkEventAddSyntax(cliCtx)
 
 	args := cliCtx.Args()
-	path := args[0]
-	arn := args[1]
+	path := args.Get(0)
+	arn := args.Get(1)
 	ignoreExisting := cliCtx.Bool("p")
+	importFile := cliCtx.String("import")
 
 	event := strings.Split(cliCtx.String("event"), ",")
 	prefix := cliCtx.String("prefix")
@@ -135,6 +171,15 @@ func mainEventAdd(cliCtx *cli.Context) error {
 		fatalIf(errDummy().Trace(), "The provided url doesn't point to a S3 server.")
 	}
 
+	targets, err := newNotifyTargetConfigs(path)
+	fatalIf(err, "Unable to initialize admin connection.")
+
+	if importFile != "" {
+		importEvents(ctx, s3Client, targets, importFile, ignoreExisting)
+		return nil
+	}
+
+	delivery := checkEventTarget(ctx, targets, arn)
 	err = s3Client.AddNotificationConfig(ctx, arn, event, prefix, suffix, ignoreExisting)
 	fatalIf(err, "Unable to enable notification on the specified bucket.")
 	printMsg(eventAddMessage{
@@ -142,6 +187,8 @@ func mainEventAdd(cliCtx *cli.Context) error {
 		Event:  event,
 		Prefix: prefix,
 		Suffix: suffix,
+
+		Delivery: delivery,
 	})
 
 	return nil
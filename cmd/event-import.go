@@ -0,0 +1,102 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// readEventImport - reads notification configs in the format printed by
+// 'mc event list --json', one JSON document after another.
+func readEventImport(r io.Reader) ([]eventListMessage, error) {
+	var configs []eventListMessage
+	dec := json.NewDecoder(r)
+	for {
+		var config eventListMessage
+		e := dec.Decode(&config)
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			return nil, e
+		}
+		if config.Arn == "" || len(config.Event) == 0 {
+			return nil, fmt.Errorf("notification config #%d has no arn or events", len(configs)+1)
+		}
+		configs = append(configs, config)
+	}
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no notification configs found")
+	}
+	return configs, nil
+}
+
+// filters - returns the prefix and suffix of the config, the filter
+// rules take precedence over the summary fields.
+func (u eventListMessage) filters() (prefix, suffix string) {
+	prefix, suffix = u.Prefix, u.Suffix
+	for _, rule := range u.Rules {
+		switch strings.ToLower(rule.Name) {
+		case "prefix":
+			prefix = rule.Value
+		case "suffix":
+			suffix = rule.Value
+		}
+	}
+	return prefix, suffix
+}
+
+// importEvents - adds all notification configs read from file.
+func importEvents(ctx context.Context, s3Client *S3Client, targets *notifyTargetConfigs, file string, ignoreExisting bool) {
+	r := io.Reader(os.Stdin)
+	if file != "-" {
+		f, e := os.Open(file)
+		fatalIf(probe.NewError(e), "Unable to open `%s`.", file)
+		defer f.Close()
+		r = f
+	}
+	configs, e := readEventImport(r)
+	fatalIf(probe.NewError(e), "Unable to read notification configs from `%s`.", file)
+
+	// Validate all targets before changing anything on the bucket.
+	delivery := make([]map[string]string, len(configs))
+	for i, config := range configs {
+		delivery[i] = checkEventTarget(ctx, targets, config.Arn)
+	}
+
+	for i, config := range configs {
+		prefix, suffix := config.filters()
+		err := s3Client.AddNotificationConfig(ctx, config.Arn, config.Event, prefix, suffix, ignoreExisting)
+		fatalIf(err, "Unable to enable notification `%s` on the specified bucket.", config.Arn)
+		printMsg(eventAddMessage{
+			ARN:    config.Arn,
+			Event:  config.Event,
+			Prefix: prefix,
+			Suffix: suffix,
+
+			Delivery: delivery[i],
+		})
+	}
+}
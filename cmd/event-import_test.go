@@ -0,0 +1,59 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadEventImport(t *testing.T) {
+	input := `{
+ "status": "success",
+ "id": "1",
+ "event": ["s3:ObjectCreated:*", "s3:ObjectRemoved:*"],
+ "prefix": "photos/",
+ "suffix": ".jpg",
+ "arn": "arn:minio:sqs::primary:webhook",
+ "rules": [{"name": "prefix", "value": "photos/"}, {"name": "suffix", "value": ".jpg"}]
+}
+{"status":"success","id":"2","event":["s3:ObjectAccessed:*"],"prefix":"","suffix":"","arn":"arn:minio:sqs::_:kafka","rules":[{"name":"Prefix","value":"logs/"}]}
+`
+	configs, e := readEventImport(strings.NewReader(input))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("expected 2 configs, got %d", len(configs))
+	}
+	if prefix, suffix := configs[0].filters(); prefix != "photos/" || suffix != ".jpg" {
+		t.Errorf("unexpected filters %q %q", prefix, suffix)
+	}
+	if prefix, suffix := configs[1].filters(); prefix != "logs/" || suffix != "" {
+		t.Errorf("unexpected filters %q %q", prefix, suffix)
+	}
+	if configs[1].Arn != "arn:minio:sqs::_:kafka" || len(configs[1].Event) != 1 {
+		t.Errorf("unexpected config %+v", configs[1])
+	}
+
+	for _, input := range []string{"", `{"arn":"arn:minio:sqs::1:webhook"}`, `{"event":`} {
+		if _, e := readEventImport(strings.NewReader(input)); e == nil {
+			t.Errorf("expected %q to fail", input)
+		}
+	}
+}
@@ -52,6 +52,9 @@ EXAMPLES:
 
   2. List all notification configurations
     {{.Prompt}} {{.HelpName}} s3/mybucket
+
+  3. Back up all notification configurations, restore them with 'mc event add --import'
+    {{.Prompt}} {{.HelpName}} myminio/mybucket --json > events.json
 `,
 }
 
@@ -70,6 +73,9 @@ type eventListMessage struct {
 	Prefix string   `json:"prefix"`
 	Suffix string   `json:"suffix"`
 	Arn    string   `json:"arn"`
+
+	Rules    []NotificationFilterRule `json:"rules,omitempty"`
+	Delivery map[string]string        `json:"delivery,omitempty"`
 }
 
 func (u eventListMessage) JSON() string {
@@ -94,6 +100,9 @@ func (u eventListMessage) String() string {
 	if u.Suffix != "" {
 		msg += console.Colorize("Filter", fmt.Sprintf("suffix=\"%s\"", u.Suffix))
 	}
+	if len(u.Delivery) > 0 {
+		msg += console.Colorize("Filter", "   Delivery: "+deliveryString(u.Delivery))
+	}
 	return msg
 }
 
@@ -127,13 +136,23 @@ func mainEventList(cliCtx *cli.Context) error {
 	configs, err := s3Client.ListNotificationConfigs(ctx, arn)
 	fatalIf(err, "Unable to list notifications on the specified bucket.")
 
+	// Delivery parameters are shown only when the server exposes
+	// the target configs to these credentials.
+	targets, _ := newNotifyTargetConfigs(path)
 	for _, config := range configs {
+		var delivery map[string]string
+		if targets != nil {
+			delivery, _, _ = targets.delivery(ctx, config.Arn)
+		}
 		printMsg(eventListMessage{
 			Event:  config.Events,
 			Prefix: config.Prefix,
 			Suffix: config.Suffix,
 			Arn:    config.Arn,
 			ID:     config.ID,
+
+			Rules:    config.Rules,
+			Delivery: delivery,
 		})
 	}
 
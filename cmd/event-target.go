@@ -0,0 +1,132 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+)
+
+// notifyDeliveryKeys are the notification target config keys which
+// control how events are queued and retried while a target is offline.
+var notifyDeliveryKeys = []string{"queue_dir", "queue_limit"}
+
+// parseNotifyARN - splits a MinIO notification ARN of the form
+// 'arn:minio:sqs:REGION:ID:TYPE' into its config sub-system and target.
+// ok is false for ARNs which do not point to a MinIO notification target.
+func parseNotifyARN(arn string) (subSys, target string, ok bool) {
+	fields := strings.Split(arn, ":")
+	if len(fields) != 6 || fields[0] != "arn" || fields[1] != "minio" || fields[2] != "sqs" {
+		return "", "", false
+	}
+	if fields[4] == "" || fields[5] == "" {
+		return "", "", false
+	}
+	target = fields[4]
+	if target == "_" {
+		// The default target of a sub-system has no name.
+		target = ""
+	}
+	return "notify_" + fields[5], target, true
+}
+
+// notifyTargetDelivery - returns the delivery parameters of the target
+// config, fails if the target is not configured or is disabled.
+func notifyTargetDelivery(cfgs []madmin.SubsysConfig, subSys, target string) (map[string]string, error) {
+	for _, cfg := range cfgs {
+		if cfg.SubSystem != subSys || cfg.Target != target {
+			continue
+		}
+		if enable, ok := cfg.Lookup(madmin.EnableKey); ok && enable != madmin.EnableOn {
+			return nil, fmt.Errorf("target is disabled, enable it with `mc admin config set`")
+		}
+		delivery := map[string]string{}
+		for _, key := range notifyDeliveryKeys {
+			if value, ok := cfg.Lookup(key); ok && value != "" {
+				delivery[key] = value
+			}
+		}
+		return delivery, nil
+	}
+	return nil, fmt.Errorf("no such target is configured, list the configured targets with `mc admin config get ALIAS %s`", subSys)
+}
+
+// notifyTargetConfigs - fetches the notification target configs of
+// the server, caching them per sub-system.
+type notifyTargetConfigs struct {
+	client *madmin.AdminClient
+	cfgs   map[string][]madmin.SubsysConfig
+}
+
+func newNotifyTargetConfigs(aliasedURL string) (*notifyTargetConfigs, *probe.Error) {
+	client, err := newAdminClient(aliasedURL)
+	if err != nil {
+		return nil, err
+	}
+	return &notifyTargetConfigs{client: client, cfgs: map[string][]madmin.SubsysConfig{}}, nil
+}
+
+func (n *notifyTargetConfigs) get(ctx context.Context, subSys string) ([]madmin.SubsysConfig, *probe.Error) {
+	if cfgs, ok := n.cfgs[subSys]; ok {
+		return cfgs, nil
+	}
+	buf, e := n.client.GetConfigKV(ctx, subSys)
+	if e != nil {
+		return nil, probe.NewError(e)
+	}
+	cfgs, e := madmin.ParseServerConfigOutput(string(buf))
+	if e != nil {
+		return nil, probe.NewError(e)
+	}
+	n.cfgs[subSys] = cfgs
+	return cfgs, nil
+}
+
+// delivery - returns the delivery parameters of the target referenced
+// by arn. checked is false when arn is not a MinIO target or when the
+// server configuration could not be read.
+func (n *notifyTargetConfigs) delivery(ctx context.Context, arn string) (delivery map[string]string, checked bool, err *probe.Error) {
+	subSys, target, ok := parseNotifyARN(arn)
+	if !ok {
+		return nil, false, nil
+	}
+	cfgs, err := n.get(ctx, subSys)
+	if err != nil {
+		return nil, false, err
+	}
+	delivery, e := notifyTargetDelivery(cfgs, subSys, target)
+	if e != nil {
+		return nil, true, probe.NewError(e)
+	}
+	return delivery, true, nil
+}
+
+// deliveryString - formats the delivery parameters for display.
+func deliveryString(delivery map[string]string) string {
+	var s []string
+	for _, key := range notifyDeliveryKeys {
+		if value, ok := delivery[key]; ok {
+			s = append(s, key+"="+value)
+		}
+	}
+	return strings.Join(s, " ")
+}
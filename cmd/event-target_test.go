@@ -0,0 +1,81 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestParseNotifyARN(t *testing.T) {
+	testCases := []struct {
+		arn    string
+		subSys string
+		target string
+		ok     bool
+	}{
+		{"arn:minio:sqs::primary:webhook", "notify_webhook", "primary", true},
+		{"arn:minio:sqs:us-east-1:1:kafka", "notify_kafka", "1", true},
+		{"arn:minio:sqs::_:amqp", "notify_amqp", "", true},
+		{"arn:aws:sqs:us-west-2:444455556666:your-queue", "", "", false},
+		{"arn:minio:sqs::primary", "", "", false},
+		{"arn:minio:sqs:::webhook", "", "", false},
+	}
+	for _, tc := range testCases {
+		subSys, target, ok := parseNotifyARN(tc.arn)
+		if subSys != tc.subSys || target != tc.target || ok != tc.ok {
+			t.Errorf("%s: expected (%q, %q, %v), got (%q, %q, %v)", tc.arn, tc.subSys, tc.target, tc.ok, subSys, target, ok)
+		}
+	}
+}
+
+func TestNotifyTargetDelivery(t *testing.T) {
+	cfgs, e := madmin.ParseServerConfigOutput(`notify_webhook enable=on endpoint=http://localhost:8080 queue_dir= queue_limit=0
+notify_webhook:primary enable=on endpoint=http://localhost:8081 queue_dir=/var/events queue_limit=10000
+notify_webhook:old enable=off endpoint=http://localhost:8082`)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	testCases := []struct {
+		target   string
+		delivery map[string]string
+		success  bool
+	}{
+		{"", map[string]string{"queue_limit": "0"}, true},
+		{"primary", map[string]string{"queue_dir": "/var/events", "queue_limit": "10000"}, true},
+		{"old", nil, false},
+		{"missing", nil, false},
+	}
+	for _, tc := range testCases {
+		delivery, e := notifyTargetDelivery(cfgs, "notify_webhook", tc.target)
+		if (e == nil) != tc.success {
+			t.Errorf("%q: expected success %v, got error %v", tc.target, tc.success, e)
+			continue
+		}
+		if tc.success && !reflect.DeepEqual(delivery, tc.delivery) {
+			t.Errorf("%q: expected %v, got %v", tc.target, tc.delivery, delivery)
+		}
+	}
+
+	if s := deliveryString(map[string]string{"queue_limit": "10", "queue_dir": "/q"}); s != "queue_dir=/q queue_limit=10" {
+		t.Errorf("unexpected delivery string %q", s)
+	}
+}
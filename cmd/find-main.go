@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"regexp"
 	"strings"
 	"time"
 
@@ -36,6 +37,10 @@ var (
 			Name:  "exec",
 			Usage: "spawn an external process for each matching object (see FORMAT)",
 		},
+		cli.IntFlag{
+			Name:  "exec-parallel",
+			Usage: "run up to N --exec processes concurrently",
+		},
 		cli.StringFlag{
 			Name:  "ignore",
 			Usage: "exclude objects matching the wildcard pattern",
@@ -64,6 +69,10 @@ var (
 			Name:  "regex",
 			Usage: "match directory and object name with PCRE regex pattern",
 		},
+		cli.StringFlag{
+			Name:  "iregex",
+			Usage: "like --regex, but the match is case insensitive",
+		},
 		cli.StringFlag{
 			Name:  "larger",
 			Usage: "match all objects larger than specified size in units (see UNITS)",
@@ -80,6 +89,15 @@ var (
 			Name:  "watch",
 			Usage: "monitor a specified path for newly created object(s)",
 		},
+		cli.StringFlag{
+			Name:  "tags",
+			Usage: "match objects carrying all the given tags e.g. \"env=prod&team=data\" (see TAGS)",
+		},
+		cli.IntFlag{
+			Name:  "tags-parallel",
+			Usage: "number of objects whose tags are fetched concurrently with --tags",
+			Value: defaultFindTagsParallel,
+		},
 	}
 )
 
@@ -109,6 +127,14 @@ UNITS
   --older-than, --newer-than flags accept the string for days, hours and minutes 
   i.e. 1d2h30m states 1 day, 2 hours and 30 minutes.
 
+TAGS
+  --tags matches objects carrying every listed tag, a key without a value like
+  "env&team=data" matches any value of that tag. S3 cannot list objects by tag,
+  so the tags of every object which matches all other criteria are fetched with
+  one request each, --tags-parallel of them at a time. Narrow the search with a
+  prefix, --name, --larger or --newer-than first to keep the number of requests
+  down on large buckets.
+
 FORMAT
   Support string substitutions with special interpretations for following keywords.
   Keywords supported if target is filesystem or object storage:
@@ -139,21 +165,30 @@ EXAMPLES:
   05. Find all images with ".jpg", ".png", and ".gif" extensions, using regex under "s3/photos".
       {{.Prompt}} {{.HelpName}} s3/photos --regex "(?i)\.(jpg|png|gif)$"
 
-  06. Find all images with ".jpg" extension under "s3/bucket" and copy to "play/bucket" *continuously*.
+  06. Find all images with ".jpg" extension in any case, under a "2022" prefix of "s3/photos".
+      {{.Prompt}} {{.HelpName}} s3/photos --regex "^2022/" --iregex "\.jpe?g$"
+
+  07. Find all images with ".jpg" extension under "s3/bucket" and copy to "play/bucket" *continuously*.
       {{.Prompt}} {{.HelpName}} s3/bucket --name "*.jpg" --watch --exec "mc cp {} play/bucket"
 
-  07. Find and generate public URLs valid for 7 days, for all objects between 64 MB, and 1 GB in size under "s3" account.
+  08. Find and generate public URLs valid for 7 days, for all objects between 64 MB, and 1 GB in size under "s3" account.
       {{.Prompt}} {{.HelpName}} s3 --larger 64MB --smaller 1GB --print {url}
 
-  08. Find all objects created in the last week under "s3/bucket".
+  09. Find all objects created in the last week under "s3/bucket".
       {{.Prompt}} {{.HelpName}} s3/bucket --newer-than 7d
 
-  09. Find all objects which were created are older than 2 days, 5 hours and 10 minutes and exclude the ones with ".jpg"
+  10. Find all objects which were created are older than 2 days, 5 hours and 10 minutes and exclude the ones with ".jpg"
       extension under "s3".
       {{.Prompt}} {{.HelpName}} s3 --older-than 2d5h10m --ignore "*.jpg"
 
-  10. List all objects up to 3 levels sub-directory deep under "s3/bucket".
+  11. List all objects up to 3 levels sub-directory deep under "s3/bucket".
       {{.Prompt}} {{.HelpName}} s3/bucket --maxdepth 3
+
+  12. Copy all objects with ".log" extension under "s3/bucket" to "play/bucket", running 16 copies at a time.
+      {{.Prompt}} {{.HelpName}} s3/bucket --name "*.log" --exec "mc cp {} play/bucket" --exec-parallel 16
+
+  13. Find all objects tagged with "env=prod" and "team=data" under "s3/bucket".
+      {{.Prompt}} {{.HelpName}} s3/bucket --tags "env=prod&team=data"
 `,
 }
 
@@ -191,10 +226,11 @@ func checkFindSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[stri
 type findContext struct {
 	*cli.Context
 	execCmd       string
+	execParallel  int
 	ignorePattern string
 	namePattern   string
 	pathPattern   string
-	regexPattern  string
+	regexes       []*regexp.Regexp
 	maxDepth      uint
 	printFmt      string
 	olderThan     string
@@ -202,8 +238,11 @@ type findContext struct {
 	largerSize    uint64
 	smallerSize   uint64
 	watch         bool
+	tags          findTagQuery
+	tagsParallel  int
 
 	// Internal values
+	execPool      *findExecPool
 	targetAlias   string
 	targetURL     string
 	targetFullURL string
@@ -259,6 +298,31 @@ func mainFind(cliCtx *cli.Context) error {
 		fatalIf(probe.NewError(e).Trace(cliCtx.String("smaller")), "Unable to parse input bytes.")
 	}
 
+	var regexes []*regexp.Regexp
+	if pattern := cliCtx.String("regex"); pattern != "" {
+		re, err := compileFindRegex(pattern, false)
+		fatalIf(err.Trace(pattern), "Unable to compile `--regex` pattern.")
+		regexes = append(regexes, re)
+	}
+	if pattern := cliCtx.String("iregex"); pattern != "" {
+		re, err := compileFindRegex(pattern, true)
+		fatalIf(err.Trace(pattern), "Unable to compile `--iregex` pattern.")
+		regexes = append(regexes, re)
+	}
+
+	var tags findTagQuery
+	if query := cliCtx.String("tags"); query != "" {
+		if clnt.GetURL().Type == fileSystem {
+			fatalIf(errInvalidArgument().Trace(query), "--tags is only supported for object storage.")
+		}
+		tags, e = parseFindTagQuery(query)
+		fatalIf(probe.NewError(e).Trace(query), "Unable to parse `--tags`.")
+	}
+	tagsParallel := cliCtx.Int("tags-parallel")
+	if tagsParallel < 1 {
+		fatalIf(errInvalidArgument().Trace(cliCtx.String("tags-parallel")), "--tags-parallel must be at least 1.")
+	}
+
 	targetAlias, _, hostCfg, err := expandAlias(args[0])
 	fatalIf(err.Trace(args[0]), "Unable to expand alias.")
 
@@ -271,16 +335,19 @@ func mainFind(cliCtx *cli.Context) error {
 		Context:       cliCtx,
 		maxDepth:      cliCtx.Uint("maxdepth"),
 		execCmd:       cliCtx.String("exec"),
+		execParallel:  cliCtx.Int("exec-parallel"),
 		printFmt:      cliCtx.String("print"),
 		namePattern:   cliCtx.String("name"),
 		pathPattern:   cliCtx.String("path"),
-		regexPattern:  cliCtx.String("regex"),
+		regexes:       regexes,
 		ignorePattern: cliCtx.String("ignore"),
 		olderThan:     olderThan,
 		newerThan:     newerThan,
 		largerSize:    largerSize,
 		smallerSize:   smallerSize,
 		watch:         cliCtx.Bool("watch"),
+		tags:          tags,
+		tagsParallel:  tagsParallel,
 		targetAlias:   targetAlias,
 		targetURL:     args[0],
 		targetFullURL: targetFullURL,
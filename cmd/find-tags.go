@@ -0,0 +1,128 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// defaultFindTagsParallel - number of concurrent tag requests of --tags.
+const defaultFindTagsParallel = 16
+
+// findTagCondition - a single key=value of a --tags query.
+type findTagCondition struct {
+	key   string
+	value string
+	// anyValue is set for a bare key, which only requires the tag.
+	anyValue bool
+}
+
+// findTagQuery - conjunction of tag conditions.
+type findTagQuery []findTagCondition
+
+// parseFindTagQuery - parses `key1=value1&key2=value2`, a key without
+// '=' matches any value of that tag.
+func parseFindTagQuery(query string) (findTagQuery, error) {
+	var q findTagQuery
+	for _, kv := range strings.Split(query, "&") {
+		if kv == "" {
+			continue
+		}
+		key, value, found := strings.Cut(kv, "=")
+		if key == "" {
+			return nil, errors.New("tag key cannot be empty in `" + kv + "`")
+		}
+		q = append(q, findTagCondition{key: key, value: value, anyValue: !found})
+	}
+	if len(q) == 0 {
+		return nil, errors.New("tag query cannot be empty")
+	}
+	return q, nil
+}
+
+// match - reports whether tags satisfy every condition.
+func (q findTagQuery) match(tags map[string]string) bool {
+	for _, c := range q {
+		value, ok := tags[c.key]
+		if !ok || (!c.anyValue && value != c.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchObjectTags - fetches the tags of an object and matches them.
+func (ctx *findContext) matchObjectTags(ctxCtx context.Context, key string) bool {
+	clnt, err := newClient(key)
+	if err != nil {
+		errorIf(err.Trace(key), "Unable to initialize `"+key+"`.")
+		return false
+	}
+	tags, err := clnt.GetTags(ctxCtx, "")
+	if err != nil {
+		errorIf(err.Trace(key), "Unable to fetch tags of `"+key+"`.")
+		return false
+	}
+	return ctx.tags.match(tags)
+}
+
+// findTagFilter fetches the tags of the listed objects in parallel,
+// results are still emitted in listing order.
+type findTagFilter struct {
+	pending chan *findTagResult
+	done    chan struct{}
+}
+
+type findTagResult struct {
+	content contentMessage
+	match   chan bool
+}
+
+func newFindTagFilter(ctxCtx context.Context, ctx *findContext) *findTagFilter {
+	f := &findTagFilter{
+		// Bounds the number of outstanding tag requests.
+		pending: make(chan *findTagResult, ctx.tagsParallel),
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(f.done)
+		for r := range f.pending {
+			if <-r.match {
+				ctx.output(ctxCtx, r.content)
+			}
+		}
+	}()
+	return f
+}
+
+// submit - queues an object, blocks while too many requests are in flight.
+func (f *findTagFilter) submit(ctxCtx context.Context, ctx *findContext, content contentMessage) {
+	r := &findTagResult{content: content, match: make(chan bool, 1)}
+	f.pending <- r
+	go func() {
+		r.match <- ctx.matchObjectTags(ctxCtx, content.Key)
+	}()
+}
+
+// wait - waits until all queued objects are emitted.
+func (f *findTagFilter) wait() {
+	close(f.pending)
+	<-f.done
+}
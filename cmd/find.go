@@ -20,12 +20,15 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -104,11 +107,17 @@ func pathMatch(pattern, path string) bool {
 	return wildcard.Match(pattern, path)
 }
 
-// regexMatch reports whether path matches the regex pattern.
-func regexMatch(pattern, path string) bool {
-	matched, e := regexp.MatchString(pattern, path)
-	errorIf(probe.NewError(e).Trace(pattern), "Unable to regex match with input pattern.")
-	return matched
+// compileFindRegex compiles the regex pattern once, so that invalid
+// patterns fail before any listing is done.
+func compileFindRegex(pattern string, caseInsensitive bool) (*regexp.Regexp, *probe.Error) {
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, e := regexp.Compile(pattern)
+	if e != nil {
+		return nil, probe.NewError(e)
+	}
+	return re, nil
 }
 
 func getExitStatus(err error) int {
@@ -123,16 +132,18 @@ func getExitStatus(err error) int {
 	return 1
 }
 
-// execFind executes the input command line, additionally formats input
-// for the command line in accordance with subsititution arguments.
-func execFind(ctx context.Context, args string, fileContent contentMessage) {
+// runFindExec executes the input command line, additionally formats input
+// for the command line in accordance with subsititution arguments. The
+// output of the command is printed in one piece once it has finished, so
+// that concurrent invocations do not garble each others output.
+func runFindExec(ctx context.Context, args string, fileContent contentMessage) error {
 	split, err := shlex.Split(args)
 	if err != nil {
 		console.Println(console.Colorize("FindExecErr", "Unable to parse --exec: "+err.Error()))
-		os.Exit(getExitStatus(err))
+		return err
 	}
 	if len(split) == 0 {
-		return
+		return nil
 	}
 	for i, arg := range split {
 		split[i] = stringsReplace(ctx, arg, fileContent)
@@ -147,10 +158,69 @@ func execFind(ctx context.Context, args string, fileContent contentMessage) {
 			console.Println(console.Colorize("FindExecErr", strings.TrimSpace(stderr.String())))
 		}
 		console.Println(console.Colorize("FindExecErr", err.Error()))
+		return err
+	}
+	console.PrintC(out.String())
+	return nil
+}
+
+// execFind runs the input command line and exits with its exit
+// status if it fails.
+func execFind(ctx context.Context, args string, fileContent contentMessage) {
+	if err := runFindExec(ctx, args, fileContent); err != nil {
 		// Return exit status of the command run
 		os.Exit(getExitStatus(err))
 	}
-	console.PrintC(out.String())
+}
+
+// findExecPool runs up to --exec-parallel command lines concurrently,
+// unlike execFind a failing command does not stop the others.
+type findExecPool struct {
+	jobs     chan contentMessage
+	wg       sync.WaitGroup
+	total    int64
+	failures int64
+}
+
+func newFindExecPool(ctx context.Context, args string, workers int) *findExecPool {
+	p := &findExecPool{jobs: make(chan contentMessage)}
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for fileContent := range p.jobs {
+				if err := runFindExec(ctx, args, fileContent); err != nil {
+					atomic.AddInt64(&p.failures, 1)
+				}
+			}
+		}()
+	}
+	return p
+}
+
+// submit queues a command line run for fileContent, blocks while all
+// workers are busy.
+func (p *findExecPool) submit(fileContent contentMessage) {
+	atomic.AddInt64(&p.total, 1)
+	p.jobs <- fileContent
+}
+
+// wait waits for all queued command lines to finish and returns
+// the number of failed and total runs.
+func (p *findExecPool) wait() (failures, total int64) {
+	close(p.jobs)
+	p.wg.Wait()
+	return atomic.LoadInt64(&p.failures), atomic.LoadInt64(&p.total)
+}
+
+// exec runs the --exec command line for fileContent, in the pool
+// if --exec-parallel is set.
+func (ctx *findContext) exec(ctxCtx context.Context, fileContent contentMessage) {
+	if ctx.execPool != nil {
+		ctx.execPool.submit(fileContent)
+		return
+	}
+	execFind(ctxCtx, ctx.execCmd, fileContent)
 }
 
 // watchFind - enables listening on the input path, listens for all file/object
@@ -251,9 +321,17 @@ func find(ctxCtx context.Context, ctx *findContext, fileContent contentMessage)
 		return
 	} // For all matching content
 
+	if ctx.tags != nil && !ctx.matchObjectTags(ctxCtx, fileContent.Key) {
+		return
+	}
+	ctx.output(ctxCtx, fileContent)
+}
+
+// output - either execs, or prints the matching content.
+func (ctx *findContext) output(ctxCtx context.Context, fileContent contentMessage) {
 	// proceed to either exec, format the output string.
 	if ctx.execCmd != "" {
-		execFind(ctxCtx, ctx.execCmd, fileContent)
+		ctx.exec(ctxCtx, fileContent)
 		return
 	}
 	if ctx.printFmt != "" {
@@ -265,10 +343,15 @@ func find(ctxCtx context.Context, ctx *findContext, fileContent contentMessage)
 // doFind - find is main function body which interprets and executes
 // all the input parameters.
 func doFind(ctxCtx context.Context, ctx *findContext) error {
-	// If watch is enabled we will wait on the prefix perpetually
-	// for all I/O events until canceled by user, if watch is not enabled
-	// following defer is a no-op.
-	defer watchFind(ctxCtx, ctx)
+	if ctx.execCmd != "" && ctx.execParallel > 1 {
+		ctx.execPool = newFindExecPool(ctxCtx, ctx.execCmd, ctx.execParallel)
+	}
+
+	// Tags are only fetched for objects matching all other criteria.
+	var tagFilter *findTagFilter
+	if ctx.tags != nil {
+		tagFilter = newFindTagFilter(ctxCtx, ctx)
+	}
 
 	var prevKeyName string
 
@@ -311,20 +394,27 @@ func doFind(ctxCtx context.Context, ctx *findContext) error {
 
 		prevKeyName = fileKeyName
 
-		// proceed to either exec, format the output string.
-		if ctx.execCmd != "" {
-			execFind(ctxCtx, ctx.execCmd, fileContent)
+		if tagFilter != nil {
+			tagFilter.submit(ctxCtx, ctx, fileContent)
 			continue
 		}
-		if ctx.printFmt != "" {
-			fileContent.Key = stringsReplace(ctxCtx, ctx.printFmt, fileContent)
-		}
-
-		printMsg(findMessage{fileContent})
+		ctx.output(ctxCtx, fileContent)
+	}
+	if tagFilter != nil {
+		tagFilter.wait()
 	}
 
-	// Success, notice watch will execute in defer only if enabled and this call
-	// will return after watch is canceled.
+	// If watch is enabled we will wait on the prefix perpetually
+	// for all I/O events until canceled by user, if watch is not enabled
+	// this is a no-op.
+	watchFind(ctxCtx, ctx)
+
+	if ctx.execPool != nil {
+		if failures, total := ctx.execPool.wait(); failures > 0 {
+			console.Println(console.Colorize("FindExecErr", fmt.Sprintf("%d of %d --exec invocations failed.", failures, total)))
+			return exitStatus(globalErrorExitStatus)
+		}
+	}
 	return nil
 }
 
@@ -416,8 +506,11 @@ func matchFind(ctx *findContext, fileContent contentMessage) (match bool) {
 	if match && ctx.pathPattern != "" {
 		match = pathMatch(ctx.pathPattern, path)
 	}
-	if match && ctx.regexPattern != "" {
-		match = regexMatch(ctx.regexPattern, path)
+	for _, re := range ctx.regexes {
+		if !match {
+			break
+		}
+		match = re.MatchString(path)
 	}
 	if match && ctx.olderThan != "" {
 		match = !isOlder(fileContent.Time, ctx.olderThan)
@@ -20,6 +20,7 @@ package cmd
 import (
 	"context"
 	"os/exec"
+	"regexp"
 	"runtime"
 	"strings"
 	"testing"
@@ -54,7 +55,7 @@ func TestMatchFind(t *testing.T) {
 			clnt: &S3Client{
 				targetURL: &ClientURL{},
 			},
-			regexPattern: `^(\d+\.){3}\d+$`,
+			regexes: []*regexp.Regexp{regexp.MustCompile(`^(\d+\.){3}\d+$`)},
 		},
 		{
 			clnt: &S3Client{
@@ -316,6 +317,17 @@ func TestFindMatch(t *testing.T) {
 		// Regexp based - failure cases.
 		{"^[a-zA-Z][a-zA-Z0-9\\-]+[a-zA-Z0-9]$", "testbucket.", "regex", false},
 		{`^(\d+\.){3}\d+$`, "192.168.x.x", "regex", false},
+
+		// Case insensitive regexp based - success cases.
+		{`\.jpe?g$`, "photos/2022/IMG_0001.JPG", "iregex", true},
+		{`^photos/`, "Photos/2022/img.jpeg", "iregex", true},
+
+		// Case insensitive regexp based - failure cases.
+		{`\.jpe?g$`, "photos/2022/IMG_0001.PNG", "iregex", false},
+	}
+
+	if _, err := compileFindRegex("*.jpg", false); err == nil {
+		t.Fatal("Expected invalid regex pattern to fail to compile")
 	}
 
 	for _, test := range basicTests {
@@ -332,8 +344,12 @@ func TestFindMatch(t *testing.T) {
 				t.Fatalf("Unexpected result %t, with pattern %s, flag %s and filepath %s \n",
 					!test.match, test.pattern, test.flagName, test.filePath)
 			}
-		case "regex":
-			testMatch := regexMatch(test.pattern, test.filePath)
+		case "regex", "iregex":
+			re, err := compileFindRegex(test.pattern, test.flagName == "iregex")
+			if err != nil {
+				t.Fatalf("Unable to compile pattern %s: %s", test.pattern, err)
+			}
+			testMatch := re.MatchString(test.filePath)
 			if testMatch != test.match {
 				t.Fatalf("Unexpected result %t, with pattern %s, flag %s and filepath %s \n",
 					!test.match, test.pattern, test.flagName, test.filePath)
@@ -461,3 +477,54 @@ func TestGetExitStatus(t *testing.T) {
 		}
 	}
 }
+
+// Tests failures are counted by findExecPool.
+func TestFindExecPool(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("Skipping on non-linux")
+		return
+	}
+	pool := newFindExecPool(context.Background(), "test {} != fail", 4)
+	for _, key := range []string{"ok", "fail", "ok", "fail", "ok"} {
+		pool.submit(contentMessage{Key: key})
+	}
+	failures, total := pool.wait()
+	if failures != 2 || total != 5 {
+		t.Errorf("Expected 2 of 5 failures, got %d of %d", failures, total)
+	}
+}
+
+func TestFindTagQuery(t *testing.T) {
+	testCases := []struct {
+		query       string
+		tags        map[string]string
+		expected    bool
+		shouldError bool
+	}{
+		{"env=prod", map[string]string{"env": "prod"}, true, false},
+		{"env=prod&team=data", map[string]string{"env": "prod", "team": "data", "x": "y"}, true, false},
+		{"env=prod&team=data", map[string]string{"env": "prod"}, false, false},
+		{"env=prod", map[string]string{"env": "dev"}, false, false},
+		{"env", map[string]string{"env": "dev"}, true, false},
+		{"env=", map[string]string{"env": "dev"}, false, false},
+		{"env=", map[string]string{"env": ""}, true, false},
+		{"env", map[string]string{}, false, false},
+		{"=prod", nil, false, true},
+		{"&", nil, false, true},
+	}
+	for i, testCase := range testCases {
+		q, e := parseFindTagQuery(testCase.query)
+		if testCase.shouldError {
+			if e == nil {
+				t.Errorf("Test %d: expected an error for %q", i+1, testCase.query)
+			}
+			continue
+		}
+		if e != nil {
+			t.Fatalf("Test %d: unexpected error: %v", i+1, e)
+		}
+		if got := q.match(testCase.tags); got != testCase.expected {
+			t.Errorf("Test %d: expected %v for %q, got %v", i+1, testCase.expected, testCase.query, got)
+		}
+	}
+}
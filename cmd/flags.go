@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/minio/cli"
+	"github.com/minio/minio-go/v7"
 )
 
 // Collection of mc flags currently supported
@@ -62,6 +63,20 @@ var globalFlags = []cli.Flag{
 		Hidden: true,
 		Value:  10 * time.Minute,
 	},
+	cli.DurationFlag{
+		Name:  "request-timeout",
+		Usage: "deadline of each request including the response body, 0 means no timeout",
+	},
+	cli.IntFlag{
+		Name:  "retry-max",
+		Usage: "maximum attempts of each request on network and server errors, 1 disables retries",
+		Value: minio.MaxRetry,
+	},
+	cli.DurationFlag{
+		Name:  "retry-base-delay",
+		Usage: "delay before the first retry of S3 requests, doubled on every attempt up to five times its value",
+		Value: minio.DefaultRetryUnit,
+	},
 }
 
 // Flags common across all I/O commands such as cp, mirror, stat, pipe etc.
@@ -26,6 +26,8 @@ import (
 
 	"github.com/minio/cli"
 	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/ratelimit"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/pkg/console"
 )
 
@@ -71,6 +73,17 @@ var (
 
 	globalConnReadDeadline  time.Duration
 	globalConnWriteDeadline time.Duration
+	globalRequestTimeout    time.Duration // Deadline of each request, zero means none
+	globalMaxConnsPerHost   int           // Connections per remote host, zero means unlimited
+
+	globalLimitUpload   *ratelimit.Limiter // Shared upload rate limiter, nil when unlimited
+	globalLimitDownload *ratelimit.Limiter // Shared download rate limiter, nil when unlimited
+
+	globalResume       bool          // Resume interrupted multipart uploads
+	globalResumeExpiry time.Duration // Discard resume state older than this
+
+	globalRetry      int           // Retry failed transfers on transient errors
+	globalRetryDelay time.Duration // Delay before the first retry, doubled on every retry
 
 	globalContext, globalCancel = context.WithCancel(context.Background())
 )
@@ -111,5 +124,39 @@ func setGlobalsFromContext(ctx *cli.Context) error {
 
 	globalConnReadDeadline = ctx.Duration("conn-read-deadline")
 	globalConnWriteDeadline = ctx.Duration("conn-write-deadline")
+
+	if ctx.IsSet("request-timeout") {
+		globalRequestTimeout = ctx.Duration("request-timeout")
+		if globalRequestTimeout < 0 {
+			fatalIf(errInvalidArgument().Trace(ctx.String("request-timeout")), "--request-timeout cannot be negative.")
+		}
+	}
+	// A connection idle for longer than its deadline is closed, do not
+	// let that cut a request short which is allowed to take longer.
+	if globalRequestTimeout > globalConnReadDeadline {
+		globalConnReadDeadline = globalRequestTimeout
+	}
+	if globalRequestTimeout > globalConnWriteDeadline {
+		globalConnWriteDeadline = globalRequestTimeout
+	}
+
+	// Only override the retry settings when set at this level, the
+	// defaults of a sub-command must not reset a value set earlier.
+	if ctx.IsSet("retry-max") {
+		retryMax := ctx.Int("retry-max")
+		if retryMax < 1 {
+			fatalIf(errInvalidArgument().Trace(ctx.String("retry-max")), "--retry-max must be at least 1.")
+		}
+		minio.MaxRetry = retryMax
+		madmin.MaxRetry = retryMax
+	}
+	if ctx.IsSet("retry-base-delay") {
+		baseDelay := ctx.Duration("retry-base-delay")
+		if baseDelay <= 0 {
+			fatalIf(errInvalidArgument().Trace(ctx.String("retry-base-delay")), "--retry-base-delay must be positive.")
+		}
+		// Admin API backoff is fixed by madmin-go, only S3 requests honor it.
+		minio.DefaultRetryUnit, minio.DefaultRetryCap = baseDelay, 5*baseDelay
+	}
 	return nil
 }
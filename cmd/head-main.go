@@ -39,6 +39,10 @@ var headFlags = []cli.Flag{
 		Usage: "print the first 'n' lines",
 		Value: 10,
 	},
+	cli.Int64Flag{
+		Name:  "c,bytes",
+		Usage: "print the first 'c' bytes, only the requested bytes are fetched",
+	},
 	cli.StringFlag{
 		Name:  "rewind",
 		Usage: "select an object version at specified time",
@@ -56,7 +60,7 @@ var headFlags = []cli.Flag{
 // Display contents of a file.
 var headCmd = cli.Command{
 	Name:         "head",
-	Usage:        "display first 'n' lines of an object",
+	Usage:        "display first 'n' lines or 'c' bytes of an object",
 	Action:       mainHead,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
@@ -74,7 +78,7 @@ ENVIRONMENT VARIABLES:
   MC_ENCRYPT_KEY:  list of comma delimited prefix=secret values
 
 NOTE:
-  '{{.HelpName}}' automatically decompresses 'gzip', 'bzip2' compressed objects.
+  '{{.HelpName}}' automatically decompresses 'gzip', 'bzip2' compressed objects, except with '--bytes'.
 
 EXAMPLES:
   1. Display only first line from a 'gzip' compressed object on Amazon S3.
@@ -89,9 +93,51 @@ EXAMPLES:
 
   4. Display the first lines of a specific object version.
      {{.Prompt}} {{.HelpName}} --version-id "3ddac055-89a7-40fa-8cd3-530a5581b6b8" s3/json-data/population.json
+
+  5. Display the first 512 bytes of a large object without downloading the rest of it.
+     {{.Prompt}} {{.HelpName}} --bytes 512 s3/backups/disk.img
 `,
 }
 
+type headOpts struct {
+	args      []string
+	versionID string
+	timeRef   time.Time
+	nlines    int64
+	nbytes    int64
+	isZip     bool
+}
+
+// headBytesURL displays the first o.nbytes of a URL to stdout, only
+// the requested range of the object is fetched.
+func headBytesURL(ctx context.Context, sourceURL string, encKeyDB map[string][]prefixSSEPair, o headOpts) *probe.Error {
+	if sourceURL == "-" {
+		return catOut(io.LimitReader(os.Stdin, o.nbytes), -1).Trace(sourceURL)
+	}
+	versionID := o.versionID
+	if !o.timeRef.IsZero() {
+		versionID = ""
+	}
+	_, content, err := url2Stat(ctx, sourceURL, versionID, false, encKeyDB, o.timeRef, o.isZip)
+	if err != nil {
+		return err.Trace(sourceURL)
+	}
+	// A ranged GET of an empty object fails with InvalidRange.
+	if content.Size == 0 {
+		return nil
+	}
+	versionID = content.VersionID
+	reader, err := getSourceStreamFromURL(ctx, sourceURL, encKeyDB, getSourceOpts{
+		GetOptions: GetOptions{VersionID: versionID, Zip: o.isZip, RangeLength: o.nbytes},
+	})
+	if err != nil {
+		return err.Trace(sourceURL)
+	}
+	defer reader.Close()
+	// Servers may ignore the range, never print more than asked for.
+	return catOut(io.LimitReader(reader, o.nbytes), -1).Trace(sourceURL)
+}
+
 // headURL displays contents of a URL to stdout.
 func headURL(sourceURL, sourceVersion string, timeRef time.Time, encKeyDB map[string][]prefixSSEPair, nlines int64, zip bool) *probe.Error {
 	var reader io.ReadCloser
@@ -167,22 +213,34 @@ func headOut(r io.Reader, nlines int64) *probe.Error {
 }
 
 // parseHeadSyntax performs command-line input validation for head command.
-func parseHeadSyntax(ctx *cli.Context) (args []string, versionID string, timeRef time.Time) {
-	args = ctx.Args()
+func parseHeadSyntax(ctx *cli.Context) headOpts {
+	var o headOpts
+	o.args = ctx.Args()
 
-	versionID = ctx.String("version-id")
+	o.versionID = ctx.String("version-id")
 	rewind := ctx.String("rewind")
 
-	if versionID != "" && rewind != "" {
+	if o.versionID != "" && rewind != "" {
 		fatalIf(errInvalidArgument().Trace(), "You cannot specify --version-id and --rewind at the same time")
 	}
 
-	if versionID != "" && len(args) != 1 {
+	if o.versionID != "" && len(o.args) != 1 {
 		fatalIf(errInvalidArgument().Trace(), "You need to pass at least one argument if --version-id is specified")
 	}
 
-	timeRef = parseRewindFlag(rewind)
-	return
+	o.timeRef = parseRewindFlag(rewind)
+	o.isZip = ctx.Bool("zip")
+	o.nlines = ctx.Int64("lines")
+	o.nbytes = ctx.Int64("bytes")
+	if ctx.IsSet("bytes") {
+		if ctx.IsSet("lines") {
+			fatalIf(errInvalidArgument().Trace(), "You cannot specify both --lines and --bytes")
+		}
+		if o.nbytes <= 0 {
+			fatalIf(errInvalidArgument().Trace(), "--bytes should be greater than zero")
+		}
+	}
+	return o
 }
 
 // mainHead is the main entry point for head command.
@@ -191,19 +249,27 @@ func mainHead(ctx *cli.Context) error {
 	encKeyDB, err := getEncKeys(ctx)
 	fatalIf(err, "Unable to parse encryption keys.")
 
-	args, versionID, timeRef := parseHeadSyntax(ctx)
+	o := parseHeadSyntax(ctx)
 
-	stdinMode := len(args) == 0
+	stdinMode := len(o.args) == 0
 
 	// handle std input data.
 	if stdinMode {
-		fatalIf(headOut(os.Stdin, ctx.Int64("lines")).Trace(), "Unable to read from standard input.")
+		if o.nbytes > 0 {
+			fatalIf(catOut(io.LimitReader(os.Stdin, o.nbytes), -1).Trace(), "Unable to read from standard input.")
+			return nil
+		}
+		fatalIf(headOut(os.Stdin, o.nlines).Trace(), "Unable to read from standard input.")
 		return nil
 	}
 
 	// Convert arguments to URLs: expand alias, fix format.
-	for _, url := range ctx.Args() {
-		fatalIf(headURL(url, versionID, timeRef, encKeyDB, ctx.Int64("lines"), ctx.Bool("zip")).Trace(url), "Unable to read from `"+url+"`.")
+	for _, url := range o.args {
+		if o.nbytes > 0 {
+			fatalIf(headBytesURL(globalContext, url, encKeyDB, o).Trace(url), "Unable to read from `"+url+"`.")
+			continue
+		}
+		fatalIf(headURL(url, o.versionID, o.timeRef, encKeyDB, o.nlines, o.isZip).Trace(url), "Unable to read from `"+url+"`.")
 	}
 
 	return nil
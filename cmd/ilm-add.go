@@ -67,6 +67,9 @@ EXAMPLES:
   3. Add a lifecycle rule with an expiration and a noncurrent version expiration action for all objects with prefix doc/ in mybucket.
      {{.Prompt}} {{.HelpName}} --prefix "doc/" --expire-days "300" --noncurrent-expire-days "100" \
           myminio/mybucket/
+
+  4. Add a lifecycle rule which keeps only the 5 most recent noncurrent versions of all objects in mybucket.
+     {{.Prompt}} {{.HelpName}} --noncurrent-expire-newer 5 myminio/mybucket
 `,
 }
 
@@ -226,6 +229,16 @@ func mainILMAdd(cliCtx *cli.Context) error {
 	opts, err := ilm.GetLifecycleOptions(cliCtx)
 	fatalIf(err.Trace(args...), "Unable to generate new lifecycle rules for the input")
 
+	// Noncurrent versions exist only on buckets which have or had versioning
+	// enabled, a rule retaining N of them would silently do nothing otherwise.
+	if opts.NewerNoncurrentExpirationVersions != nil {
+		versioning, err := client.GetVersion(ctx)
+		fatalIf(err.Trace(urlStr), "Unable to get versioning status of "+urlStr)
+		if versioning.Status == "" {
+			fatalIf(errDummy().Trace(urlStr), "Versioning was never enabled on "+urlStr+", enable it with 'mc version enable "+urlStr+"' before retaining noncurrent versions.")
+		}
+	}
+
 	newRule, err := opts.ToILMRule(lfcCfg)
 	fatalIf(err.Trace(args...), "Unable to generate new lifecycle rules for the input")
 
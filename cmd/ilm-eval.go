@@ -0,0 +1,304 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/cmd/ilm"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/pkg/console"
+)
+
+var ilmEvalFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "id",
+		Usage: "id of the lifecycle rule",
+	},
+	cli.IntFlag{
+		Name:  "sample-percent",
+		Value: 100,
+		Usage: "evaluate only this percentage of object names and estimate the totals",
+	},
+}
+
+var ilmEvalCmd = cli.Command{
+	Name:         "eval",
+	Usage:        "preview the objects a lifecycle rule currently applies to",
+	Action:       mainILMEval,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        append(ilmEvalFlags, globalFlags...),
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} [FLAGS] TARGET
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+DESCRIPTION:
+  Scan the bucket and count the object versions a lifecycle rule would expire or
+  transition if it were applied now. Nothing is changed on the bucket.
+
+EXAMPLES:
+  1. Preview the impact of the lifecycle rule with ID "bgrt1ghju" on mybucket.
+     {{.Prompt}} {{.HelpName}} --id "bgrt1ghju" myminio/mybucket
+
+  2. Estimate the impact of the rule by scanning 10% of the object names of a large bucket.
+     {{.Prompt}} {{.HelpName}} --id "bgrt1ghju" --sample-percent 10 myminio/bigbucket
+`,
+}
+
+type ilmEvalStat struct {
+	Count int64 `json:"count"`
+	Size  int64 `json:"size"`
+}
+
+type ilmEvalTotals struct {
+	Expire               ilmEvalStat `json:"expire"`
+	Transition           ilmEvalStat `json:"transition"`
+	NoncurrentExpire     ilmEvalStat `json:"noncurrentExpire"`
+	NoncurrentTransition ilmEvalStat `json:"noncurrentTransition"`
+}
+
+func (t *ilmEvalTotals) add(action ilm.Action, size int64) {
+	var stat *ilmEvalStat
+	switch action {
+	case ilm.ExpireAction:
+		stat = &t.Expire
+	case ilm.TransitionAction:
+		stat = &t.Transition
+	case ilm.NoncurrentExpireAction:
+		stat = &t.NoncurrentExpire
+	case ilm.NoncurrentTransitionAction:
+		stat = &t.NoncurrentTransition
+	default:
+		return
+	}
+	stat.Count++
+	stat.Size += size
+}
+
+// scale - extrapolates totals counted on a sample of percent to the whole bucket.
+func (t ilmEvalTotals) scale(percent int) ilmEvalTotals {
+	scale := func(s ilmEvalStat) ilmEvalStat {
+		return ilmEvalStat{Count: s.Count * 100 / int64(percent), Size: s.Size * 100 / int64(percent)}
+	}
+	return ilmEvalTotals{
+		Expire:               scale(t.Expire),
+		Transition:           scale(t.Transition),
+		NoncurrentExpire:     scale(t.NoncurrentExpire),
+		NoncurrentTransition: scale(t.NoncurrentTransition),
+	}
+}
+
+type ilmEvalMessage struct {
+	Status        string         `json:"status"`
+	Target        string         `json:"target"`
+	ID            string         `json:"id"`
+	Disabled      bool           `json:"disabled,omitempty"`
+	SamplePercent int            `json:"samplePercent"`
+	Scanned       int64          `json:"scanned"`
+	Matched       ilmEvalTotals  `json:"matched"`
+	Estimated     *ilmEvalTotals `json:"estimated,omitempty"`
+}
+
+func (i ilmEvalMessage) String() string {
+	var b strings.Builder
+	header := fmt.Sprintf("Rule `%s` on %s, %d versions scanned", i.ID, i.Target, i.Scanned)
+	if i.Estimated != nil {
+		header += fmt.Sprintf(" (%d%% sample)", i.SamplePercent)
+	}
+	if i.Disabled {
+		header += ", the rule is disabled"
+	}
+	fmt.Fprintln(&b, console.Colorize(ilmMainHeader, header))
+
+	row := func(name string, matched, estimated ilmEvalStat) {
+		line := fmt.Sprintf("%-22s: %d versions, %s", name, matched.Count, humanize.IBytes(uint64(matched.Size)))
+		if i.Estimated != nil {
+			line += fmt.Sprintf(" (~%d versions, ~%s in bucket)", estimated.Count, humanize.IBytes(uint64(estimated.Size)))
+		}
+		fmt.Fprintln(&b, console.Colorize(ilmThemeRow, line))
+	}
+	var estimated ilmEvalTotals
+	if i.Estimated != nil {
+		estimated = *i.Estimated
+	}
+	row("Expire", i.Matched.Expire, estimated.Expire)
+	row("Transition", i.Matched.Transition, estimated.Transition)
+	row("Noncurrent expire", i.Matched.NoncurrentExpire, estimated.NoncurrentExpire)
+	row("Noncurrent transition", i.Matched.NoncurrentTransition, estimated.NoncurrentTransition)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (i ilmEvalMessage) JSON() string {
+	msgBytes, e := json.MarshalIndent(i, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+func checkILMEvalSyntax(ctx *cli.Context) {
+	if len(ctx.Args()) != 1 {
+		showCommandHelpAndExit(ctx, globalErrorExitStatus)
+	}
+	if ctx.String("id") == "" {
+		fatalIf(errInvalidArgument(), "ilm ID cannot be empty")
+	}
+	if p := ctx.Int("sample-percent"); p < 1 || p > 100 {
+		fatalIf(errInvalidArgument().Trace(ctx.String("sample-percent")), "--sample-percent must be between 1 and 100")
+	}
+}
+
+// ilmEvalSampled - reports whether the object name falls in the sample,
+// all versions of an object are either sampled or skipped together.
+func ilmEvalSampled(name string, percent int) bool {
+	return percent >= 100 || crc32.ChecksumIEEE([]byte(name))%100 < uint32(percent)
+}
+
+// evalILMRule - counts the object versions under the target the rule
+// currently expires or transitions.
+func evalILMRule(ctx context.Context, client Client, urlStr string, rule lifecycle.Rule, percent int) ilmEvalMessage {
+	msg := ilmEvalMessage{
+		Status:        "success",
+		Target:        urlStr,
+		ID:            rule.ID,
+		Disabled:      rule.Status != "Enabled",
+		SamplePercent: percent,
+	}
+
+	alias, _, _ := mustExpandAlias(urlStr)
+
+	prefix := ilm.RulePrefix(rule)
+	withTags := len(ilm.RuleTags(rule)) > 0
+	now := time.Now().UTC()
+
+	// Only list the objects under the rule prefix, the rest of the
+	// bucket can never match it.
+	if prefix != "" {
+		prefixURL := urlJoinPath(urlStr, prefix)
+		prefixClient, err := newClient(prefixURL)
+		if err != nil {
+			errorIf(err.Trace(prefixURL), "Unable to initialize client for "+prefixURL)
+			return msg
+		}
+		client = prefixClient
+	}
+
+	// Versions of an object are listed newest first, track the time
+	// each one became noncurrent and how many noncurrent versions
+	// are newer.
+	var key string
+	var successor time.Time
+	var newer int
+	for content := range client.List(ctx, ListOptions{
+		Recursive:         true,
+		WithOlderVersions: true,
+		WithDeleteMarkers: true,
+		ShowDir:           DirNone,
+	}) {
+		if content.Err != nil {
+			errorIf(content.Err.Trace(urlStr), "Unable to list folder.")
+			continue
+		}
+		_, name := url2BucketAndObject(&content.URL)
+		if name != key {
+			key, successor, newer = name, time.Time{}, 0
+		}
+		obj := ilm.ObjectVersion{
+			Name:             name,
+			ModTime:          content.Time,
+			IsLatest:         content.IsLatest || content.VersionID == "",
+			SuccessorModTime: successor,
+			NewerNoncurrent:  newer,
+			StorageClass:     content.StorageClass,
+		}
+		successor = content.Time
+		if content.IsDeleteMarker {
+			continue
+		}
+		if !obj.IsLatest {
+			newer++
+		}
+		if !strings.HasPrefix(name, prefix) || !ilmEvalSampled(name, percent) {
+			continue
+		}
+
+		msg.Scanned++
+		action := ilm.Eval(rule, obj, now)
+		if action == ilm.NoneAction {
+			continue
+		}
+		var tags map[string]string
+		if withTags {
+			clnt, err := newClientFromAlias(alias, content.URL.String())
+			if err == nil {
+				tags, err = clnt.GetTags(ctx, content.VersionID)
+			}
+			if err != nil {
+				errorIf(err.Trace(content.URL.String()), "Unable to get tags of the object.")
+				continue
+			}
+		}
+		if ilm.MatchesRule(rule, name, tags) {
+			msg.Matched.add(action, content.Size)
+		}
+	}
+
+	if percent < 100 {
+		estimated := msg.Matched.scale(percent)
+		msg.Estimated = &estimated
+	}
+	return msg
+}
+
+func mainILMEval(cliCtx *cli.Context) error {
+	ctx, cancelILMEval := context.WithCancel(globalContext)
+	defer cancelILMEval()
+
+	checkILMEvalSyntax(cliCtx)
+	setILMDisplayColorScheme()
+	args := cliCtx.Args()
+	urlStr := args.Get(0)
+	id := cliCtx.String("id")
+
+	client, err := newClient(urlStr)
+	fatalIf(err.Trace(urlStr), "Unable to initialize client for "+urlStr)
+
+	lfcCfg, err := client.GetLifecycle(ctx)
+	fatalIf(err.Trace(urlStr), "Unable to fetch lifecycle rules for "+urlStr)
+
+	for _, rule := range lfcCfg.Rules {
+		if rule.ID == id {
+			printMsg(evalILMRule(ctx, client, urlStr, rule, cliCtx.Int("sample-percent")))
+			return nil
+		}
+	}
+	fatalIf(errInvalidArgument().Trace(id), "Lifecycle rule `"+id+"` not found on "+urlStr)
+	return nil
+}
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/xml"
 	"errors"
 
 	"github.com/minio/cli"
@@ -27,19 +28,29 @@ import (
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 )
 
+var ilmExportFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "xml",
+		Usage: "export in the S3 XML format instead of JSON",
+	},
+}
+
 var ilmExportCmd = cli.Command{
 	Name:         "export",
 	Usage:        "export lifecycle configuration in JSON format",
 	Action:       mainILMExport,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        globalFlags,
+	Flags:        append(ilmExportFlags, globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
 USAGE:
-  {{.HelpName}} TARGET
+  {{.HelpName}} [FLAGS] TARGET
 
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
 DESCRIPTION:
   Exports lifecycle configuration in JSON format to STDOUT.
 
@@ -49,6 +60,9 @@ EXAMPLES:
 
   2. Print lifecycle configuration for 'mybucket' to STDOUT.
      {{.Prompt}} {{.HelpName}} play/mybucket
+
+  3. Export lifecycle configuration for 'mybucket' in the S3 XML format to 'lifecycle.xml' file.
+     {{.Prompt}} {{.HelpName}} --xml myminio/mybucket > lifecycle.xml
 `,
 }
 
@@ -56,9 +70,16 @@ type ilmExportMessage struct {
 	Status string                   `json:"status"`
 	Target string                   `json:"target"`
 	Config *lifecycle.Configuration `json:"config"`
+
+	xml bool
 }
 
 func (i ilmExportMessage) String() string {
+	if i.xml {
+		msgBytes, e := xml.MarshalIndent(i.Config, "", " ")
+		fatalIf(probe.NewError(e), "Unable to export ILM configuration")
+		return xml.Header + string(msgBytes)
+	}
 	msgBytes, e := json.MarshalIndent(i.Config, "", " ")
 	fatalIf(probe.NewError(e), "Unable to export ILM configuration")
 
@@ -103,6 +124,7 @@ func mainILMExport(cliCtx *cli.Context) error {
 		Status: "success",
 		Target: urlStr,
 		Config: ilmCfg,
+		xml:    cliCtx.Bool("xml"),
 	})
 
 	return nil
@@ -18,12 +18,18 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/xml"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
+	"github.com/minio/mc/cmd/ilm"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/minio/pkg/console"
 )
@@ -42,7 +48,9 @@ USAGE:
   {{.HelpName}} TARGET
 
 DESCRIPTION:
-  Import entire lifecycle configuration from STDIN, input file is expected to be in JSON format.
+  Import entire lifecycle configuration from STDIN, input file is expected to be in JSON format
+  or in the S3 XML format. Existing rules of the bucket are replaced, the rules added, changed
+  and removed compared to the current configuration are reported.
 
 EXAMPLES:
   1. Set lifecycle configuration for the mybucket on alias 'myminio' to the rules imported from lifecycle.json
@@ -50,16 +58,31 @@ EXAMPLES:
 
   2. Set lifecycle configuration for the mybucket on alias 'myminio'. User is expected to enter the JSON contents on STDIN
      {{.Prompt}} {{.HelpName}} myminio/mybucket
+
+  3. Set lifecycle configuration for the mybucket on alias 'myminio' from an S3 XML document
+     {{.Prompt}} {{.HelpName}} myminio/mybucket < lifecycle.xml
 `,
 }
 
 type ilmImportMessage struct {
-	Status string `json:"status"`
-	Target string `json:"target"`
+	Status  string   `json:"status"`
+	Target  string   `json:"target"`
+	Added   []string `json:"added,omitempty"`
+	Changed []string `json:"changed,omitempty"`
+	Removed []string `json:"removed,omitempty"`
 }
 
 func (i ilmImportMessage) String() string {
-	return console.Colorize(ilmThemeResultSuccess, "Lifecycle configuration imported successfully to `"+i.Target+"`.")
+	msg := console.Colorize(ilmThemeResultSuccess, "Lifecycle configuration imported successfully to `"+i.Target+"`.")
+	for _, r := range []struct {
+		name string
+		ids  []string
+	}{{"Added", i.Added}, {"Changed", i.Changed}, {"Removed", i.Removed}} {
+		if len(r.ids) > 0 {
+			msg += "\n" + console.Colorize(ilmThemeRow, r.name+" rules: "+strings.Join(r.ids, ", "))
+		}
+	}
+	return msg
 }
 
 func (i ilmImportMessage) JSON() string {
@@ -70,12 +93,25 @@ func (i ilmImportMessage) JSON() string {
 
 // readILMConfig read from stdin, returns XML.
 func readILMConfig() (*lifecycle.Configuration, *probe.Error) {
-	// User is expected to enter the lifecycleConfiguration instance contents in JSON format
+	return parseILMConfig(os.Stdin)
+}
+
+// parseILMConfig - decodes a lifecycle configuration in JSON format or,
+// if the document starts with '<', in the S3 XML format.
+func parseILMConfig(r io.Reader) (*lifecycle.Configuration, *probe.Error) {
 	cfg := lifecycle.NewConfiguration()
 
-	// Consume json from STDIN
-	dec := json.NewDecoder(os.Stdin)
-	if e := dec.Decode(cfg); e != nil {
+	buf, e := io.ReadAll(r)
+	if e != nil {
+		return cfg, probe.NewError(e)
+	}
+	buf = bytes.TrimSpace(buf)
+	if bytes.HasPrefix(buf, []byte("<")) {
+		e = xml.Unmarshal(buf, cfg)
+	} else {
+		e = json.Unmarshal(buf, cfg)
+	}
+	if e != nil {
 		return cfg, probe.NewError(e)
 	}
 
@@ -110,12 +146,25 @@ func mainILMImport(cliCtx *cli.Context) error {
 		// since no rules are provided and we will show a success message.
 		fatalIf(errDummy(), "The provided ILM configuration does not contain any rule, aborting.")
 	}
+	fatalIf(ilm.ValidateILMConfig(ilmCfg).Trace(args...), "Invalid ILM configuration")
+
+	curCfg, err := client.GetLifecycle(ctx)
+	if err != nil {
+		if minio.ToErrorResponse(err.ToGoError()).Code != "NoSuchLifecycleConfiguration" {
+			fatalIf(err.Trace(urlStr), "Unable to fetch lifecycle rules for "+urlStr)
+		}
+		curCfg = lifecycle.NewConfiguration()
+	}
+	added, changed, removed := ilm.DiffILMRules(curCfg, ilmCfg)
 
 	fatalIf(client.SetLifecycle(ctx, ilmCfg).Trace(urlStr), "Unable to set new lifecycle rules")
 
 	printMsg(ilmImportMessage{
-		Status: "success",
-		Target: urlStr,
+		Status:  "success",
+		Target:  urlStr,
+		Added:   added,
+		Changed: changed,
+		Removed: removed,
 	})
 	return nil
 }
@@ -0,0 +1,66 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/mc/cmd/ilm"
+)
+
+func TestParseILMConfig(t *testing.T) {
+	jsonCfg := `{"Rules": [
+ {"ID": "expire", "Status": "Enabled", "Expiration": {"Days": 30}, "Filter": {"Prefix": "logs/"}},
+ {"ID": "keep", "Status": "Enabled", "NoncurrentVersionExpiration": {"NewerNoncurrentVersions": 5}}
+]}`
+	xmlCfg := `<?xml version="1.0" encoding="UTF-8"?>
+<LifecycleConfiguration>
+ <Rule><ID>expire</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><Expiration><Days>30</Days></Expiration></Rule>
+ <Rule><ID>keep</ID><Status>Enabled</Status><NoncurrentVersionExpiration><NewerNoncurrentVersions>5</NewerNoncurrentVersions></NoncurrentVersionExpiration></Rule>
+</LifecycleConfiguration>`
+
+	fromJSON, err := parseILMConfig(strings.NewReader(jsonCfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromXML, err := parseILMConfig(strings.NewReader(xmlCfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added, changed, removed := ilm.DiffILMRules(fromJSON, fromXML); len(added)+len(changed)+len(removed) != 0 {
+		t.Fatalf("expected JSON and XML configs to match, got added %v changed %v removed %v", added, changed, removed)
+	}
+
+	next, err := parseILMConfig(strings.NewReader(`{"Rules": [
+ {"ID": "expire", "Status": "Enabled", "Expiration": {"Days": 60}, "Filter": {"Prefix": "logs/"}},
+ {"ID": "new", "Status": "Enabled", "Expiration": {"Days": 1}}
+]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	added, changed, removed := ilm.DiffILMRules(fromXML, next)
+	if !reflect.DeepEqual(added, []string{"new"}) || !reflect.DeepEqual(changed, []string{"expire"}) || !reflect.DeepEqual(removed, []string{"keep"}) {
+		t.Errorf("unexpected diff: added %v changed %v removed %v", added, changed, removed)
+	}
+
+	if _, err := parseILMConfig(strings.NewReader("<LifecycleConfiguration>")); err == nil {
+		t.Error("expected truncated XML to fail")
+	}
+}
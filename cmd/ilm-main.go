@@ -31,6 +31,7 @@ var ilmSubcommands = []cli.Command{
 	ilmExportCmd,
 	ilmImportCmd,
 	ilmRestoreCmd,
+	ilmEvalCmd,
 }
 
 var ilmCmd = cli.Command{
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ilm
+
+import (
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// Action is the lifecycle action a rule currently applies to an object version.
+type Action int
+
+// Lifecycle actions returned by Eval.
+const (
+	NoneAction Action = iota
+	ExpireAction
+	TransitionAction
+	NoncurrentExpireAction
+	NoncurrentTransitionAction
+)
+
+// ObjectVersion describes an object version for rule evaluation.
+type ObjectVersion struct {
+	Name     string
+	ModTime  time.Time
+	IsLatest bool
+	// SuccessorModTime is the time this version became noncurrent.
+	SuccessorModTime time.Time
+	// NewerNoncurrent is the number of noncurrent versions newer than this one.
+	NewerNoncurrent int
+	// StorageClass is the storage class of the version, the tier name once
+	// it was transitioned.
+	StorageClass string
+}
+
+// RulePrefix returns the object prefix the rule applies to.
+func RulePrefix(rule lifecycle.Rule) string {
+	return getPrefix(rule)
+}
+
+// RuleTags returns the object tags the rule applies to.
+func RuleTags(rule lifecycle.Rule) map[string]string {
+	tags := map[string]string{}
+	if !rule.RuleFilter.Tag.IsEmpty() {
+		tags[rule.RuleFilter.Tag.Key] = rule.RuleFilter.Tag.Value
+	}
+	for _, tag := range rule.RuleFilter.And.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+// MatchesRule reports whether the rule filter selects an object with
+// the given name and tags.
+func MatchesRule(rule lifecycle.Rule, name string, tags map[string]string) bool {
+	if !strings.HasPrefix(name, RulePrefix(rule)) {
+		return false
+	}
+	for k, v := range RuleTags(rule) {
+		if tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// expectedExpiryTime returns the time an action due days after t takes
+// effect, rounded up to the next midnight UTC like the server does.
+func expectedExpiryTime(t time.Time, days lifecycle.ExpirationDays) time.Time {
+	if days == 0 {
+		return t
+	}
+	return t.UTC().Add(time.Duration(days+1) * 24 * time.Hour).Truncate(24 * time.Hour)
+}
+
+// Eval returns the action the rule applies to obj at time now, ignoring
+// the rule filter. Expiration takes precedence over transition, versions
+// already in the target storage class are not transitioned again.
+func Eval(rule lifecycle.Rule, obj ObjectVersion, now time.Time) Action {
+	if obj.IsLatest {
+		exp := rule.Expiration
+		if !exp.IsDateNull() && !now.Before(exp.Date.Time) {
+			return ExpireAction
+		}
+		if !exp.IsDaysNull() && !now.Before(expectedExpiryTime(obj.ModTime, exp.Days)) {
+			return ExpireAction
+		}
+		tr := rule.Transition
+		if tr.StorageClass != "" && tr.StorageClass == obj.StorageClass {
+			return NoneAction
+		}
+		if !tr.IsDateNull() && !now.Before(tr.Date.Time) {
+			return TransitionAction
+		}
+		if !tr.IsDaysNull() && !now.Before(expectedExpiryTime(obj.ModTime, tr.Days)) {
+			return TransitionAction
+		}
+		return NoneAction
+	}
+
+	nve := rule.NoncurrentVersionExpiration
+	if nve.NoncurrentDays > 0 || nve.NewerNoncurrentVersions > 0 {
+		due := obj.NewerNoncurrent >= nve.NewerNoncurrentVersions
+		if nve.NoncurrentDays > 0 && now.Before(expectedExpiryTime(obj.SuccessorModTime, nve.NoncurrentDays)) {
+			due = false
+		}
+		if due {
+			return NoncurrentExpireAction
+		}
+	}
+	nvt := rule.NoncurrentVersionTransition
+	if !nvt.IsStorageClassEmpty() && nvt.StorageClass != obj.StorageClass {
+		due := obj.NewerNoncurrent >= nvt.NewerNoncurrentVersions
+		if now.Before(expectedExpiryTime(obj.SuccessorModTime, nvt.NoncurrentDays)) {
+			due = false
+		}
+		if due {
+			return NoncurrentTransitionAction
+		}
+	}
+	return NoneAction
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ilm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+func TestMatchesRule(t *testing.T) {
+	rule := lifecycle.Rule{
+		RuleFilter: lifecycle.Filter{
+			And: lifecycle.And{
+				Prefix: "doc/",
+				Tags:   []lifecycle.Tag{{Key: "tier", Value: "cold"}},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		matches bool
+	}{
+		{"doc/a.txt", map[string]string{"tier": "cold", "x": "y"}, true},
+		{"doc/a.txt", map[string]string{"tier": "hot"}, false},
+		{"doc/a.txt", nil, false},
+		{"img/a.png", map[string]string{"tier": "cold"}, false},
+	}
+	for i, test := range tests {
+		if matches := MatchesRule(rule, test.name, test.tags); matches != test.matches {
+			t.Errorf("%d: expected %v, got %v", i, test.matches, matches)
+		}
+	}
+	if !MatchesRule(lifecycle.Rule{Prefix: "doc/"}, "doc/b", nil) {
+		t.Error("expected legacy prefix to match")
+	}
+}
+
+func TestEval(t *testing.T) {
+	now := time.Date(2022, 6, 15, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.Add(-time.Duration(days) * 24 * time.Hour) }
+
+	rule := lifecycle.Rule{
+		Expiration: lifecycle.Expiration{Days: 30},
+		Transition: lifecycle.Transition{Days: 10, StorageClass: "WARM"},
+		NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{
+			NoncurrentDays:          7,
+			NewerNoncurrentVersions: 2,
+		},
+	}
+	tests := []struct {
+		obj    ObjectVersion
+		action Action
+	}{
+		{ObjectVersion{ModTime: daysAgo(40), IsLatest: true}, ExpireAction},
+		{ObjectVersion{ModTime: daysAgo(20), IsLatest: true}, TransitionAction},
+		{ObjectVersion{ModTime: daysAgo(5), IsLatest: true}, NoneAction},
+		// Already transitioned to the target tier.
+		{ObjectVersion{ModTime: daysAgo(20), IsLatest: true, StorageClass: "WARM"}, NoneAction},
+		{ObjectVersion{ModTime: daysAgo(40), IsLatest: true, StorageClass: "WARM"}, ExpireAction},
+		// Retained by NewerNoncurrentVersions.
+		{ObjectVersion{ModTime: daysAgo(50), SuccessorModTime: daysAgo(40), NewerNoncurrent: 1}, NoneAction},
+		{ObjectVersion{ModTime: daysAgo(50), SuccessorModTime: daysAgo(40), NewerNoncurrent: 2}, NoncurrentExpireAction},
+		// Not noncurrent for long enough.
+		{ObjectVersion{ModTime: daysAgo(50), SuccessorModTime: daysAgo(3), NewerNoncurrent: 5}, NoneAction},
+	}
+	for i, test := range tests {
+		if action := Eval(rule, test.obj, now); action != test.action {
+			t.Errorf("%d: expected action %v, got %v", i, test.action, action)
+		}
+	}
+
+	noncurrent := lifecycle.Rule{
+		NoncurrentVersionTransition: lifecycle.NoncurrentVersionTransition{NoncurrentDays: 7, StorageClass: "WARM"},
+	}
+	obj := ObjectVersion{ModTime: daysAgo(50), SuccessorModTime: daysAgo(40)}
+	if action := Eval(noncurrent, obj, now); action != NoncurrentTransitionAction {
+		t.Errorf("expected noncurrent transition, got %v", action)
+	}
+	obj.StorageClass = "WARM"
+	if action := Eval(noncurrent, obj, now); action != NoneAction {
+		t.Errorf("expected no action for a transitioned noncurrent version, got %v", action)
+	}
+}
@@ -19,6 +19,7 @@ package ilm
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -118,6 +119,9 @@ func validateNoncurrentExpiration(rule lifecycle.Rule) error {
 	if days < 0 {
 		return errors.New("NoncurrentVersionExpiration.NoncurrentDays is not a positive integer")
 	}
+	if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions < 0 {
+		return errors.New("NoncurrentVersionExpiration.NewerNoncurrentVersions is not a positive integer")
+	}
 	return nil
 }
 
@@ -181,6 +185,38 @@ func validateILMRule(rule lifecycle.Rule) *probe.Error {
 	return nil
 }
 
+// ValidateILMConfig checks all rules of a lifecycle configuration, as
+// imported from a file. Dates in the past are accepted since exported
+// rules may have been created long ago.
+func ValidateILMConfig(cfg *lifecycle.Configuration) *probe.Error {
+	ids := make(map[string]bool, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		if rule.ID == "" {
+			return probe.NewError(errors.New("rule ID cannot be empty"))
+		}
+		if ids[rule.ID] {
+			return probe.NewError(fmt.Errorf("rule ID `%s` is not unique", rule.ID))
+		}
+		ids[rule.ID] = true
+		if rule.Status != "Enabled" && rule.Status != "Disabled" {
+			return probe.NewError(fmt.Errorf("rule `%s`: Status must be Enabled or Disabled", rule.ID))
+		}
+		for _, validate := range []func(lifecycle.Rule) error{
+			validateRuleAction,
+			validateExpiration,
+			validateTranExpDate,
+			validateTranDays,
+			validateNoncurrentExpiration,
+			validateNoncurrentTransition,
+		} {
+			if e := validate(rule); e != nil {
+				return probe.NewError(fmt.Errorf("rule `%s`: %w", rule.ID, e))
+			}
+		}
+	}
+	return nil
+}
+
 func parseTransitionDate(transitionDateStr string) (lifecycle.ExpirationDate, *probe.Error) {
 	transitionDate, e := time.Parse(defaultILMDateFormat, transitionDateStr)
 	if e != nil {
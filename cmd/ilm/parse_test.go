@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ilm
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+func TestValidateNewerNoncurrentExpiration(t *testing.T) {
+	tests := []struct {
+		versions int
+		success  bool
+	}{
+		{5, true},
+		{0, false},
+		{-1, false},
+	}
+	for _, test := range tests {
+		rule := lifecycle.Rule{
+			ID:     "rule",
+			Status: "Enabled",
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{
+				NewerNoncurrentVersions: test.versions,
+			},
+		}
+		if err := validateILMRule(rule); (err == nil) != test.success {
+			t.Errorf("%d: expected success %v, got %v", test.versions, test.success, err)
+		}
+	}
+}
+
+func TestValidateILMConfig(t *testing.T) {
+	expire := lifecycle.Expiration{Days: 10}
+	tests := []struct {
+		rules   []lifecycle.Rule
+		success bool
+	}{
+		{[]lifecycle.Rule{{ID: "a", Status: "Enabled", Expiration: expire}, {ID: "b", Status: "Disabled", Expiration: expire}}, true},
+		{[]lifecycle.Rule{{ID: "a", Status: "Enabled", Expiration: expire}, {ID: "a", Status: "Enabled", Expiration: expire}}, false},
+		{[]lifecycle.Rule{{Status: "Enabled", Expiration: expire}}, false},
+		{[]lifecycle.Rule{{ID: "a", Status: "enabled", Expiration: expire}}, false},
+		{[]lifecycle.Rule{{ID: "a", Status: "Enabled"}}, false},
+	}
+	for i, test := range tests {
+		err := ValidateILMConfig(&lifecycle.Configuration{Rules: test.rules})
+		if (err == nil) != test.success {
+			t.Errorf("%d: expected success %v, got %v", i, test.success, err)
+		}
+	}
+}
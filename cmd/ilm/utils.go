@@ -18,6 +18,7 @@
 package ilm
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -139,3 +140,40 @@ func ToTables(cfg *lifecycle.Configuration, filter LsFilter) []Table {
 		return []Table{tierCur, tierNoncur, expCur, expNoncur}
 	}
 }
+
+// DiffILMRules compares the rules of two lifecycle configurations by ID,
+// returning the IDs only in next, the IDs present in both which differ
+// and the IDs only in prev.
+func DiffILMRules(prev, next *lifecycle.Configuration) (added, changed, removed []string) {
+	prevRules := make(map[string]string, len(prev.Rules))
+	for _, rule := range prev.Rules {
+		prevRules[rule.ID] = ruleFingerprint(rule)
+	}
+	nextIDs := make(map[string]bool, len(next.Rules))
+	for _, rule := range next.Rules {
+		nextIDs[rule.ID] = true
+		fp, ok := prevRules[rule.ID]
+		switch {
+		case !ok:
+			added = append(added, rule.ID)
+		case fp != ruleFingerprint(rule):
+			changed = append(changed, rule.ID)
+		}
+	}
+	for _, rule := range prev.Rules {
+		if !nextIDs[rule.ID] {
+			removed = append(removed, rule.ID)
+		}
+	}
+	return added, changed, removed
+}
+
+// ruleFingerprint - serializes the rule so that rules decoded from XML
+// and JSON compare equal.
+func ruleFingerprint(rule lifecycle.Rule) string {
+	buf, e := json.Marshal(rule)
+	if e != nil {
+		return ""
+	}
+	return string(buf)
+}
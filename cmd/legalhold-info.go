@@ -128,6 +128,45 @@ func (l legalHoldInfoMessage) JSON() string {
 	return string(msgBytes)
 }
 
+// legalHoldSummaryMessage - legal hold totals of a listing.
+type legalHoldSummaryMessage struct {
+	Status  string `json:"status"`
+	Target  string `json:"target"`
+	Objects int    `json:"objects"`
+	On      int    `json:"on"`
+	Off     int    `json:"off"`
+	NotSet  int    `json:"notSet"`
+	Failed  int    `json:"failed"`
+}
+
+func (l legalHoldSummaryMessage) String() string {
+	msg := fmt.Sprintf("Legal hold of %d object(s) under `%s`: %d ON, %d OFF, %d not set.", l.Objects, l.Target, l.On, l.Off, l.NotSet)
+	if l.Failed > 0 {
+		msg += fmt.Sprintf(" %d failed.", l.Failed)
+	}
+	return console.Colorize("LegalHoldSummary", msg)
+}
+
+func (l legalHoldSummaryMessage) JSON() string {
+	l.Status = "success"
+	msgBytes, e := json.MarshalIndent(l, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// add - counts the legal hold of one object.
+func (l *legalHoldSummaryMessage) add(lhold minio.LegalHoldStatus) {
+	l.Objects++
+	switch lhold {
+	case minio.LegalHoldEnabled:
+		l.On++
+	case minio.LegalHoldDisabled:
+		l.Off++
+	default:
+		l.NotSet++
+	}
+}
+
 // showLegalHoldInfo - show legalhold for one or many objects within a given prefix, with or without versioning
 func showLegalHoldInfo(ctx context.Context, urlStr, versionID string, timeRef time.Time, withOlderVersions, recursive bool) error {
 	clnt, err := newClient(urlStr)
@@ -165,6 +204,7 @@ func showLegalHoldInfo(ctx context.Context, urlStr, versionID string, timeRef ti
 	var cErr error
 	errorsFound := false
 	objectsFound := false
+	summary := legalHoldSummaryMessage{Target: urlStr}
 	lstOptions := ListOptions{Recursive: recursive, ShowDir: DirNone}
 	if !timeRef.IsZero() {
 		lstOptions.WithOlderVersions = withOlderVersions
@@ -190,23 +230,25 @@ func showLegalHoldInfo(ctx context.Context, urlStr, versionID string, timeRef ti
 		lhold, probeErr := newClnt.GetObjectLegalHold(ctx, content.VersionID)
 		if probeErr != nil {
 			errorsFound = true
+			summary.Failed++
 			errorIf(probeErr.Trace(content.URL.Path), "Failed to get legal hold information on `"+content.URL.Path+"`")
 		} else {
-			if !globalJSON {
-
-				contentURL := filepath.ToSlash(content.URL.Path)
-				key := strings.TrimPrefix(contentURL, prefixPath)
-
-				printMsg(legalHoldInfoMessage{
-					LegalHold: lhold,
-					Status:    "success",
-					URLPath:   content.URL.String(),
-					Key:       key,
-					VersionID: content.VersionID,
-				})
-			}
+			contentURL := filepath.ToSlash(content.URL.Path)
+			key := strings.TrimPrefix(contentURL, prefixPath)
+
+			printMsg(legalHoldInfoMessage{
+				LegalHold: lhold,
+				Status:    "success",
+				URLPath:   content.URL.String(),
+				Key:       key,
+				VersionID: content.VersionID,
+			})
+			summary.add(lhold)
 		}
 	}
+	if objectsFound {
+		printMsg(summary)
+	}
 
 	if cErr == nil && !globalJSON {
 		switch {
@@ -228,6 +270,7 @@ func mainLegalHoldInfo(cliCtx *cli.Context) error {
 	console.SetColor("LegalHoldVersion", color.New(color.FgGreen))
 	console.SetColor("LegalHoldPartialFailure", color.New(color.FgRed, color.Bold))
 	console.SetColor("LegalHoldMessageFailure", color.New(color.FgYellow))
+	console.SetColor("LegalHoldSummary", color.New(color.Bold))
 
 	targetURL, versionID, timeRef, recursive, withVersions := parseLegalHoldArgs(cliCtx)
 	if timeRef.IsZero() && withVersions {
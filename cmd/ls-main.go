@@ -52,14 +52,32 @@ var (
 			Name:  "summarize",
 			Usage: "display summary information (number of objects, total size)",
 		},
+		cli.IntFlag{
+			Name:  "depth",
+			Usage: "with --recursive --summarize, number of prefix levels to summarize at",
+			Value: 1,
+		},
+		cli.StringFlag{
+			Name:  "filter-storage-class",
+			Usage: "list only objects in the specified storage class(es), comma separated",
+		},
 		cli.StringFlag{
-			Name:  "storage-class, sc",
-			Usage: "filter to specified storage class",
+			Name:   "storage-class, sc",
+			Usage:  "filter to specified storage class",
+			Hidden: true, // deprecated, use --filter-storage-class
 		},
 		cli.BoolFlag{
 			Name:  "zip",
 			Usage: "list files inside zip archive (MinIO servers only)",
 		},
+		cli.StringFlag{
+			Name:  "sort",
+			Usage: "sort the listing by 'name', 'size' or 'time', with --recursive the whole listing is held in memory",
+		},
+		cli.BoolFlag{
+			Name:  "reverse",
+			Usage: "reverse the sort order, implies '--sort name' if no sort is specified",
+		},
 	}
 )
 
@@ -111,7 +129,21 @@ EXAMPLES:
      {{.Prompt}} {{.HelpName}} --summarize s3/mybucket/
   
   10. List all objects on mybucket, for the GLACIER storage class
-     {{.Prompt}} {{.HelpName}} --storage-class 'GLACIER' s3/mybucket 
+     {{.Prompt}} {{.HelpName}} --filter-storage-class 'GLACIER' s3/mybucket
+
+  11. List all objects on mybucket recursively, then summarize the number of objects and size of
+      every prefix two levels deep, followed by the totals.
+     {{.Prompt}} {{.HelpName}} --recursive --summarize --depth 2 s3/mybucket/
+
+  12. List all objects on mybucket recursively, largest objects first. Sorting requires the whole
+      listing to be held in memory before anything is printed.
+     {{.Prompt}} {{.HelpName}} --recursive --sort size --reverse s3/mybucket
+
+  13. List the contents of mybucket, newest objects first.
+     {{.Prompt}} {{.HelpName}} --sort time --reverse s3/mybucket
+
+  14. Verify that objects under a prefix were transitioned to the remote tiers WARM-TIER or COLD-TIER.
+     {{.Prompt}} {{.HelpName}} --recursive --filter-storage-class 'WARM-TIER,COLD-TIER' s3/mybucket/logs/
 `,
 }
 
@@ -124,33 +156,39 @@ var rewindSupportedFormat = []string{
 
 // Parse rewind flag while considering the system local time zone
 func parseRewindFlag(rewind string) (timeRef time.Time) {
-	if rewind != "" {
+	return parseTimeRefFlag(rewind, "--rewind")
+}
+
+// parseTimeRefFlag parses a point in time given either as a date in one of
+// the rewind supported formats or as a duration in the past.
+func parseTimeRefFlag(value, flag string) (timeRef time.Time) {
+	if value != "" {
 		location, e := time.LoadLocation("Local")
 		if e != nil {
 			return
 		}
 
 		for _, format := range rewindSupportedFormat {
-			if t, e := time.ParseInLocation(format, rewind, location); e == nil {
+			if t, e := time.ParseInLocation(format, value, location); e == nil {
 				timeRef = t
 				break
 			}
 		}
 
 		if timeRef.IsZero() {
-			// rewind is not parsed, check if it is a duration instead
-			if duration, e := ParseDuration(rewind); e == nil {
+			// value is not parsed, check if it is a duration instead
+			if duration, e := ParseDuration(value); e == nil {
 				if duration < 0 {
 					fatalIf(probe.NewError(errors.New("negative duration is not supported")),
-						"Unable to parse --rewind argument")
+						"Unable to parse "+flag+" argument")
 				}
 				timeRef = time.Now().Add(-time.Duration(duration))
 			}
 		}
 
 		if timeRef.IsZero() {
-			// rewind argument still not parsed, error out
-			fatalIf(probe.NewError(errors.New("unknown format")), "Unable to parse --rewind argument")
+			// value still not parsed, error out
+			fatalIf(probe.NewError(errors.New("unknown format")), "Unable to parse "+flag+" argument")
 		}
 	}
 	return
@@ -179,7 +217,25 @@ func checkListSyntax(ctx context.Context, cliCtx *cli.Context) ([]string, doList
 	if listZip && (withOlderVersions || !timeRef.IsZero()) {
 		fatalIf(errInvalidArgument().Trace(args...), "Zip file listing can only be performed on the latest version")
 	}
-	storageClasss := cliCtx.String("storage-class")
+	sortBy := cliCtx.String("sort")
+	switch sortBy {
+	case "", "name", "size", "time":
+	default:
+		fatalIf(errInvalidArgument().Trace(sortBy), "Unknown sort order `"+sortBy+"`, valid values are 'name', 'size' or 'time'.")
+	}
+	if sortBy == "" && cliCtx.Bool("reverse") {
+		sortBy = "name"
+	}
+
+	summaryDepth := cliCtx.Int("depth")
+	if summaryDepth < 1 {
+		fatalIf(errInvalidArgument().Trace(args...), "`--depth` must be at least 1.")
+	}
+
+	storageClasses := cliCtx.String("filter-storage-class")
+	if storageClasses == "" {
+		storageClasses = cliCtx.String("storage-class")
+	}
 	opts := doListOptions{
 		timeRef:           timeRef,
 		isRecursive:       isRecursive,
@@ -187,7 +243,10 @@ func checkListSyntax(ctx context.Context, cliCtx *cli.Context) ([]string, doList
 		isSummary:         isSummary,
 		withOlderVersions: withOlderVersions,
 		listZip:           listZip,
-		filter:            storageClasss,
+		filter:            parseStorageClassFilter(storageClasses),
+		sortBy:            sortBy,
+		reverse:           cliCtx.Bool("reverse"),
+		summaryDepth:      summaryDepth,
 	}
 	return args, opts
 }
@@ -208,10 +267,18 @@ func mainList(cliCtx *cli.Context) error {
 	console.SetColor("Time", color.New(color.FgGreen))
 	console.SetColor("Summarize", color.New(color.Bold))
 	console.SetColor("SC", color.New(color.FgBlue))
+	console.SetColor("Prefix", color.New(color.FgCyan, color.Bold))
+	console.SetColor("Objects", color.New(color.FgGreen))
 
 	// check 'ls' cliCtx arguments.
 	args, opts := checkListSyntax(ctx, cliCtx)
 
+	// Recursive JSON listings are meant to be stream processed, always
+	// print them as JSON lines, one record per object.
+	if globalJSON && opts.isRecursive {
+		globalJSONLine = true
+	}
+
 	var cErr error
 	for _, targetURL := range args {
 		clnt, err := newClient(targetURL)
@@ -200,6 +200,44 @@ func printObjectVersions(clntURL ClientURL, ctntVersions []*ClientContent, print
 	}
 }
 
+// parseStorageClassFilter splits a comma separated list of storage
+// classes, an empty list or '*' matches every storage class.
+func parseStorageClassFilter(s string) (filter []string) {
+	for _, sc := range strings.Split(s, ",") {
+		sc = strings.ToUpper(strings.TrimSpace(sc))
+		if sc == "*" {
+			return nil
+		}
+		if sc != "" {
+			filter = append(filter, sc)
+		}
+	}
+	return filter
+}
+
+// matchStorageClass returns true if content is in one of the storage
+// classes of filter. Prefixes, delete markers and local files have no
+// storage class and are always listed, objects reported without a
+// storage class by an object storage are STANDARD.
+func matchStorageClass(filter []string, content *ClientContent) bool {
+	if len(filter) == 0 || content.Type.IsDir() || content.IsDeleteMarker {
+		return true
+	}
+	sc := strings.ToUpper(content.StorageClass)
+	if sc == "" {
+		if content.URL.Type != objectStorage {
+			return true
+		}
+		sc = "STANDARD"
+	}
+	for _, f := range filter {
+		if f == sc {
+			return true
+		}
+	}
+	return false
+}
+
 type doListOptions struct {
 	timeRef           time.Time
 	isRecursive       bool
@@ -207,7 +245,91 @@ type doListOptions struct {
 	isSummary         bool
 	withOlderVersions bool
 	listZip           bool
-	filter            string
+	filter            []string
+	sortBy            string
+	reverse           bool
+	summaryDepth      int
+}
+
+// prefixSummary accumulates object count and size per prefix of a
+// recursive listing, it is printed with the same format as `mc du`.
+type prefixSummary struct {
+	rootPath  string
+	separator string
+	depth     int
+	totals    map[string]*duMessage
+}
+
+func newPrefixSummary(root ClientURL, depth int) *prefixSummary {
+	return &prefixSummary{
+		rootPath:  root.Path,
+		separator: string(root.Separator),
+		depth:     depth,
+		totals:    make(map[string]*duMessage),
+	}
+}
+
+// add accounts an object to the prefix depth levels below the listing root.
+func (p *prefixSummary) add(content *ClientContent, withVersions bool) {
+	if content.IsDeleteMarker || content.Type.IsDir() {
+		return
+	}
+	rel := strings.TrimPrefix(strings.TrimPrefix(content.URL.Path, p.rootPath), p.separator)
+	parts := strings.Split(rel, p.separator)
+	// The last element is the object name, it is not a prefix.
+	parts = parts[:len(parts)-1]
+	if len(parts) > p.depth {
+		parts = parts[:p.depth]
+	}
+	prefix := strings.Trim(p.rootPath, p.separator)
+	if len(parts) > 0 {
+		prefix = strings.TrimPrefix(prefix+p.separator+strings.Join(parts, p.separator), p.separator)
+	}
+
+	total, ok := p.totals[prefix]
+	if !ok {
+		total = &duMessage{Prefix: prefix, Status: "success", IsVersions: withVersions}
+		p.totals[prefix] = total
+	}
+	total.Size += content.Size
+	total.Objects++
+}
+
+// print prints the totals sorted by prefix.
+func (p *prefixSummary) print() {
+	prefixes := make([]string, 0, len(p.totals))
+	for prefix := range p.totals {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		printMsg(*p.totals[prefix])
+	}
+}
+
+// sortListEntries - sorts listed objects, each entry holds the versions of
+// one object and is ordered by its latest version. The sort is stable and
+// entries that compare equal by size or time are ordered by name.
+func sortListEntries(entries [][]*ClientContent, sortBy string, reverse bool) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i][0], entries[j][0]
+		switch sortBy {
+		case "size":
+			if a.Size != b.Size {
+				return (a.Size < b.Size) != reverse
+			}
+		case "time":
+			if !a.Time.Equal(b.Time) {
+				return a.Time.Before(b.Time) != reverse
+			}
+		default:
+			if a.URL.Path != b.URL.Path {
+				return (a.URL.Path < b.URL.Path) != reverse
+			}
+			return false
+		}
+		return a.URL.Path < b.URL.Path
+	})
 }
 
 // doList - list all entities inside a folder.
@@ -218,8 +340,31 @@ func doList(ctx context.Context, clnt Client, o doListOptions) error {
 		cErr              error
 		totalSize         int64
 		totalObjects      int64
+		sortedEntries     [][]*ClientContent
+		summary           *prefixSummary
 	)
 
+	if o.isSummary && o.isRecursive {
+		summary = newPrefixSummary(clnt.GetURL(), o.summaryDepth)
+	}
+
+	// Versions of the same object are grouped before printing, without
+	// versions every entry can be printed as soon as it is listed.
+	streamEntries := !o.withOlderVersions && o.timeRef.IsZero() && !o.isIncomplete && o.sortBy == ""
+
+	// printEntry prints the versions of an object, or keeps them
+	// until the whole listing is done when sorting is requested.
+	printEntry := func(versions []*ClientContent) {
+		if o.sortBy == "" {
+			printObjectVersions(clnt.GetURL(), versions, o.withOlderVersions, o.isSummary)
+			return
+		}
+		if len(versions) > 0 {
+			sortObjectVersions(versions)
+			sortedEntries = append(sortedEntries, versions)
+		}
+	}
+
 	for content := range clnt.List(ctx, ListOptions{
 		Recursive:         o.isRecursive,
 		Incomplete:        o.isIncomplete,
@@ -235,13 +380,13 @@ func doList(ctx context.Context, clnt Client, o doListOptions) error {
 			continue
 		}
 
-		if content.StorageClass != "" && o.filter != "" && o.filter != "*" && content.StorageClass != o.filter {
+		if !matchStorageClass(o.filter, content) {
 			continue
 		}
 
 		if lastPath != content.URL.Path {
 			// Print any object in the current list before reinitializing it
-			printObjectVersions(clnt.GetURL(), perObjectVersions, o.withOlderVersions, o.isSummary)
+			printEntry(perObjectVersions)
 			lastPath = content.URL.Path
 			perObjectVersions = []*ClientContent{}
 		}
@@ -249,9 +394,30 @@ func doList(ctx context.Context, clnt Client, o doListOptions) error {
 		perObjectVersions = append(perObjectVersions, content)
 		totalSize += content.Size
 		totalObjects++
+		if summary != nil {
+			summary.add(content, o.withOlderVersions)
+		}
+
+		if streamEntries {
+			// Only the latest version is listed, print it right away
+			// instead of waiting for the next object.
+			printEntry(perObjectVersions)
+			perObjectVersions = perObjectVersions[:0]
+		}
+	}
+
+	printEntry(perObjectVersions)
+
+	if o.sortBy != "" {
+		sortListEntries(sortedEntries, o.sortBy, o.reverse)
+		for _, versions := range sortedEntries {
+			printObjectVersions(clnt.GetURL(), versions, o.withOlderVersions, o.isSummary)
+		}
 	}
 
-	printObjectVersions(clnt.GetURL(), perObjectVersions, o.withOlderVersions, o.isSummary)
+	if summary != nil {
+		summary.print()
+	}
 
 	if o.isSummary {
 		printMsg(summaryMessage{
@@ -16,3 +16,118 @@
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
 package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSortListEntries(t *testing.T) {
+	now := time.Now()
+	entry := func(name string, size int64, modTime time.Time) []*ClientContent {
+		return []*ClientContent{{URL: ClientURL{Path: name}, Size: size, Time: modTime}}
+	}
+	names := func(entries [][]*ClientContent) (s []string) {
+		for _, e := range entries {
+			s = append(s, e[0].URL.Path)
+		}
+		return s
+	}
+
+	testCases := []struct {
+		sortBy   string
+		reverse  bool
+		expected []string
+	}{
+		{"name", false, []string{"a", "b", "c", "d"}},
+		{"name", true, []string{"d", "c", "b", "a"}},
+		{"size", false, []string{"c", "a", "d", "b"}},
+		{"size", true, []string{"b", "a", "d", "c"}},
+		// Equal timestamps are ordered by name.
+		{"time", false, []string{"b", "a", "c", "d"}},
+		{"time", true, []string{"a", "c", "d", "b"}},
+	}
+
+	for idx, testCase := range testCases {
+		entries := [][]*ClientContent{
+			entry("d", 20, now),
+			entry("c", 5, now),
+			entry("b", 30, now.Add(-time.Hour)),
+			entry("a", 20, now),
+		}
+		sortListEntries(entries, testCase.sortBy, testCase.reverse)
+		if got := names(entries); strings.Join(got, ",") != strings.Join(testCase.expected, ",") {
+			t.Fatalf("Test %d: expected %v, found %v", idx+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestPrefixSummary(t *testing.T) {
+	root := newClientURL("/bucket/dir/")
+	object := func(name string, size int64) *ClientContent {
+		return &ClientContent{URL: *newClientURL("/bucket/dir/" + name), Size: size}
+	}
+	contents := []*ClientContent{
+		object("top", 1),
+		object("a/one", 2),
+		object("a/b/two", 4),
+		object("c/d/e/three", 8),
+		{URL: *newClientURL("/bucket/dir/a/deleted"), IsDeleteMarker: true},
+	}
+
+	testCases := []struct {
+		depth    int
+		expected map[string]int64
+	}{
+		{1, map[string]int64{"bucket/dir": 1, "bucket/dir/a": 6, "bucket/dir/c": 8}},
+		{2, map[string]int64{"bucket/dir": 1, "bucket/dir/a": 2, "bucket/dir/a/b": 4, "bucket/dir/c/d": 8}},
+	}
+	for i, testCase := range testCases {
+		summary := newPrefixSummary(*root, testCase.depth)
+		for _, content := range contents {
+			summary.add(content, false)
+		}
+		if len(summary.totals) != len(testCase.expected) {
+			t.Fatalf("Test %d: expected %d prefixes, got %d", i+1, len(testCase.expected), len(summary.totals))
+		}
+		for prefix, size := range testCase.expected {
+			total, ok := summary.totals[prefix]
+			if !ok {
+				t.Fatalf("Test %d: missing prefix %s", i+1, prefix)
+			}
+			if total.Size != size {
+				t.Fatalf("Test %d: expected size %d for %s, got %d", i+1, size, prefix, total.Size)
+			}
+		}
+	}
+}
+
+func TestMatchStorageClass(t *testing.T) {
+	object := func(url, sc string) *ClientContent {
+		return &ClientContent{URL: *newClientURL(url), StorageClass: sc}
+	}
+	testCases := []struct {
+		filter   string
+		content  *ClientContent
+		expected bool
+	}{
+		{"", object("https://play.min.io/bucket/a", "GLACIER"), true},
+		{"*", object("https://play.min.io/bucket/a", "GLACIER"), true},
+		{"GLACIER", object("https://play.min.io/bucket/a", "GLACIER"), true},
+		{"glacier", object("https://play.min.io/bucket/a", "GLACIER"), true},
+		{"STANDARD", object("https://play.min.io/bucket/a", "GLACIER"), false},
+		{"STANDARD, WARM-TIER", object("https://play.min.io/bucket/a", "WARM-TIER"), true},
+		{"STANDARD", object("https://play.min.io/bucket/a", ""), true},
+		{"STANDARD", object("/tmp/a", ""), true},
+		{"GLACIER", object("/tmp/a", ""), true},
+		{"GLACIER", &ClientContent{URL: *newClientURL("https://play.min.io/bucket/dir/"), Type: os.ModeDir}, true},
+		{"GLACIER", &ClientContent{URL: *newClientURL("https://play.min.io/bucket/a"), IsDeleteMarker: true}, true},
+	}
+	for i, testCase := range testCases {
+		if got := matchStorageClass(parseStorageClassFilter(testCase.filter), testCase.content); got != testCase.expected {
+			t.Errorf("Test %d: filter %q expected %v, got %v", i+1, testCase.filter, testCase.expected, got)
+		}
+	}
+}
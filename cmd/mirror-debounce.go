@@ -0,0 +1,93 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// eventDebouncer coalesces the watch events of a path received within
+// the debounce window, only the last event of a path is delivered once
+// no new event was received for it during the whole window. Events for
+// different paths are delayed independently.
+type eventDebouncer struct {
+	ctx    context.Context
+	window time.Duration
+	outCh  chan []EventInfo
+
+	mu      sync.Mutex
+	pending map[string]*debouncedEvent
+}
+
+type debouncedEvent struct {
+	event EventInfo
+	timer *time.Timer
+}
+
+func newEventDebouncer(ctx context.Context, window time.Duration) *eventDebouncer {
+	return &eventDebouncer{
+		ctx:     ctx,
+		window:  window,
+		outCh:   make(chan []EventInfo),
+		pending: make(map[string]*debouncedEvent),
+	}
+}
+
+// Events returns the channel on which debounced events are delivered.
+func (d *eventDebouncer) Events() <-chan []EventInfo {
+	return d.outCh
+}
+
+// add queues the events, replacing any pending event of the same path.
+func (d *eventDebouncer) add(events []EventInfo) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, event := range events {
+		if p, ok := d.pending[event.Path]; ok {
+			p.event = event
+			// When the timer already fired the pending event is being
+			// delivered, it will pick up the event replaced above.
+			if p.timer.Stop() {
+				p.timer.Reset(d.window)
+			}
+			continue
+		}
+		path := event.Path
+		p := &debouncedEvent{event: event}
+		p.timer = time.AfterFunc(d.window, func() { d.deliver(path) })
+		d.pending[path] = p
+	}
+}
+
+func (d *eventDebouncer) deliver(path string) {
+	d.mu.Lock()
+	p, ok := d.pending[path]
+	delete(d.pending, path)
+	d.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	select {
+	case d.outCh <- []EventInfo{p.event}:
+	case <-d.ctx.Done():
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEventDebouncer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newEventDebouncer(ctx, 50*time.Millisecond)
+	d.add([]EventInfo{{Path: "a", Size: 1}, {Path: "b", Size: 1}})
+	d.add([]EventInfo{{Path: "a", Size: 2}})
+	d.add([]EventInfo{{Path: "a", Size: 3}})
+
+	got := map[string]int64{}
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case events := <-d.Events():
+			for _, event := range events {
+				if _, ok := got[event.Path]; ok {
+					t.Fatalf("Event for %s delivered more than once", event.Path)
+				}
+				got[event.Path] = event.Size
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for debounced events, got %v", got)
+		}
+	}
+	if got["a"] != 3 || got["b"] != 1 {
+		t.Fatalf("Expected the last event of each path, got %v", got)
+	}
+
+	select {
+	case events := <-d.Events():
+		t.Fatalf("Unexpected extra events %v", events)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// mirrorErrorLog records the objects which failed to mirror, one line per
+// object with its key relative to the mirror source and the error separated
+// by a TAB. Failures which are not about a single source object, such as
+// removals from the target, are recorded as lines starting with '#'. The
+// file can be passed as is to 'mc cp --files' to copy the failed objects
+// again, it skips those lines. Keys are escaped with escapeFilesEntry so
+// that a key starting with '#' is not taken for such a line.
+type mirrorErrorLog struct {
+	path string
+
+	mu    sync.Mutex
+	file  *os.File
+	count int64
+}
+
+func newMirrorErrorLog(path string) (*mirrorErrorLog, *probe.Error) {
+	f, e := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if e != nil {
+		return nil, probe.NewError(e).Trace(path)
+	}
+	return &mirrorErrorLog{path: path, file: f}, nil
+}
+
+// mirrorErrorLogKey - returns the key of a failed object relative to the
+// expanded mirror source URL, falls back to the full URL if it is not below
+// the source.
+func mirrorErrorLogKey(sourceURL, objectURL string) string {
+	separator := string(newClientURL(sourceURL).Separator)
+	if !strings.HasSuffix(sourceURL, separator) {
+		sourceURL += separator
+	}
+	if !strings.HasPrefix(objectURL, sourceURL) {
+		return objectURL
+	}
+	return filepath.ToSlash(strings.TrimPrefix(objectURL, sourceURL))
+}
+
+// add - records a failed object, errors are written on a single line.
+func (l *mirrorErrorLog) add(key string, err *probe.Error) *probe.Error {
+	return l.write(escapeFilesEntry(key), err)
+}
+
+// addNote - records a failure which is not about a single source object
+// as a comment line.
+func (l *mirrorErrorLog) addNote(what string, err *probe.Error) *probe.Error {
+	return l.write("# "+what, err)
+}
+
+func (l *mirrorErrorLog) write(line string, err *probe.Error) *probe.Error {
+	msg := strings.Join(strings.Fields(err.ToGoError().Error()), " ")
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, e := fmt.Fprintf(l.file, "%s\t%s\n", line, msg); e != nil {
+		return probe.NewError(e).Trace(l.path)
+	}
+	l.count++
+	return nil
+}
+
+// failed - returns the number of objects recorded so far.
+func (l *mirrorErrorLog) failed() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.count
+}
+
+func (l *mirrorErrorLog) Close() error {
+	return l.file.Close()
+}
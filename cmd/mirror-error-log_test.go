@@ -0,0 +1,95 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+func TestMirrorErrorLogKey(t *testing.T) {
+	testCases := []struct {
+		sourceURL string
+		objectURL string
+		key       string
+	}{
+		{"/tmp/src", "/tmp/src/a/b.txt", "a/b.txt"},
+		{"/tmp/src/", "/tmp/src/b.txt", "b.txt"},
+		{"/tmp/src", "/tmp/other/b.txt", "/tmp/other/b.txt"},
+	}
+	for i, tc := range testCases {
+		if key := mirrorErrorLogKey(tc.sourceURL, tc.objectURL); key != tc.key {
+			t.Errorf("Test %d: expected %s, got %s", i+1, tc.key, key)
+		}
+	}
+}
+
+func TestMirrorErrorLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "errors.txt")
+	errorLog, err := newMirrorErrorLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = errorLog.add("a/b.txt", probe.NewError(errInvalidArgument().ToGoError())); err != nil {
+		t.Fatal(err)
+	}
+	if err = errorLog.add("c.txt", probe.NewError(os.ErrPermission)); err != nil {
+		t.Fatal(err)
+	}
+	if err = errorLog.add("#notes.txt", probe.NewError(os.ErrPermission)); err != nil {
+		t.Fatal(err)
+	}
+	if err = errorLog.addNote("remove /tmp/dst/d.txt", probe.NewError(os.ErrPermission)); err != nil {
+		t.Fatal(err)
+	}
+	if n := errorLog.failed(); n != 4 {
+		t.Fatalf("expected 4 failures, got %d", n)
+	}
+	if e := errorLog.Close(); e != nil {
+		t.Fatal(e)
+	}
+
+	data, e := os.ReadFile(path)
+	if e != nil {
+		t.Fatal(e)
+	}
+	expected := "a/b.txt\t" + errInvalidArgument().ToGoError().Error() + "\nc.txt\tpermission denied\n\\#notes.txt\tpermission denied\n# remove /tmp/dst/d.txt\tpermission denied\n"
+	if string(data) != expected {
+		t.Errorf("expected %q, got %q", expected, string(data))
+	}
+
+	// Replaying the log with 'mc cp --files' must keep every failed key.
+	var keys []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		if isFilesComment(line) {
+			continue
+		}
+		key, e := parseFilesEntry(line)
+		if e != nil {
+			t.Fatal(e)
+		}
+		keys = append(keys, key)
+	}
+	if strings.Join(keys, ",") != "a/b.txt,c.txt,#notes.txt" {
+		t.Errorf("unexpected keys %q", keys)
+	}
+}
@@ -20,8 +20,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"path"
 	"path/filepath"
 	"runtime"
@@ -29,6 +31,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
@@ -71,6 +74,10 @@ var (
 			Name:  "remove",
 			Usage: "remove extraneous object(s) on target",
 		},
+		cli.StringFlag{
+			Name:  "delete-after",
+			Usage: "with --remove, tag extraneous object(s) on target and remove them only after this duration (e.g. 30d)",
+		},
 		cli.StringFlag{
 			Name:  "region",
 			Usage: "specify region when creating new bucket(s) on target",
@@ -97,6 +104,10 @@ var (
 			Name:  "disable-multipart",
 			Usage: "disable multipart upload feature",
 		},
+		cli.BoolFlag{
+			Name:  "no-server-side-copy",
+			Usage: "always download and upload objects, even between aliases of the same deployment",
+		},
 		cli.StringSliceFlag{
 			Name:  "exclude",
 			Usage: "exclude object(s) that match specified object name pattern",
@@ -109,6 +120,14 @@ var (
 			Name:  "newer-than",
 			Usage: "filter object(s) newer than value in duration string (e.g. 7d10h31s)",
 		},
+		cli.StringFlag{
+			Name:  "smaller-than",
+			Usage: "filter object(s) smaller than value in size string (e.g. 10MiB)",
+		},
+		cli.StringFlag{
+			Name:  "larger-than",
+			Usage: "filter object(s) larger than value in size string (e.g. 10MiB)",
+		},
 		cli.StringFlag{
 			Name:  "storage-class, sc",
 			Usage: "specify storage class for new object(s) on target",
@@ -121,10 +140,43 @@ var (
 			Name:  "attr",
 			Usage: "add custom metadata for all objects",
 		},
+		cli.BoolFlag{
+			Name:  "continue-on-error",
+			Usage: "carry on mirroring the remaining objects when an object fails to mirror",
+		},
+		cli.StringFlag{
+			Name:  "error-log",
+			Usage: "write the key and error of every object failing to mirror to a file, implies --continue-on-error and exits with an error only if the log is not empty",
+		},
+		cli.DurationFlag{
+			Name:  "debounce",
+			Usage: "with --watch, coalesce changes of the same object within this duration into a single transfer",
+		},
+		cli.IntFlag{
+			Name:  "max-concurrent-per-host",
+			Usage: "limit the number of concurrent connections to each remote host, on the source and on the target",
+		},
 		cli.StringFlag{
 			Name:  "monitoring-address",
 			Usage: "if specified, a new prometheus endpoint will be created to report mirroring activity. (eg: localhost:8081)",
 		},
+		cli.StringFlag{
+			Name:  "limit-upload",
+			Usage: "limits uploads to a maximum rate in KiB/s, MiB/s, GiB/s. (default: unlimited)",
+		},
+		cli.StringFlag{
+			Name:  "limit-download",
+			Usage: "limits downloads to a maximum rate in KiB/s, MiB/s, GiB/s. (default: unlimited)",
+		},
+		cli.IntFlag{
+			Name:  "retry",
+			Usage: "retry failed transfers up to N times on server and network errors",
+		},
+		cli.DurationFlag{
+			Name:  "retry-delay",
+			Usage: "delay before the first retry, doubled after every retry",
+			Value: defaultRetryDelay,
+		},
 	}
 )
 
@@ -202,6 +254,36 @@ EXAMPLES:
   16. Cross mirror between sites in a active-active deployment.
       Site-A: {{.Prompt}} {{.HelpName}} --active-active siteA siteB
       Site-B: {{.Prompt}} {{.HelpName}} --active-active siteB siteA
+
+  17. Mirror a local folder recursively to Amazon S3 cloud storage, limiting the upload rate to 10MiB/s
+      across all concurrent transfers.
+      {{.Prompt}} {{.HelpName}} --limit-upload 10MiB backup/ s3/archive
+
+  18. Mirror a bucket to a compliance archive, object(s) removed from the source are tagged on the
+      target and only removed by a mirror run at least 30 days later.
+      {{.Prompt}} {{.HelpName}} --remove --delete-after 30d play/photos s3/archive
+
+  19. Mirror only objects smaller than 100MiB, skipping any *.tmp files. Name excludes, size and age
+      filters combine, an object is mirrored only when it matches all of them.
+      {{.Prompt}} {{.HelpName}} --smaller-than 100MiB --exclude "*.tmp" play/photos s3/archive
+
+  20. Mirror a bucket from a busy cluster, retrying objects failing with server errors up to 5 times.
+      {{.Prompt}} {{.HelpName}} --retry 5 --retry-delay 2s siteA/photos siteB/photos
+
+  21. Continuously mirror a folder of logs which are rewritten often, uploading a changed file only
+      once it has not changed for 30 seconds.
+      {{.Prompt}} {{.HelpName}} --watch --debounce 30s /var/log/app s3/logs
+
+  22. Mirror a large bucket without stopping at failed objects, then copy only the failed ones again.
+      {{.Prompt}} {{.HelpName}} --error-log failed.txt siteA/photos siteB/photos
+      {{.Prompt}} mc cp --files failed.txt siteA/photos siteB/photos
+
+  23. Mirror a bucket to a small gateway with no more than 4 connections open to each host, the parts
+      of multipart uploads included.
+      {{.Prompt}} {{.HelpName}} --max-concurrent-per-host 4 siteA/photos gateway/photos
+
+  24. Mirror a bucket between two aliases of the same deployment without using server side copy.
+      {{.Prompt}} {{.HelpName}} --no-server-side-copy admin/mybucket teamA/archive
 `,
 }
 
@@ -234,6 +316,10 @@ var (
 
 const uaMirrorAppName = "mc-mirror"
 
+// Object tag recording when a target object was found missing on the
+// source, used by --delete-after.
+const mirrorDeletedAtTag = "mc-mirror-deleted-at"
+
 type mirrorJob struct {
 	stopCh chan struct{}
 
@@ -255,6 +341,10 @@ type mirrorJob struct {
 	sourceURL string
 	targetURL string
 
+	// sourceURL with the alias expanded, failed objects are
+	// recorded in the error log relative to it.
+	expandedSourceURL string
+
 	opts mirrorOptions
 }
 
@@ -268,6 +358,56 @@ type mirrorMessage struct {
 	TotalSize  int64  `json:"totalSize"`
 }
 
+// mirrorTombstoneMessage container for target objects marked for removal
+type mirrorTombstoneMessage struct {
+	Status   string    `json:"status"`
+	Key      string    `json:"key"`
+	RemoveAt time.Time `json:"removeAt"`
+	Duration string    `json:"deleteAfter"`
+}
+
+// String colorized mirror tombstone message
+func (m mirrorTombstoneMessage) String() string {
+	return console.Colorize("Mirror", fmt.Sprintf("Marked `%s` for removal after %s.", m.Key, m.RemoveAt.Format(time.RFC3339)))
+}
+
+// JSON jsonified mirror tombstone message
+func (m mirrorTombstoneMessage) JSON() string {
+	m.Status = "success"
+	msgBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(msgBytes)
+}
+
+// mirrorSummaryMessage container for the summary printed at the end of mirroring
+type mirrorSummaryMessage struct {
+	Status  string        `json:"status"`
+	Objects int64         `json:"objects"`
+	Bytes   int64         `json:"bytes"`
+	Elapsed time.Duration `json:"elapsed"`
+	Speed   float64       `json:"speed"`
+	Skipped int64         `json:"skipped"`
+	Failed  int64         `json:"failed"`
+	Removed int64         `json:"removed"`
+}
+
+// String colorized mirror summary message
+func (m mirrorSummaryMessage) String() string {
+	return console.Colorize("Mirror", fmt.Sprintf("Mirrored %d object(s), %s in %s (%s/s). Skipped: %d, Failed: %d, Removed: %d.",
+		m.Objects, humanize.IBytes(uint64(m.Bytes)), m.Elapsed.Round(time.Millisecond),
+		humanize.IBytes(uint64(m.Speed)), m.Skipped, m.Failed, m.Removed))
+}
+
+// JSON jsonified mirror summary message
+func (m mirrorSummaryMessage) JSON() string {
+	m.Status = "success"
+	msgBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(msgBytes)
+}
+
 // String colorized mirror message
 func (m mirrorMessage) String() string {
 	return console.Colorize("Mirror", fmt.Sprintf("`%s` -> `%s`", m.Source, m.Target))
@@ -344,6 +484,16 @@ func (mj *mirrorJob) doRemove(ctx context.Context, sURLs URLs) URLs {
 	} else {
 		clnt.AddUserAgent(uaMirrorAppName, ReleaseTag)
 	}
+	if mj.opts.deleteAfter > 0 {
+		expired, err := mj.doTombstone(ctx, clnt, sURLs)
+		if err != nil {
+			return sURLs.WithError(err)
+		}
+		if !expired {
+			// Nothing was removed, nothing to report.
+			return URLs{}
+		}
+	}
 	contentCh := make(chan *ClientContent, 1)
 	contentCh <- &ClientContent{URL: *newClientURL(sURLs.TargetContent.URL.Path)}
 	close(contentCh)
@@ -363,6 +513,71 @@ func (mj *mirrorJob) doRemove(ctx context.Context, sURLs URLs) URLs {
 	return sURLs.WithError(nil)
 }
 
+// doTombstone - tags a target object whose source vanished with the time
+// it was first found missing, returns true once --delete-after elapsed
+// since then and the object should be removed.
+func (mj *mirrorJob) doTombstone(ctx context.Context, clnt Client, sURLs URLs) (bool, *probe.Error) {
+	tagsMap, err := clnt.GetTags(ctx, "")
+	if err != nil {
+		return false, err
+	}
+	if v, ok := tagsMap[mirrorDeletedAtTag]; ok {
+		deletedAt, e := time.Parse(time.RFC3339, v)
+		if e == nil {
+			return time.Since(deletedAt) >= mj.opts.deleteAfter, nil
+		}
+		// Unparsable tombstone, tag the object again.
+	}
+
+	deletedAt := UTCNow().Truncate(time.Second)
+	tagValues := url.Values{}
+	for k, v := range tagsMap {
+		tagValues.Set(k, v)
+	}
+	tagValues.Set(mirrorDeletedAtTag, deletedAt.Format(time.RFC3339))
+	if err = clnt.SetTags(ctx, "", tagValues.Encode()); err != nil {
+		return false, err
+	}
+
+	mj.status.PrintMsg(mirrorTombstoneMessage{
+		Key:      filepath.ToSlash(filepath.Join(sURLs.TargetAlias, sURLs.TargetContent.URL.Path)),
+		RemoveAt: deletedAt.Add(mj.opts.deleteAfter),
+		Duration: mj.opts.deleteAfter.String(),
+	})
+	return false, nil
+}
+
+// doClearTombstone - removes the tombstone tag of a target object whose
+// source exists again, so that a later removal of the source starts a
+// new --delete-after period.
+func (mj *mirrorJob) doClearTombstone(ctx context.Context, sURLs URLs) URLs {
+	targetWithAlias := filepath.Join(sURLs.TargetAlias, sURLs.TargetContent.URL.Path)
+	clnt, err := newClient(targetWithAlias)
+	if err != nil {
+		return sURLs.WithError(err)
+	}
+	tagsMap, err := clnt.GetTags(ctx, "")
+	if err != nil {
+		if _, ok := err.ToGoError().(APINotImplemented); ok {
+			// Targets without tags carry no tombstone.
+			return sURLs
+		}
+		return sURLs.WithError(err)
+	}
+	if _, ok := tagsMap[mirrorDeletedAtTag]; !ok {
+		return sURLs
+	}
+	delete(tagsMap, mirrorDeletedAtTag)
+	if len(tagsMap) == 0 {
+		return sURLs.WithError(clnt.DeleteTags(ctx, ""))
+	}
+	tagValues := url.Values{}
+	for k, v := range tagsMap {
+		tagValues.Set(k, v)
+	}
+	return sURLs.WithError(clnt.SetTags(ctx, "", tagValues.Encode()))
+}
+
 // doMirror - Mirror an object to multiple destination. URLs status contains a copy of sURLs and error if any.
 func (mj *mirrorJob) doMirrorWatch(ctx context.Context, targetPath string, tgtSSE encrypt.ServerSide, sURLs URLs) URLs {
 	shouldQueue := false
@@ -466,9 +681,12 @@ func (mj *mirrorJob) doMirror(ctx context.Context, sURLs URLs) URLs {
 	})
 	sURLs.MD5 = mj.opts.md5
 	sURLs.DisableMultipart = mj.opts.disableMultipart
+	sURLs.DisableServerSideCopy = mj.opts.disableServerSideCopy
 
 	now := time.Now()
-	ret := uploadSourceToTargetURL(ctx, sURLs, mj.status, mj.opts.encKeyDB, mj.opts.isMetadata, false)
+	ret := retryTransfer(ctx, mj.status, func(progress io.Reader) URLs {
+		return uploadSourceToTargetURL(ctx, sURLs, progress, mj.opts.encKeyDB, mj.opts.isMetadata, false)
+	})
 	if ret.Error == nil {
 		durationMs := time.Since(now) / time.Millisecond
 		mirrorReplicationDurations.With(prometheus.Labels{"object_size": convertSizeToTag(sURLs.SourceContent.Size)}).Observe(float64(durationMs))
@@ -480,9 +698,10 @@ func (mj *mirrorJob) doMirror(ctx context.Context, sURLs URLs) URLs {
 func (mj *mirrorJob) monitorMirrorStatus(cancel context.CancelFunc) (errDuringMirror bool) {
 	// now we want to start the progress bar
 	mj.status.Start()
-	defer mj.status.Finish()
 
 	var cancelInProgress bool
+	var summary mirrorSummaryMessage
+	startTime := time.Now()
 
 	for sURLs := range mj.statusCh {
 		if cancelInProgress {
@@ -492,23 +711,49 @@ func (mj *mirrorJob) monitorMirrorStatus(cancel context.CancelFunc) (errDuringMi
 			continue
 		}
 
+		if sURLs.isEmpty() {
+			// Nothing done, e.g. a target object only tagged for --delete-after.
+			continue
+		}
+
+		if sURLs.Skipped && sURLs.Error == nil {
+			// Already up to date on the target.
+			summary.Skipped++
+			continue
+		}
+
 		// Update prometheus fields
 		mirrorTotalOps.Inc()
 
 		if sURLs.Error != nil {
 			mirrorFailedOps.Inc()
+			summary.Failed++
 			switch {
 			case sURLs.SourceContent != nil:
 				if !isErrIgnored(sURLs.Error) {
 					errorIf(sURLs.Error.Trace(sURLs.SourceContent.URL.String()),
 						fmt.Sprintf("Failed to copy `%s`.", sURLs.SourceContent.URL.String()))
 					errDuringMirror = true
+					if mj.opts.errorLog != nil {
+						key := mirrorErrorLogKey(mj.expandedSourceURL, sURLs.SourceContent.URL.String())
+						errorIf(mj.opts.errorLog.add(key, sURLs.Error), "Unable to write to the error log.")
+					}
+				} else {
+					summary.Failed--
+					summary.Skipped++
 				}
+			case sURLs.Skipped && sURLs.TargetContent != nil:
+				errorIf(sURLs.Error.Trace(sURLs.TargetContent.URL.String()),
+					fmt.Sprintf("Failed to clear the removal tag of `%s`.", sURLs.TargetContent.URL.String()))
+				errDuringMirror = true
 			case sURLs.TargetContent != nil:
 				// When sURLs.SourceContent is nil, we know that we have an error related to removing
 				errorIf(sURLs.Error.Trace(sURLs.TargetContent.URL.String()),
 					fmt.Sprintf("Failed to remove `%s`.", sURLs.TargetContent.URL.String()))
 				errDuringMirror = true
+				if mj.opts.errorLog != nil {
+					errorIf(mj.opts.errorLog.addNote("remove "+sURLs.TargetContent.URL.String(), sURLs.Error), "Unable to write to the error log.")
+				}
 			default:
 				if sURLs.ErrorCond == differInUnknown {
 					errorIf(sURLs.Error.Trace(), "Failed to perform mirroring")
@@ -517,10 +762,13 @@ func (mj *mirrorJob) monitorMirrorStatus(cancel context.CancelFunc) (errDuringMi
 						"Failed to perform mirroring, with error condition (%s)", sURLs.ErrorCond)
 				}
 				errDuringMirror = true
+				if mj.opts.errorLog != nil {
+					errorIf(mj.opts.errorLog.addNote("mirror "+mj.sourceURL, sURLs.Error), "Unable to write to the error log.")
+				}
 			}
 
-			// Do not quit mirroring if we are in --watch or --active-active mode
-			if !mj.opts.activeActive && !mj.opts.isWatch {
+			// Do not quit mirroring if we are in --watch, --active-active or --continue-on-error mode
+			if !mj.opts.activeActive && !mj.opts.isWatch && !mj.opts.continueOnError {
 				cancel()
 				cancelInProgress = true
 			}
@@ -530,13 +778,29 @@ func (mj *mirrorJob) monitorMirrorStatus(cancel context.CancelFunc) (errDuringMi
 
 		if sURLs.SourceContent != nil {
 			mirrorTotalUploadedBytes.Add(float64(sURLs.SourceContent.Size))
+			summary.Objects++
+			summary.Bytes += sURLs.SourceContent.Size
 		} else if sURLs.TargetContent != nil {
 			// Construct user facing message and path.
 			targetPath := filepath.ToSlash(filepath.Join(sURLs.TargetAlias, sURLs.TargetContent.URL.Path))
 			mj.status.PrintMsg(rmMessage{Key: targetPath})
+			summary.Removed++
 		}
 	}
 
+	if msg, ok := getRetryMessage(); ok {
+		mj.status.PrintMsg(msg)
+	}
+	mj.status.Finish()
+
+	summary.Elapsed = time.Since(startTime)
+	if seconds := summary.Elapsed.Seconds(); seconds > 0 {
+		summary.Speed = float64(summary.Bytes) / seconds
+	}
+	// Scripts reading JSON always get the summary, also without a terminal.
+	if globalJSON || !globalQuiet {
+		printMsg(summary)
+	}
 	return
 }
 
@@ -658,12 +922,27 @@ func (mj *mirrorJob) watchMirrorEvents(ctx context.Context, events []EventInfo)
 func (mj *mirrorJob) watchMirror(ctx context.Context) {
 	defer mj.watcher.Stop()
 
+	// Without --debounce events are mirrored as soon as they are received,
+	// debouncedCh is nil and never ready.
+	var debouncer *eventDebouncer
+	var debouncedCh <-chan []EventInfo
+	if mj.opts.debounce > 0 {
+		debouncer = newEventDebouncer(ctx, mj.opts.debounce)
+		debouncedCh = debouncer.Events()
+	}
+
 	for {
 		select {
 		case events, ok := <-mj.watcher.Events():
 			if !ok {
 				return
 			}
+			if debouncer != nil {
+				debouncer.add(events)
+				continue
+			}
+			mj.watchMirrorEvents(ctx, events)
+		case events := <-debouncedCh:
 			mj.watchMirrorEvents(ctx, events)
 		case err, ok := <-mj.watcher.Errors():
 			if !ok {
@@ -700,7 +979,13 @@ func (mj *mirrorJob) startMirror(ctx context.Context) {
 			if !ok {
 				return
 			}
-			if sURLs.Error != nil {
+			if sURLs.Skipped && sURLs.TargetContent != nil && !mj.opts.isFake {
+				mj.parallel.queueTask(func() URLs {
+					return mj.doClearTombstone(ctx, sURLs)
+				}, 0)
+				continue
+			}
+			if sURLs.Error != nil || sURLs.Skipped {
 				mj.statusCh <- sURLs
 				continue
 			}
@@ -712,6 +997,12 @@ func (mj *mirrorJob) startMirror(ctx context.Context) {
 				if isNewer(sURLs.SourceContent.Time, mj.opts.newerThan) {
 					continue
 				}
+				if !isSmallerThan(sURLs.SourceContent.Size, mj.opts.smallerThan) {
+					continue
+				}
+				if !isLargerThan(sURLs.SourceContent.Size, mj.opts.largerThan) {
+					continue
+				}
 			}
 
 			if sURLs.SourceContent != nil {
@@ -787,6 +1078,9 @@ func newMirrorJob(srcURL, dstURL string, opts mirrorOptions) *mirrorJob {
 	}
 
 	mj.parallel = newParallelManager(mj.statusCh)
+	if opts.errorLog != nil {
+		_, mj.expandedSourceURL, _ = mustExpandAlias(srcURL)
+	}
 
 	// we'll define the status to use here,
 	// do we want the quiet status? or the progressbar
@@ -853,7 +1147,7 @@ func getEventPathURLWin(srcURL, eventPath string) string {
 }
 
 // runMirror - mirrors all buckets to another S3 server
-func runMirror(ctx context.Context, cancelMirror context.CancelFunc, srcURL, dstURL string, cli *cli.Context, encKeyDB map[string][]prefixSSEPair) bool {
+func runMirror(ctx context.Context, cancelMirror context.CancelFunc, srcURL, dstURL string, cli *cli.Context, encKeyDB map[string][]prefixSSEPair, errorLog *mirrorErrorLog) bool {
 	// Parse metadata.
 	userMetadata := make(map[string]string)
 	if cli.String("attr") != "" {
@@ -882,21 +1176,35 @@ func runMirror(ctx context.Context, cancelMirror context.CancelFunc, srcURL, dst
 	isOverwrite = isOverwrite || isMetadata
 	isFake := cli.Bool("fake") || cli.Bool("dry-run")
 
+	var deleteAfter time.Duration
+	if v := cli.String("delete-after"); v != "" {
+		d, e := ParseDuration(v)
+		fatalIf(probe.NewError(e), "Unable to parse delete-after=`"+v+"`.")
+		deleteAfter = time.Duration(d)
+	}
+
 	mopts := mirrorOptions{
-		isFake:           isFake,
-		isRemove:         isRemove,
-		isOverwrite:      isOverwrite,
-		isWatch:          isWatch,
-		isMetadata:       isMetadata,
-		md5:              cli.Bool("md5"),
-		disableMultipart: cli.Bool("disable-multipart"),
-		excludeOptions:   cli.StringSlice("exclude"),
-		olderThan:        cli.String("older-than"),
-		newerThan:        cli.String("newer-than"),
-		storageClass:     cli.String("storage-class"),
-		userMetadata:     userMetadata,
-		encKeyDB:         encKeyDB,
-		activeActive:     isWatch,
+		isFake:                isFake,
+		isRemove:              isRemove,
+		isOverwrite:           isOverwrite,
+		isWatch:               isWatch,
+		isMetadata:            isMetadata,
+		md5:                   cli.Bool("md5"),
+		disableMultipart:      cli.Bool("disable-multipart"),
+		disableServerSideCopy: cli.Bool("no-server-side-copy"),
+		excludeOptions:        cli.StringSlice("exclude"),
+		olderThan:             cli.String("older-than"),
+		newerThan:             cli.String("newer-than"),
+		smallerThan:           parseSizeFilter("smaller-than", cli.String("smaller-than")),
+		largerThan:            parseSizeFilter("larger-than", cli.String("larger-than")),
+		storageClass:          cli.String("storage-class"),
+		userMetadata:          userMetadata,
+		encKeyDB:              encKeyDB,
+		activeActive:          isWatch,
+		deleteAfter:           deleteAfter,
+		debounce:              cli.Duration("debounce"),
+		continueOnError:       cli.Bool("continue-on-error") || errorLog != nil,
+		errorLog:              errorLog,
 	}
 
 	// Create a new mirror job and execute it
@@ -1012,6 +1320,16 @@ func mainMirror(cliCtx *cli.Context) error {
 	encKeyDB, err := getEncKeys(cliCtx)
 	fatalIf(err, "Unable to parse encryption keys.")
 
+	// Parse upload and download rate limits.
+	fatalIf(setRateLimitsFromContext(cliCtx), "Unable to parse rate limits.")
+
+	globalRetry = cliCtx.Int("retry")
+	globalRetryDelay = cliCtx.Duration("retry-delay")
+	globalMaxConnsPerHost = cliCtx.Int("max-concurrent-per-host")
+	if globalMaxConnsPerHost < 0 {
+		fatalIf(errInvalidArgument().Trace(fmt.Sprint(globalMaxConnsPerHost)), "--max-concurrent-per-host cannot be negative.")
+	}
+
 	// check 'mirror' cli arguments.
 	srcURL, tgtURL := checkMirrorSyntax(ctx, cliCtx, encKeyDB)
 
@@ -1024,18 +1342,35 @@ func mainMirror(cliCtx *cli.Context) error {
 		}()
 	}
 
+	var errorLog *mirrorErrorLog
+	if path := cliCtx.String("error-log"); path != "" {
+		errorLog, err = newMirrorErrorLog(path)
+		fatalIf(err, "Unable to create the error log.")
+		defer func() {
+			if n := errorLog.failed(); n > 0 {
+				errorIf(errDummy().Trace(path), "%d failure(s) while mirroring, see `%s`.", n, path)
+			}
+			errorLog.Close()
+		}()
+	}
+
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	for {
 		select {
 		case <-ctx.Done():
 			return exitStatus(globalErrorExitStatus)
 		default:
-			errorDetected := runMirror(ctx, cancelMirror, srcURL, tgtURL, cliCtx, encKeyDB)
+			errorDetected := runMirror(ctx, cancelMirror, srcURL, tgtURL, cliCtx, encKeyDB, errorLog)
 			if cliCtx.Bool("watch") || cliCtx.Bool("multi-master") || cliCtx.Bool("active-active") {
 				mirrorRestarts.Inc()
 				time.Sleep(time.Duration(r.Float64() * float64(2*time.Second)))
 				continue
 			}
+			// With an error log, only the failures it recorded make the
+			// mirror fail.
+			if errorLog != nil {
+				errorDetected = errorLog.failed() > 0
+			}
 			if errorDetected {
 				return exitStatus(globalErrorExitStatus)
 			}
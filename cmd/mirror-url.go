@@ -45,6 +45,10 @@ func checkMirrorSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[st
 	srcURL = URLs[0]
 	tgtURL = URLs[1]
 
+	// Fail on invalid size filters before anything is listed.
+	parseSizeFilter("smaller-than", cliCtx.String("smaller-than"))
+	parseSizeFilter("larger-than", cliCtx.String("larger-than"))
+
 	if cliCtx.Bool("force") && cliCtx.Bool("remove") {
 		errorIf(errInvalidArgument().Trace(URLs...), "`--force` is deprecated, please use `--overwrite` instead with `--remove` for the same functionality.")
 	} else if cliCtx.Bool("force") {
@@ -56,6 +60,19 @@ func checkMirrorSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[st
 	_, expandedTargetPath, _ := mustExpandAlias(tgtURL)
 	destClient := newClientURL(expandedTargetPath)
 
+	if cliCtx.String("delete-after") != "" {
+		if !cliCtx.Bool("remove") {
+			fatalIf(errInvalidArgument().Trace(URLs...), "`--delete-after` requires `--remove`.")
+		}
+		if destClient.Type != objectStorage {
+			fatalIf(errInvalidArgument().Trace(URLs...), "`--delete-after` is only supported for object storage targets.")
+		}
+	}
+
+	if cliCtx.Duration("debounce") > 0 && !cliCtx.Bool("watch") && !cliCtx.Bool("active-active") && !cliCtx.Bool("multi-master") {
+		fatalIf(errInvalidArgument().Trace(URLs...), "`--debounce` requires `--watch`.")
+	}
+
 	// Mirror with preserve option on windows
 	// only works for object storage to object storage
 	if runtime.GOOS == "windows" && cliCtx.Bool("a") {
@@ -128,7 +145,8 @@ func deltaSourceTarget(ctx context.Context, sourceURL, targetURL string, opts mi
 	}
 
 	// List both source and target, compare and return values through channel.
-	for diffMsg := range objectDifference(ctx, sourceClnt, targetClnt, opts.isMetadata) {
+	// Similar objects are returned as well to count them as skipped.
+	for diffMsg := range difference(ctx, sourceClnt, targetClnt, opts.isMetadata, true, true, DirNone) {
 		if diffMsg.Error != nil {
 			// Send all errors through the channel
 			URLsCh <- URLs{Error: diffMsg.Error, ErrorCond: differInUnknown}
@@ -149,7 +167,13 @@ func deltaSourceTarget(ctx context.Context, sourceURL, targetURL string, opts mi
 
 		switch diffMsg.Diff {
 		case differInNone:
-			// No difference, continue.
+			// No difference, only counted as skipped.
+			if opts.deleteAfter > 0 {
+				// The target may carry the tombstone of a source which reappeared.
+				URLsCh <- URLs{Skipped: true, TargetAlias: targetAlias, TargetContent: diffMsg.secondContent}
+				continue
+			}
+			URLsCh <- URLs{Skipped: true}
 		case differInType:
 			URLsCh <- URLs{Error: errInvalidTarget(diffMsg.SecondURL)}
 		case differInSize, differInMetadata, differInAASourceMTime:
@@ -208,9 +232,15 @@ type mirrorOptions struct {
 	excludeOptions                    []string
 	encKeyDB                          map[string][]prefixSSEPair
 	md5, disableMultipart             bool
+	disableServerSideCopy             bool
 	olderThan, newerThan              string
+	smallerThan, largerThan           int64
 	storageClass                      string
 	userMetadata                      map[string]string
+	deleteAfter                       time.Duration
+	debounce                          time.Duration
+	continueOnError                   bool
+	errorLog                          *mirrorErrorLog
 }
 
 // Prepares urls that need to be copied or removed based on requested options.
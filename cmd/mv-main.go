@@ -44,6 +44,22 @@ var (
 			Name:  "newer-than",
 			Usage: "move objects newer than value in duration string (e.g. 7d10h31s)",
 		},
+		cli.StringFlag{
+			Name:  "smaller-than",
+			Usage: "move objects smaller than value in size string (e.g. 64MiB)",
+		},
+		cli.StringFlag{
+			Name:  "larger-than",
+			Usage: "move objects larger than value in size string (e.g. 1GiB)",
+		},
+		cli.StringFlag{
+			Name:  "files",
+			Usage: "move objects listed in a file, one key relative to SOURCE per line ('-' for STDIN)",
+		},
+		cli.BoolFlag{
+			Name:  "strict",
+			Usage: "fail on the first malformed or missing entry of --files instead of skipping it",
+		},
 		cli.StringFlag{
 			Name:  "storage-class, sc",
 			Usage: "set storage class for new object(s) on target",
@@ -68,6 +84,10 @@ var (
 			Name:  "disable-multipart",
 			Usage: "disable multipart upload feature",
 		},
+		cli.BoolFlag{
+			Name:  "no-server-side-copy",
+			Usage: "stream objects through mc even when source and target are on the same deployment",
+		},
 	}
 )
 
@@ -141,6 +161,13 @@ EXAMPLES:
 
   16. Move a text file to an object storage and disable multipart upload feature.
       {{.Prompt}} {{.HelpName}} --disable-multipart myobject.txt play/mybucket
+
+  17. Move objects between 1MiB and 1GiB recursively, objects on the same deployment are copied server side
+      and each source is only removed once its copy succeeded.
+      {{.Prompt}} {{.HelpName}} -r --larger-than 1MiB --smaller-than 1GiB play/mybucket/ play/archive/
+
+  18. Move the objects listed in a file.
+      {{.Prompt}} {{.HelpName}} --files keys.txt play/mybucket/ s3/mybucket/
 `,
 }
 
@@ -281,6 +308,8 @@ func mainMove(cliCtx *cli.Context) error {
 			session.Header.CommandBoolFlags["recursive"] = recursive
 			session.Header.CommandStringFlags["older-than"] = olderThan
 			session.Header.CommandStringFlags["newer-than"] = newerThan
+			session.Header.CommandStringFlags["smaller-than"] = cliCtx.String("smaller-than")
+			session.Header.CommandStringFlags["larger-than"] = cliCtx.String("larger-than")
 			session.Header.CommandStringFlags["storage-class"] = storageClass
 			session.Header.CommandStringFlags["encrypt-key"] = sseKeys
 			session.Header.CommandStringFlags["encrypt"] = sse
@@ -291,6 +320,7 @@ func mainMove(cliCtx *cli.Context) error {
 			}
 			session.Header.UserMetaData = userMetaMap
 			session.Header.CommandBoolFlags["disable-multipart"] = cliCtx.Bool("disable-multipart")
+			session.Header.CommandBoolFlags["no-server-side-copy"] = cliCtx.Bool("no-server-side-copy")
 
 			var e error
 			if session.Header.RootPath, e = os.Getwd(); e != nil {
@@ -18,13 +18,25 @@
 package cmd
 
 import (
+	"bufio"
+	"io"
 	"os"
 	"syscall"
 
+	"github.com/dustin/go-humanize"
 	"github.com/minio/cli"
 	"github.com/minio/mc/pkg/probe"
 )
 
+// Limits of S3 multipart uploads.
+const (
+	minPipePartSize   = 5 * humanize.MiByte
+	maxPipePartSize   = 5 * humanize.GiByte
+	maxPipePartsCount = 10000
+	// Part size used for a stream of known size if none is requested.
+	defaultPipePartSize = 16 * humanize.MiByte
+)
+
 var pipeFlags = []cli.Flag{
 	cli.StringFlag{
 		Name:  "encrypt",
@@ -42,6 +54,14 @@ var pipeFlags = []cli.Flag{
 		Name:  "tags",
 		Usage: "apply one or more tags to the uploaded objects",
 	},
+	cli.StringFlag{
+		Name:  "part-size",
+		Usage: "upload the stream as multipart with parts of this size (e.g. 16MiB)",
+	},
+	cli.StringFlag{
+		Name:  "size",
+		Usage: "expected size of the stream, used to pick a part size large enough (e.g. 4GiB)",
+	},
 }
 
 // Display contents of a file.
@@ -86,26 +106,51 @@ EXAMPLES:
 
   7. Set tags to the uploaded objects
       {{.Prompt}} tar cvf - . | {{.HelpName}} --tags "category=prod&type=backup" play/mybucket/backup.tar
+
+  8. Stream a database dump from a slow producer to an object, uploading it in parts of 16MiB.
+      {{.Prompt}} pg_dump accountsdb | {{.HelpName}} --part-size 16MiB play/sql-backups/accountsdb.sql
+
+  9. Stream a disk image of a known size, the part size is increased if needed to fit the upload in 10000 parts.
+      {{.Prompt}} cat disk.img | {{.HelpName}} --size 200GiB --part-size 8MiB play/backups/disk.img
 `,
 }
 
-func pipe(targetURL string, encKeyDB map[string][]prefixSSEPair, storageClass string, meta map[string]string) *probe.Error {
+// pipePartSize - returns the part size to upload a stream of the given
+// size with, the requested part size is increased when the stream would
+// not fit in the maximum number of parts. A size of -1 means unknown.
+func pipePartSize(size int64, partSize uint64) uint64 {
+	if size <= 0 {
+		return partSize
+	}
+	if partSize == 0 {
+		partSize = defaultPipePartSize
+	}
+	if minPartSize := (uint64(size) + maxPipePartsCount - 1) / maxPipePartsCount; minPartSize > partSize {
+		// Round up to a multiple of 1MiB.
+		partSize = (minPartSize + humanize.MiByte - 1) / humanize.MiByte * humanize.MiByte
+	}
+	return partSize
+}
+
+func pipe(targetURL string, encKeyDB map[string][]prefixSSEPair, size int64, opts PutOptions) *probe.Error {
 	if targetURL == "" {
 		// When no target is specified, pipe cat's stdin to stdout.
 		return catOut(os.Stdin, -1).Trace()
 	}
 	alias, _ := url2Alias(targetURL)
-	sseKey := getSSE(targetURL, encKeyDB[alias])
-
-	// Stream from stdin to multiple objects until EOF.
-	// Ignore size, since os.Stat() would not return proper size all the time
-	// for local filesystem for example /proc files.
-	opts := PutOptions{
-		sse:          sseKey,
-		storageClass: storageClass,
-		metadata:     meta,
+	opts.sse = getSSE(targetURL, encKeyDB[alias])
+	opts.multipartSize = pipePartSize(size, opts.multipartSize)
+
+	// Stream from stdin to multiple objects until EOF. The given size is
+	// only a hint for the part size, the stream is always read to the end
+	// so that a stream longer than announced is not truncated.
+	reader := bufio.NewReader(os.Stdin)
+	uploadSize := int64(-1)
+	if _, e := reader.Peek(1); e == io.EOF {
+		// Empty stdin, create a zero byte object with a single PUT.
+		uploadSize = 0
 	}
-	_, err := putTargetStreamWithURL(targetURL, os.Stdin, -1, opts)
+	_, err := putTargetStreamWithURL(targetURL, reader, uploadSize, opts)
 	// TODO: See if this check is necessary.
 	switch e := err.ToGoError().(type) {
 	case *os.PathError:
@@ -122,6 +167,20 @@ func checkPipeSyntax(ctx *cli.Context) {
 	if len(ctx.Args()) > 1 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code.
 	}
+	if partSize := ctx.String("part-size"); partSize != "" {
+		v, e := humanize.ParseBytes(partSize)
+		fatalIf(probe.NewError(e).Trace(partSize), "Unable to parse --part-size.")
+		if v < minPipePartSize || v > maxPipePartSize {
+			fatalIf(errInvalidArgument().Trace(partSize), "--part-size should be between 5MiB and 5GiB.")
+		}
+	}
+	if size := ctx.String("size"); size != "" {
+		_, e := humanize.ParseBytes(size)
+		fatalIf(probe.NewError(e).Trace(size), "Unable to parse --size.")
+	}
+	if (ctx.IsSet("part-size") || ctx.IsSet("size")) && len(ctx.Args()) == 0 {
+		fatalIf(errInvalidArgument().Trace(), "--part-size and --size need a TARGET.")
+	}
 }
 
 // mainPipe is the main entry point for pipe command.
@@ -141,13 +200,25 @@ func mainPipe(ctx *cli.Context) error {
 	if tags := ctx.String("tags"); tags != "" {
 		meta["X-Amz-Tagging"] = tags
 	}
+	opts := PutOptions{
+		storageClass: ctx.String("storage-class"),
+		metadata:     meta,
+	}
+	size := int64(-1)
+	if v := ctx.String("size"); v != "" {
+		n, _ := humanize.ParseBytes(v)
+		size = int64(n)
+	}
+	if v := ctx.String("part-size"); v != "" {
+		opts.multipartSize, _ = humanize.ParseBytes(v)
+	}
 	if len(ctx.Args()) == 0 {
-		err = pipe("", nil, ctx.String("storage-class"), meta)
+		err = pipe("", nil, size, opts)
 		fatalIf(err.Trace("stdout"), "Unable to write to one or more targets.")
 	} else {
 		// extract URLs.
 		URLs := ctx.Args()
-		err = pipe(URLs[0], encKeyDB, ctx.String("storage-class"), meta)
+		err = pipe(URLs[0], encKeyDB, size, opts)
 		fatalIf(err.Trace(URLs[0]), "Unable to write to one or more targets.")
 	}
 
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+)
+
+func TestPipePartSize(t *testing.T) {
+	testCases := []struct {
+		size     int64
+		partSize uint64
+		expected uint64
+	}{
+		// Unknown size.
+		{-1, 16 * humanize.MiByte, 16 * humanize.MiByte},
+		{-1, 0, 0},
+		// No part size requested for a stream of known size.
+		{10 * humanize.GiByte, 0, 16 * humanize.MiByte},
+		{200 * humanize.GiByte, 0, 21 * humanize.MiByte},
+		// Fits in 10000 parts.
+		{10 * humanize.GiByte, 16 * humanize.MiByte, 16 * humanize.MiByte},
+		// 200GiB in 10000 parts needs at least 20.48MiB parts.
+		{200 * humanize.GiByte, 8 * humanize.MiByte, 21 * humanize.MiByte},
+	}
+
+	for i, testCase := range testCases {
+		if got := pipePartSize(testCase.size, testCase.partSize); got != testCase.expected {
+			t.Errorf("Test %d: expected %d, got %d", i+1, testCase.expected, got)
+		}
+	}
+}
@@ -87,6 +87,11 @@ func newProgressReader(r io.Reader, caption string, total int64) *pb.Reader {
 func newProgressBar(total int64) *progressBar {
 	bar := newPB(total)
 
+	// Let users know when throttling is active.
+	if postfix := rateLimitPostfix(); postfix != "" {
+		bar.Postfix(postfix)
+	}
+
 	// Return new progress bar here.
 	return &progressBar{ProgressBar: bar}
 }
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var quotaClearCmd = cli.Command{
+	Name:         "clear",
+	Usage:        "clear bucket quota",
+	Action:       mainQuotaClear,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        globalFlags,
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} ALIAS/BUCKET
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Clear the quota configured on bucket "mybucket" on MinIO.
+     {{.Prompt}} {{.HelpName}} myminio/mybucket
+`,
+}
+
+// quotaClearMessage container for quota clear message structure
+type quotaClearMessage struct {
+	Status string `json:"status"`
+	Bucket string `json:"bucket"`
+}
+
+func (q quotaClearMessage) String() string {
+	return console.Colorize("QuotaMessage",
+		fmt.Sprintf("Successfully cleared bucket quota configured on `%s`", q.Bucket))
+}
+
+func (q quotaClearMessage) JSON() string {
+	jsonMessageBytes, e := json.MarshalIndent(q, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
+// mainQuotaClear is the handler for "mc quota clear" command.
+func mainQuotaClear(ctx *cli.Context) error {
+	checkQuotaSyntax(ctx)
+	setQuotaColorScheme()
+
+	aliasedURL := ctx.Args().Get(0)
+	bucket, err := quotaBucket(aliasedURL)
+	fatalIf(err, "Quota can only be cleared on a bucket.")
+
+	client, err := newAdminClient(aliasedURL)
+	fatalIf(err, "Unable to initialize admin connection.")
+
+	msg, err := clearBucketQuota(client, bucket)
+	fatalIf(err.Trace(aliasedURL), "Unable to clear bucket quota")
+	printMsg(msg)
+	return nil
+}
+
+// clearBucketQuota removes the quota of bucket, shared with 'mc admin bucket quota'.
+func clearBucketQuota(client *madmin.AdminClient, bucket string) (quotaClearMessage, *probe.Error) {
+	if e := client.SetBucketQuota(globalContext, bucket, &madmin.BucketQuota{}); e != nil {
+		return quotaClearMessage{}, probe.NewError(e)
+	}
+	return quotaClearMessage{
+		Status: "success",
+		Bucket: bucket,
+	}, nil
+}
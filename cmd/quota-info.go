@@ -0,0 +1,150 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var quotaInfoCmd = cli.Command{
+	Name:         "info",
+	Usage:        "show bucket quota and current usage",
+	Action:       mainQuotaInfo,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        globalFlags,
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} ALIAS/BUCKET
+
+  Bucket usage is computed periodically by the server's scanner, so recent
+  writes may not be reflected yet.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Display the quota and usage of bucket "mybucket" on MinIO.
+     {{.Prompt}} {{.HelpName}} myminio/mybucket
+`,
+}
+
+// quotaInfoMessage container for quota info message structure
+type quotaInfoMessage struct {
+	Status    string   `json:"status"`
+	Bucket    string   `json:"bucket"`
+	Quota     uint64   `json:"quota"`
+	QuotaType string   `json:"type,omitempty"`
+	Usage     *uint64  `json:"usage,omitempty"`
+	UsedPct   *float64 `json:"usedPercent,omitempty"`
+}
+
+func (q quotaInfoMessage) String() string {
+	if q.Quota == 0 {
+		msg := fmt.Sprintf("Bucket `%s` has no quota configured", q.Bucket)
+		if q.Usage != nil {
+			msg += fmt.Sprintf(", current usage is %s", humanize.IBytes(*q.Usage))
+		}
+		return console.Colorize("QuotaInfo", msg)
+	}
+	msg := console.Colorize("QuotaInfo",
+		fmt.Sprintf("Bucket `%s` has %s quota of %s", q.Bucket, q.QuotaType, humanize.IBytes(q.Quota)))
+	if q.Usage == nil || q.UsedPct == nil {
+		return msg + console.Colorize("QuotaInfo", ", current usage is unavailable")
+	}
+	theme := "QuotaUsageLow"
+	switch {
+	case *q.UsedPct >= 100:
+		theme = "QuotaUsageFull"
+	case *q.UsedPct >= 80:
+		theme = "QuotaUsageHigh"
+	}
+	return msg + console.Colorize("QuotaInfo", ", used ") +
+		console.Colorize(theme, fmt.Sprintf("%s (%.2f%%)", humanize.IBytes(*q.Usage), *q.UsedPct))
+}
+
+func (q quotaInfoMessage) JSON() string {
+	jsonMessageBytes, e := json.MarshalIndent(q, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
+// quotaUsedPercent returns the percentage of the quota used by the bucket.
+func quotaUsedPercent(usage, quota uint64) float64 {
+	if quota == 0 {
+		return 0
+	}
+	return float64(usage) / float64(quota) * 100
+}
+
+// mainQuotaInfo is the handler for "mc quota info" command.
+func mainQuotaInfo(ctx *cli.Context) error {
+	checkQuotaSyntax(ctx)
+	setQuotaColorScheme()
+
+	aliasedURL := ctx.Args().Get(0)
+	bucket, err := quotaBucket(aliasedURL)
+	fatalIf(err, "Quota is only available for a bucket.")
+
+	client, err := newAdminClient(aliasedURL)
+	fatalIf(err, "Unable to initialize admin connection.")
+
+	msg, err := getBucketQuotaInfo(client, bucket)
+	fatalIf(err.Trace(aliasedURL), "Unable to get bucket quota")
+	printMsg(msg)
+	return nil
+}
+
+// getBucketQuotaInfo returns the quota of bucket and its usage, shared
+// with 'mc admin bucket quota'. A failure to get the usage is only reported.
+func getBucketQuotaInfo(client *madmin.AdminClient, bucket string) (quotaInfoMessage, *probe.Error) {
+	qCfg, e := client.GetBucketQuota(globalContext, bucket)
+	if e != nil {
+		return quotaInfoMessage{}, probe.NewError(e)
+	}
+
+	msg := quotaInfoMessage{
+		Status:    "success",
+		Bucket:    bucket,
+		Quota:     qCfg.Quota,
+		QuotaType: string(qCfg.Type),
+	}
+
+	dataUsage, e := client.DataUsageInfo(globalContext)
+	if e != nil {
+		errorIf(probe.NewError(e).Trace(bucket), "Unable to get bucket usage.")
+	} else if bucketUsage, ok := dataUsage.BucketsUsage[bucket]; ok {
+		usage := bucketUsage.Size
+		msg.Usage = &usage
+		if qCfg.Quota > 0 {
+			usedPct := quotaUsedPercent(usage, qCfg.Quota)
+			msg.UsedPct = &usedPct
+		}
+	}
+	return msg, nil
+}
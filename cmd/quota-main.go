@@ -0,0 +1,99 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+	"github.com/minio/cli"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var quotaSubcommands = []cli.Command{
+	quotaSetCmd,
+	quotaInfoCmd,
+	quotaClearCmd,
+}
+
+var quotaCmd = cli.Command{
+	Name:            "quota",
+	Usage:           "manage bucket quota",
+	Action:          mainQuota,
+	Before:          setGlobalsFromContext,
+	Flags:           globalFlags,
+	HideHelpCommand: true,
+	Subcommands:     quotaSubcommands,
+}
+
+// mainQuota is the handle for "mc quota" command.
+func mainQuota(ctx *cli.Context) error {
+	commandNotFound(ctx, quotaSubcommands)
+	return nil
+	// Sub-commands like "set", "info", "clear" have their own main.
+}
+
+func setQuotaColorScheme() {
+	console.SetColor("QuotaMessage", color.New(color.FgGreen))
+	console.SetColor("QuotaInfo", color.New(color.FgBlue))
+	console.SetColor("QuotaUsageLow", color.New(color.FgGreen))
+	console.SetColor("QuotaUsageHigh", color.New(color.FgYellow, color.Bold))
+	console.SetColor("QuotaUsageFull", color.New(color.FgRed, color.Bold))
+}
+
+// checkQuotaSyntax - validate the passed arguments of the quota subcommands.
+func checkQuotaSyntax(ctx *cli.Context) {
+	if len(ctx.Args()) != 1 {
+		showCommandHelpAndExit(ctx, 1) // last argument is exit code
+	}
+}
+
+// quotaBucket returns the bucket name of an ALIAS/BUCKET argument.
+func quotaBucket(aliasedURL string) (string, *probe.Error) {
+	_, targetURL := url2Alias(aliasedURL)
+	bucket := strings.Trim(targetURL, "/")
+	if bucket == "" || strings.Contains(bucket, "/") {
+		return "", errInvalidArgument().Trace(aliasedURL)
+	}
+	return bucket, nil
+}
+
+// parseQuotaSize parses a human readable quota size such as 10GiB or 1TB.
+func parseQuotaSize(sizeStr string) (uint64, *probe.Error) {
+	size, e := humanize.ParseBytes(sizeStr)
+	if e != nil {
+		return 0, probe.NewError(fmt.Errorf("invalid size `%s`, expected a number with an optional unit such as KiB, MiB, GiB, TiB, KB, MB, GB or TB", sizeStr))
+	}
+	if size == 0 {
+		return 0, probe.NewError(fmt.Errorf("size must be greater than zero, use 'mc quota clear' to remove a quota"))
+	}
+	return size, nil
+}
+
+// parseQuotaType validates the quota type, only hard quotas are supported.
+func parseQuotaType(typeStr string) (madmin.QuotaType, *probe.Error) {
+	qType := madmin.QuotaType(strings.ToLower(typeStr))
+	if qType != madmin.HardQuota {
+		return "", probe.NewError(fmt.Errorf("unsupported quota type `%s`, only `%s` is supported", typeStr, madmin.HardQuota))
+	}
+	return qType, nil
+}
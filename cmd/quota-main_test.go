@@ -0,0 +1,79 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestParseQuotaSize(t *testing.T) {
+	testCases := []struct {
+		size     string
+		expected uint64
+		success  bool
+	}{
+		{"1TiB", 1 << 40, true},
+		{"500GB", 500 * 1000 * 1000 * 1000, true},
+		{"10gi", 10 << 30, true},
+		{"1024", 1024, true},
+		{"", 0, false},
+		{"0", 0, false},
+		{"10XB", 0, false},
+		{"-1GiB", 0, false},
+	}
+	for i, tc := range testCases {
+		size, err := parseQuotaSize(tc.size)
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: expected success %v, got %v", i+1, tc.success, err)
+		}
+		if size != tc.expected {
+			t.Errorf("Test %d: expected %d, got %d", i+1, tc.expected, size)
+		}
+	}
+}
+
+func TestQuotaBucket(t *testing.T) {
+	testCases := []struct {
+		url     string
+		bucket  string
+		success bool
+	}{
+		{"myminio/mybucket", "mybucket", true},
+		{"myminio/mybucket/", "mybucket", true},
+		{"myminio/mybucket/prefix", "", false},
+		{"myminio", "", false},
+	}
+	for i, tc := range testCases {
+		bucket, err := quotaBucket(tc.url)
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: expected success %v, got %v", i+1, tc.success, err)
+		}
+		if bucket != tc.bucket {
+			t.Errorf("Test %d: expected %s, got %s", i+1, tc.bucket, bucket)
+		}
+	}
+}
+
+func TestQuotaUsedPercent(t *testing.T) {
+	if pct := quotaUsedPercent(512, 1024); pct != 50 {
+		t.Errorf("expected 50, got %v", pct)
+	}
+	if pct := quotaUsedPercent(512, 0); pct != 0 {
+		t.Errorf("expected 0, got %v", pct)
+	}
+}
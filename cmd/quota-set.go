@@ -0,0 +1,139 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/madmin-go"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var quotaSetFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "size",
+		Usage: "set a quota size e.g. 10GiB, 1TiB",
+	},
+	cli.StringFlag{
+		Name:  "type",
+		Usage: "set the quota type, only 'hard' is supported",
+		Value: string(madmin.HardQuota),
+	},
+}
+
+var quotaSetCmd = cli.Command{
+	Name:         "set",
+	Usage:        "set bucket quota",
+	Action:       mainQuotaSet,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        append(quotaSetFlags, globalFlags...),
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} ALIAS/BUCKET --size SIZE [--type hard]
+
+SIZE:
+  size accepts human-readable case-insensitive number
+  suffixes such as "k", "m", "g" and "t" referring to the metric units KB,
+  MB, GB and TB respectively. Adding an "i" to these prefixes, uses the IEC
+  units, so that "gi" refers to "gibibyte" or "GiB". A "b" at the end is
+  also accepted. Without suffixes the unit is bytes.
+
+TYPE:
+  hard: writes are rejected once the bucket usage reaches the quota.
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Set a hard quota of 1TiB on bucket "mybucket" on MinIO.
+     {{.Prompt}} {{.HelpName}} myminio/mybucket --size 1TiB --type hard
+
+  2. Set a hard quota of 500GB on bucket "mybucket" on MinIO.
+     {{.Prompt}} {{.HelpName}} myminio/mybucket --size 500GB
+`,
+}
+
+// quotaSetMessage container for quota set message structure
+type quotaSetMessage struct {
+	Status    string `json:"status"`
+	Bucket    string `json:"bucket"`
+	Quota     uint64 `json:"quota"`
+	QuotaType string `json:"type"`
+}
+
+func (q quotaSetMessage) String() string {
+	return console.Colorize("QuotaMessage",
+		fmt.Sprintf("Successfully set bucket quota of %s with %s type on `%s`", humanize.IBytes(q.Quota), q.QuotaType, q.Bucket))
+}
+
+func (q quotaSetMessage) JSON() string {
+	jsonMessageBytes, e := json.MarshalIndent(q, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
+// mainQuotaSet is the handler for "mc quota set" command.
+func mainQuotaSet(ctx *cli.Context) error {
+	checkQuotaSyntax(ctx)
+	setQuotaColorScheme()
+
+	aliasedURL := ctx.Args().Get(0)
+	bucket, err := quotaBucket(aliasedURL)
+	fatalIf(err, "Quota can only be set on a bucket.")
+
+	if !ctx.IsSet("size") {
+		fatalIf(errInvalidArgument().Trace(aliasedURL), "--size flag is required.")
+	}
+	quota, err := parseQuotaSize(ctx.String("size"))
+	fatalIf(err, "Unable to parse --size.")
+	qType, err := parseQuotaType(ctx.String("type"))
+	fatalIf(err, "Unable to parse --type.")
+
+	client, err := newAdminClient(aliasedURL)
+	fatalIf(err, "Unable to initialize admin connection.")
+
+	msg, err := setBucketQuota(client, bucket, quota, qType)
+	fatalIf(err.Trace(aliasedURL), "Unable to set bucket quota")
+	printMsg(msg)
+	return nil
+}
+
+// setBucketQuota sets the quota of bucket, shared with 'mc admin bucket quota'.
+func setBucketQuota(client *madmin.AdminClient, bucket string, quota uint64, qType madmin.QuotaType) (quotaSetMessage, *probe.Error) {
+	e := client.SetBucketQuota(globalContext, bucket, &madmin.BucketQuota{
+		Quota: quota,
+		Type:  qType,
+	})
+	if e != nil {
+		return quotaSetMessage{}, probe.NewError(e)
+	}
+	return quotaSetMessage{
+		Status:    "success",
+		Bucket:    bucket,
+		Quota:     quota,
+		QuotaType: string(qType),
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
@@ -40,6 +41,10 @@ var replicateResyncStartFlags = []cli.Flag{
 		Name:  "remote-bucket",
 		Usage: "remote bucket ARN",
 	},
+	cli.BoolFlag{
+		Name:  "wait",
+		Usage: "report progress and wait until the resync completes",
+	},
 }
 
 var replicateResyncStartCmd = cli.Command{
@@ -64,6 +69,9 @@ EXAMPLES:
 
   2. Re-replicate all objects older than 60 days in bucket "mybucket" for remote bucket target.
    {{.Prompt}} {{.HelpName}} myminio/mybucket --older-than 60d --remote-bucket "arn:minio:replication::xxx:mybucket"
+
+  3. Re-replicate previously replicated objects for remote target, reporting progress until the resync completes.
+   {{.Prompt}} {{.HelpName}} myminio/mybucket --remote-bucket "arn:minio:replication::xxx:mybucket" --wait
 `,
 }
 
@@ -129,12 +137,91 @@ func mainReplicateResyncStart(cliCtx *cli.Context) error {
 		}
 	}
 
-	rinfo, err := client.ResetReplication(ctx, olderThan, cliCtx.String("remote-bucket"))
+	arn := cliCtx.String("remote-bucket")
+	rinfo, err := client.ResetReplication(ctx, olderThan, arn)
 	fatalIf(err.Trace(args...), "Unable to reset replication")
 	printMsg(replicateResyncMessage{
 		Op:                cliCtx.Command.Name,
 		URL:               aliasedURL,
 		ResyncTargetsInfo: rinfo,
 	})
+
+	if cliCtx.Bool("wait") {
+		var resetID string
+		if len(rinfo.Targets) == 1 {
+			resetID = rinfo.Targets[0].ResetID
+		}
+		st, err := waitReplicateResync(ctx, client, aliasedURL, arn, resetID)
+		fatalIf(err.Trace(args...), "Unable to get replication resync status")
+		if st.ResyncStatus != "Completed" {
+			fatalIf(errDummy().Trace(args...), "Replication resync of %s ended with status %s.", aliasedURL, st.ResyncStatus)
+		}
+	}
 	return nil
 }
+
+// replicateResyncProgressMessage - progress of an ongoing replication resync.
+type replicateResyncProgressMessage struct {
+	Status string `json:"status"`
+	URL    string `json:"url"`
+
+	replication.ResyncTarget
+}
+
+func (r replicateResyncProgressMessage) JSON() string {
+	r.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(r, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+func (r replicateResyncProgressMessage) String() string {
+	msg := fmt.Sprintf("Resync %s: %s objects (%s) replicated, %s failed",
+		r.ResyncStatus, humanize.Comma(r.ReplicatedCount), humanize.IBytes(uint64(r.ReplicatedSize)), humanize.Comma(r.FailedCount))
+	if r.Object != "" {
+		msg += ", last " + r.Bucket + "/" + r.Object
+	}
+	return console.Colorize("replicateResyncMessage", msg)
+}
+
+// replicateResyncDone - reports whether the resync status is final.
+func replicateResyncDone(status string) bool {
+	switch status {
+	case "Completed", "Failed", "Canceled":
+		return true
+	}
+	return false
+}
+
+// waitReplicateResync - polls the status of the resync of the remote
+// target with resetID, printing progress whenever it changes, until the
+// resync is done.
+func waitReplicateResync(ctx context.Context, client Client, aliasedURL, arn, resetID string) (replication.ResyncTarget, *probe.Error) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	var last replication.ResyncTarget
+	for {
+		rinfo, err := client.ReplicationResyncStatus(ctx, arn)
+		if err != nil {
+			return last, err
+		}
+		for _, st := range rinfo.Targets {
+			if st.Arn != arn || (resetID != "" && st.ResetID != resetID) {
+				continue
+			}
+			if st != last {
+				printMsg(replicateResyncProgressMessage{URL: aliasedURL, ResyncTarget: st})
+				last = st
+			}
+			if replicateResyncDone(st.ResyncStatus) {
+				return st, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return last, probe.NewError(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -50,6 +50,9 @@ FLAGS:
 EXAMPLES:
   1. Get server side replication metrics for bucket "mybucket" for alias "myminio".
        {{.Prompt}} {{.HelpName}} myminio/mybucket
+
+  2. Get the pending and failed replication backlog of each remote target of bucket "mybucket" in JSON.
+       {{.Prompt}} {{.HelpName}} myminio/mybucket --json
 `,
 }
 
@@ -61,10 +64,54 @@ func checkReplicateStatusSyntax(ctx *cli.Context) {
 }
 
 type replicateStatusMessage struct {
-	Op                string              `json:"op"`
-	URL               string              `json:"url"`
-	Status            string              `json:"status"`
-	ReplicationStatus replication.Metrics `json:"replicationStatus"`
+	Op                string                  `json:"op"`
+	URL               string                  `json:"url"`
+	Status            string                  `json:"status"`
+	ReplicationStatus replication.Metrics     `json:"replicationStatus"`
+	Targets           []replicateTargetStatus `json:"targets,omitempty"`
+}
+
+// replicateTargetStatus - replication metrics of a single remote target,
+// pending bytes and operations are the backlog queued for the target.
+type replicateTargetStatus struct {
+	Arn            string `json:"arn"`
+	PendingSize    uint64 `json:"pendingSize"`
+	PendingCount   uint64 `json:"pendingCount"`
+	FailedSize     uint64 `json:"failedSize"`
+	FailedCount    uint64 `json:"failedCount"`
+	ReplicatedSize uint64 `json:"replicatedSize"`
+	ReplicaSize    uint64 `json:"replicaSize"`
+}
+
+// replicateTargetStatuses - returns the metrics of every remote target
+// sorted by ARN, targets configured in cfg without any metrics yet are
+// reported with zero values.
+func replicateTargetStatuses(metrics replication.Metrics, cfg replication.Config) []replicateTargetStatus {
+	arns := make(map[string]bool, len(metrics.Stats))
+	for arn := range metrics.Stats {
+		arns[arn] = true
+	}
+	for _, rule := range cfg.Rules {
+		if rule.Destination.Bucket != "" {
+			arns[rule.Destination.Bucket] = true
+		}
+	}
+
+	targets := make([]replicateTargetStatus, 0, len(arns))
+	for arn := range arns {
+		st := metrics.Stats[arn]
+		targets = append(targets, replicateTargetStatus{
+			Arn:            arn,
+			PendingSize:    st.PendingSize,
+			PendingCount:   st.PendingCount,
+			FailedSize:     st.FailedSize,
+			FailedCount:    st.FailedCount,
+			ReplicatedSize: st.ReplicatedSize,
+			ReplicaSize:    st.ReplicaSize,
+		})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Arn < targets[j].Arn })
+	return targets
 }
 
 func (s replicateStatusMessage) JSON() string {
@@ -198,10 +245,15 @@ func mainReplicateStatus(cliCtx *cli.Context) error {
 	replicateStatus, err := client.GetReplicationMetrics(ctx)
 	fatalIf(err.Trace(args...), "Unable to get replication status")
 
+	// The configuration only adds targets which have no metrics yet,
+	// it is fine to go without it.
+	replicateCfg, _ := client.GetReplication(ctx)
+
 	printMsg(replicateStatusMessage{
 		Op:                cliCtx.Command.Name,
 		URL:               aliasedURL,
 		ReplicationStatus: replicateStatus,
+		Targets:           replicateTargetStatuses(replicateStatus, replicateCfg),
 	})
 
 	return nil
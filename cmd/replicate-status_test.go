@@ -0,0 +1,49 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+func TestReplicateTargetStatuses(t *testing.T) {
+	metrics := replication.Metrics{
+		Stats: map[string]replication.TargetMetrics{
+			"arn:minio:replication::b:dst": {PendingSize: 100, PendingCount: 2, FailedSize: 10, FailedCount: 1, ReplicatedSize: 1000},
+			"arn:minio:replication::a:dst": {ReplicatedSize: 50, ReplicaSize: 5},
+		},
+	}
+	cfg := replication.Config{
+		Rules: []replication.Rule{
+			{Destination: replication.Destination{Bucket: "arn:minio:replication::a:dst"}},
+			{Destination: replication.Destination{Bucket: "arn:minio:replication::c:dst"}},
+		},
+	}
+
+	expected := []replicateTargetStatus{
+		{Arn: "arn:minio:replication::a:dst", ReplicatedSize: 50, ReplicaSize: 5},
+		{Arn: "arn:minio:replication::b:dst", PendingSize: 100, PendingCount: 2, FailedSize: 10, FailedCount: 1, ReplicatedSize: 1000},
+		{Arn: "arn:minio:replication::c:dst"},
+	}
+	if got := replicateTargetStatuses(metrics, cfg); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
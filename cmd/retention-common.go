@@ -124,12 +124,20 @@ func getRetainUntilDate(validity uint64, unit minio.ValidityUnit) (string, *prob
 	return timeStr, nil
 }
 
-func setRetentionSingle(ctx context.Context, op lockOpType, alias, url, versionID string, mode minio.RetentionMode, retainUntil time.Time, bypassGovernance bool) *probe.Error {
-	newClnt, err := newClientFromAlias(alias, url)
-	if err != nil {
-		return err
+// retentionReduces returns true when replacing the current retention of an
+// object with the given mode and retain-until date would shorten or weaken
+// a COMPLIANCE lock which is still in effect at now, which the server is
+// going to reject anyway.
+func retentionReduces(curMode minio.RetentionMode, curUntil time.Time, mode minio.RetentionMode, until, now time.Time) bool {
+	if curMode != minio.Compliance || curUntil.Before(now) {
+		return false
 	}
+	return mode != minio.Compliance || until.Before(curUntil)
+}
 
+// setRetentionSingle applies the retention to a single object and prints
+// the outcome, a returned error has already been reported to the user.
+func setRetentionSingle(ctx context.Context, op lockOpType, alias, url, versionID string, mode minio.RetentionMode, retainUntil time.Time, bypassGovernance, force bool) *probe.Error {
 	msg := retentionCmdMessage{
 		Op:        op,
 		Mode:      mode,
@@ -137,7 +145,18 @@ func setRetentionSingle(ctx context.Context, op lockOpType, alias, url, versionI
 		VersionID: versionID,
 	}
 
-	err = newClnt.PutObjectRetention(ctx, versionID, mode, retainUntil, bypassGovernance)
+	newClnt, err := newClientFromAlias(alias, url)
+	if err == nil && op == lockOpSet && !force {
+		// Errors are left for the server to report when applying the retention.
+		curMode, curUntil, gerr := newClnt.GetObjectRetention(ctx, versionID)
+		if gerr == nil && retentionReduces(curMode, curUntil, mode, retainUntil, UTCNow()) {
+			err = probe.NewError(fmt.Errorf("COMPLIANCE retention until %s cannot be reduced to %s until %s, use --force to send the request anyway",
+				curUntil.Format(time.RFC3339), mode, retainUntil.Format(time.RFC3339)))
+		}
+	}
+	if err == nil {
+		err = newClnt.PutObjectRetention(ctx, versionID, mode, retainUntil, bypassGovernance)
+	}
 	if err != nil {
 		msg.Err = err.ToGoError()
 		msg.Status = "failure"
@@ -180,7 +199,7 @@ func fatalIfBucketLockNotEnabled(ctx context.Context, aliasedURL string) {
 
 // Apply Retention for one object/version or many objects within a given prefix.
 func applyRetention(ctx context.Context, op lockOpType, target, versionID string, timeRef time.Time, withOlderVersions, isRecursive bool,
-	mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypassGovernance bool,
+	mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypassGovernance, force bool,
 ) error {
 	clnt, err := newClient(target)
 	if err != nil {
@@ -209,8 +228,9 @@ func applyRetention(ctx context.Context, op lockOpType, target, versionID string
 
 	alias, urlStr, _ := mustExpandAlias(target)
 	if versionID != "" || !isRecursive && !withOlderVersions {
-		err := setRetentionSingle(ctx, op, alias, urlStr, versionID, mode, until, bypassGovernance)
-		fatalIf(err.Trace(), "Unable to set retention on `%s`", target)
+		if err := setRetentionSingle(ctx, op, alias, urlStr, versionID, mode, until, bypassGovernance, force); err != nil {
+			return exitStatus(globalErrorExitStatus)
+		}
 		return nil
 	}
 
@@ -240,9 +260,9 @@ func applyRetention(ctx context.Context, op lockOpType, target, versionID string
 			break
 		}
 
-		err := setRetentionSingle(ctx, op, alias, content.URL.String(), content.VersionID, mode, until, bypassGovernance)
+		err := setRetentionSingle(ctx, op, alias, content.URL.String(), content.VersionID, mode, until, bypassGovernance, force)
 		if err != nil {
-			errorIf(err.Trace(clnt.GetURL().String()), "Invalid URL")
+			cErr = exitStatus(globalErrorExitStatus) // Set the exit status.
 			continue
 		}
 
@@ -0,0 +1,54 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestRetentionReduces(t *testing.T) {
+	now := time.Now().UTC()
+	later := now.Add(48 * time.Hour)
+	earlier := now.Add(-48 * time.Hour)
+	testCases := []struct {
+		curMode  minio.RetentionMode
+		curUntil time.Time
+		mode     minio.RetentionMode
+		until    time.Time
+		reduces  bool
+	}{
+		{"", time.Time{}, minio.Compliance, now, false},
+		{minio.Governance, later, minio.Governance, now, false},
+		{minio.Governance, later, minio.Compliance, now, false},
+		{minio.Compliance, now, minio.Compliance, later, false},
+		{minio.Compliance, now, minio.Compliance, now, false},
+		{minio.Compliance, later, minio.Compliance, now, true},
+		{minio.Compliance, now, minio.Governance, later, true},
+		// An expired COMPLIANCE lock no longer protects the object.
+		{minio.Compliance, earlier, minio.Governance, later, false},
+		{minio.Compliance, earlier, minio.Compliance, earlier.Add(-time.Hour), false},
+	}
+	for i, tc := range testCases {
+		if got := retentionReduces(tc.curMode, tc.curUntil, tc.mode, tc.until, now); got != tc.reduces {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.reduces, got)
+		}
+	}
+}
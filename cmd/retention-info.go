@@ -151,20 +151,30 @@ func (m retentionInfoMessageList) String() string {
 	var msg string
 	var retentionField string
 
+	var untilField string
 	if m.Mode == "" {
 		retentionField += console.Colorize("RetentionNotFound", "NO RETENTION")
 	} else {
 		exp := ""
-		if m.Mode == minio.Governance {
-			now := time.Now()
+		now := time.Now()
+		if !m.Until.IsZero() && now.After(m.Until) {
+			exp = "EXPIRED"
+		}
+		retentionField += console.Colorize("RetentionSuccess", m.Mode.String()) + " " + console.Colorize("RetentionExpired", exp)
+		if !m.Until.IsZero() {
 			if now.After(m.Until) {
-				exp = "EXPIRED"
+				untilField = console.Colorize("RetentionExpired", m.Until.Local().Format(printDate))
+			} else {
+				untilField = console.Colorize("RetentionSuccess", m.Until.Local().Format(printDate)+
+					" (in "+timeDurationToHumanizedDuration(m.Until.Sub(now)).StringShort()+")")
 			}
 		}
-		retentionField += console.Colorize("RetentionSuccess", m.Mode.String()) + " " + console.Colorize("RetentionExpired", exp)
 	}
 
 	msg += "[ " + centerText(retentionField, 18) + " ]  "
+	if untilField != "" {
+		msg += untilField + "  "
+	}
 
 	if m.VersionID != "" {
 		msg += console.Colorize("RetentionVersionID", m.VersionID+"  ")
@@ -257,10 +267,10 @@ type retentionInfoMsg interface {
 }
 
 // Show retention info for a single object or version
-func infoRetentionSingle(ctx context.Context, alias, url, versionID string, listStyle bool) *probe.Error {
+func infoRetentionSingle(ctx context.Context, alias, url, versionID string, listStyle bool) (minio.RetentionMode, time.Time, *probe.Error) {
 	newClnt, err := newClientFromAlias(alias, url)
 	if err != nil {
-		return err
+		return "", time.Time{}, err
 	}
 
 	var msg retentionInfoMsg
@@ -286,7 +296,7 @@ func infoRetentionSingle(ctx context.Context, alias, url, versionID string, list
 				msg.SetStatus("failure")
 				printMsg(msg)
 			}
-			return err
+			return "", time.Time{}, err
 		}
 		err = nil
 	}
@@ -296,7 +306,56 @@ func infoRetentionSingle(ctx context.Context, alias, url, versionID string, list
 	msg.SetUntil(until)
 
 	printMsg(msg)
-	return err
+	return mode, until, err
+}
+
+// retentionSummaryMessage - retention totals of a listing, to verify
+// object lock coverage at a glance.
+type retentionSummaryMessage struct {
+	Status     string `json:"status"`
+	Target     string `json:"target"`
+	Objects    int    `json:"objects"`
+	Compliance int    `json:"compliance"`
+	Governance int    `json:"governance"`
+	Expired    int    `json:"expired"`
+	None       int    `json:"none"`
+	Failed     int    `json:"failed"`
+}
+
+func (m retentionSummaryMessage) String() string {
+	msg := fmt.Sprintf("Retention of %d object(s) under `%s`: %d COMPLIANCE, %d GOVERNANCE, %d without retention.",
+		m.Objects, m.Target, m.Compliance, m.Governance, m.None)
+	if m.Expired > 0 {
+		msg += fmt.Sprintf(" %d expired.", m.Expired)
+	}
+	if m.Failed > 0 {
+		msg += fmt.Sprintf(" %d failed.", m.Failed)
+	}
+	return console.Colorize("RetentionSummary", msg)
+}
+
+func (m retentionSummaryMessage) JSON() string {
+	m.Status = "success"
+	msgBytes, e := json.MarshalIndent(m, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// add - counts the retention of one object.
+func (m *retentionSummaryMessage) add(mode minio.RetentionMode, until time.Time, now time.Time) {
+	m.Objects++
+	switch mode {
+	case minio.Compliance:
+		m.Compliance++
+	case minio.Governance:
+		m.Governance++
+	default:
+		m.None++
+		return
+	}
+	if !until.IsZero() && now.After(until) {
+		m.Expired++
+	}
 }
 
 // Get Retention for one object/version or many objects within a given prefix.
@@ -315,7 +374,7 @@ func getRetention(ctx context.Context, target, versionID string, timeRef time.Ti
 
 	alias, urlStr, _ := mustExpandAlias(target)
 	if versionID != "" || !isRecursive && !withOlderVersions {
-		err := infoRetentionSingle(ctx, alias, urlStr, versionID, false)
+		_, _, err := infoRetentionSingle(ctx, alias, urlStr, versionID, false)
 		if err != nil {
 			if _, ok := err.ToGoError().(ObjectNameEmpty); ok {
 				return showBucketLock(target)
@@ -334,6 +393,8 @@ func getRetention(ctx context.Context, target, versionID string, timeRef time.Ti
 
 	var cErr error
 	var atLeastOneObjectOrVersionFound bool
+	summary := retentionSummaryMessage{Target: target}
+	now := time.Now()
 
 	for content := range clnt.List(ctx, lstOptions) {
 		if content.Err != nil {
@@ -350,19 +411,23 @@ func getRetention(ctx context.Context, target, versionID string, timeRef time.Ti
 			break
 		}
 
-		err := infoRetentionSingle(ctx, alias, content.URL.String(), content.VersionID, true)
+		mode, until, err := infoRetentionSingle(ctx, alias, content.URL.String(), content.VersionID, true)
 		if err != nil {
 			errorIf(err.Trace(clnt.GetURL().String()), "Invalid URL")
 			cErr = exitStatus(globalErrorExitStatus)
+			summary.Failed++
 			continue
 		}
 
 		atLeastOneObjectOrVersionFound = true
+		summary.add(mode, until, now)
 	}
 
 	if !atLeastOneObjectOrVersionFound {
 		errorIf(errDummy().Trace(clnt.GetURL().String()), "Unable to find any object/version to show its retention.")
 		cErr = exitStatus(globalErrorExitStatus) // Set the exit status.
+	} else {
+		printMsg(summary)
 	}
 
 	return cErr
@@ -378,6 +443,7 @@ func mainRetentionInfo(cliCtx *cli.Context) error {
 	console.SetColor("RetentionVersionID", color.New(color.FgGreen))
 	console.SetColor("RetentionExpired", color.New(color.FgRed, color.Bold))
 	console.SetColor("RetentionFailure", color.New(color.FgYellow))
+	console.SetColor("RetentionSummary", color.New(color.Bold))
 
 	target, versionID, recursive, rewind, withVersions, bucketMode := parseInfoRetentionArgs(cliCtx)
 
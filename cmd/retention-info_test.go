@@ -0,0 +1,47 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestRetentionSummary(t *testing.T) {
+	now := time.Now()
+	var summary retentionSummaryMessage
+	summary.add(minio.Compliance, now.Add(time.Hour), now)
+	summary.add(minio.Compliance, now.Add(-time.Hour), now)
+	summary.add(minio.Governance, now.Add(time.Hour), now)
+	summary.add("", time.Time{}, now)
+
+	expected := retentionSummaryMessage{Objects: 4, Compliance: 2, Governance: 1, Expired: 1, None: 1}
+	if summary != expected {
+		t.Fatalf("expected %+v, got %+v", expected, summary)
+	}
+}
+
+func TestRetentionInfoWithoutUntil(t *testing.T) {
+	m := retentionInfoMessageList{Mode: minio.Governance, URLPath: "myminio/bucket/object"}
+	if s := m.String(); strings.Contains(s, "EXPIRED") {
+		t.Fatalf("expected a retention without retain-until date not to be expired, got %q", s)
+	}
+}
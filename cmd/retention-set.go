@@ -38,6 +38,10 @@ var retentionSetFlags = []cli.Flag{
 		Name:  "bypass",
 		Usage: "bypass governance",
 	},
+	cli.BoolFlag{
+		Name:  "force",
+		Usage: "set retention even if it shortens an existing compliance retention",
+	},
 	cli.StringFlag{
 		Name:  "version-id, vid",
 		Usage: "apply retention to a specific object version",
@@ -75,6 +79,10 @@ FLAGS:
 VALIDITY:
   This argument must be formatted like Nd or Ny where 'd' denotes days and 'y' denotes years e.g. 10d, 3y.
 
+  A compliance retention can only be extended. Objects whose current compliance retention
+  ends after the requested date are skipped, unless --force is specified, in which case the
+  request is sent and rejected by the server.
+
 EXAMPLES:
   1. Set object retention for a specific object
      $ {{.HelpName}} compliance 30d myminio/mybucket/prefix/obj.csv
@@ -90,10 +98,13 @@ EXAMPLES:
 
   5. Set default lock retention configuration for a bucket
      $ {{.HelpName}} --default governance 30d myminio/mybucket/
+
+  6. Shorten governance retention of a specific object, bypassing its current lock
+     $ {{.HelpName}} governance 1d myminio/mybucket/prefix/obj.csv --bypass
 `,
 }
 
-func parseSetRetentionArgs(cliCtx *cli.Context) (target, versionID string, recursive bool, timeRef time.Time, withVersions bool, mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypass, bucketMode bool) {
+func parseSetRetentionArgs(cliCtx *cli.Context) (target, versionID string, recursive bool, timeRef time.Time, withVersions bool, mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypass, force, bucketMode bool) {
 	args := cliCtx.Args()
 	if len(args) != 3 {
 		showCommandHelpAndExit(cliCtx, 1)
@@ -118,10 +129,11 @@ func parseSetRetentionArgs(cliCtx *cli.Context) (target, versionID string, recur
 	withVersions = cliCtx.Bool("versions")
 	recursive = cliCtx.Bool("recursive")
 	bypass = cliCtx.Bool("bypass")
+	force = cliCtx.Bool("force")
 	bucketMode = cliCtx.Bool("default")
 
-	if bucketMode && (versionID != "" || !timeRef.IsZero() || withVersions || recursive || bypass) {
-		fatalIf(errDummy(), "--default cannot be specified with any of --version-id, --rewind, --versions, --recursive, --bypass.")
+	if bucketMode && (versionID != "" || !timeRef.IsZero() || withVersions || recursive || bypass || force) {
+		fatalIf(errDummy(), "--default cannot be specified with any of --version-id, --rewind, --versions, --recursive, --bypass, --force.")
 	}
 
 	return
@@ -129,9 +141,9 @@ func parseSetRetentionArgs(cliCtx *cli.Context) (target, versionID string, recur
 
 // Set Retention for one object/version or many objects within a given prefix.
 func setRetention(ctx context.Context, target, versionID string, timeRef time.Time, withOlderVersions, isRecursive bool,
-	mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypassGovernance bool,
+	mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit, bypassGovernance, force bool,
 ) error {
-	return applyRetention(ctx, lockOpSet, target, versionID, timeRef, withOlderVersions, isRecursive, mode, validity, unit, bypassGovernance)
+	return applyRetention(ctx, lockOpSet, target, versionID, timeRef, withOlderVersions, isRecursive, mode, validity, unit, bypassGovernance, force)
 }
 
 func setBucketLock(urlStr string, mode minio.RetentionMode, validity uint64, unit minio.ValidityUnit) error {
@@ -146,7 +158,7 @@ func mainRetentionSet(cliCtx *cli.Context) error {
 	console.SetColor("RetentionSuccess", color.New(color.FgGreen, color.Bold))
 	console.SetColor("RetentionFailure", color.New(color.FgYellow))
 
-	target, versionID, recursive, rewind, withVersions, mode, validity, unit, bypass, bucketMode := parseSetRetentionArgs(cliCtx)
+	target, versionID, recursive, rewind, withVersions, mode, validity, unit, bypass, force, bucketMode := parseSetRetentionArgs(cliCtx)
 
 	fatalIfBucketLockNotEnabled(ctx, target)
 
@@ -158,5 +170,5 @@ func mainRetentionSet(cliCtx *cli.Context) error {
 		rewind = time.Now().UTC()
 	}
 
-	return setRetention(ctx, target, versionID, rewind, withVersions, recursive, mode, validity, unit, bypass)
+	return setRetention(ctx, target, versionID, rewind, withVersions, recursive, mode, validity, unit, bypass, force)
 }
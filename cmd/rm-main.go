@@ -96,6 +96,14 @@ var (
 			Name:  "non-current",
 			Usage: "remove object(s) versions that are non-current",
 		},
+		cli.BoolFlag{
+			Name:  "preview",
+			Usage: "print the number and size of object(s) to be removed, ask for confirmation unless --force is set",
+		},
+		cli.StringFlag{
+			Name:  "trash",
+			Usage: "move object(s) to specified prefix instead of removing them, ignored on versioned buckets",
+		},
 		cli.BoolFlag{
 			Name:   "force-delete",
 			Usage:  "attempt a prefix force delete, requires confirmation please use with caution",
@@ -167,6 +175,16 @@ EXAMPLES:
   14. Perform a fake removal of object(s) versions that are non-current and older than 10 days. If top-level version is a delete 
   marker, this will also be deleted when --non-current flag is specified.
       {{.Prompt}} {{.HelpName}} s3/docs/ --recursive --force --versions --non-current --older-than 10d --dry-run
+
+  15. Remove all versions older than 30 days of the objects under 'logs/', the age of every
+      version is checked separately.
+      {{.Prompt}} {{.HelpName}} --recursive --force --versions --older-than 30d s3/docs/logs/
+
+  16. Move all objects under 'reports/' to the 'trash/reports/' prefix, instead of removing them.
+      {{.Prompt}} {{.HelpName}} --recursive --force --trash s3/docs/trash/reports/ s3/docs/reports/
+
+  17. Print the number and total size of objects under 'reports/', then ask for confirmation before removing them.
+      {{.Prompt}} {{.HelpName}} --recursive --preview s3/docs/reports/
 `,
 }
 
@@ -213,13 +231,39 @@ func checkRmSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[string
 	isForceDel := cliCtx.Bool("force-delete")
 	versionID := cliCtx.String("version-id")
 	rewind := cliCtx.String("rewind")
+	trash := cliCtx.String("trash")
+	isPreview := cliCtx.Bool("preview")
 	isNamespaceRemoval := false
 
+	if isPreview && isStdin {
+		fatalIf(errDummy().Trace(),
+			"You cannot specify --preview with --stdin.")
+	}
+
+	if isPreview && globalJSON && !isForce {
+		fatalIf(errDummy().Trace(),
+			"You cannot specify --preview with --json without --force, please use --preview --force.")
+	}
+
+	if trash != "" && (versionID != "" || isVersions || rewind != "" || cliCtx.Bool("incomplete") || isForceDel) {
+		fatalIf(errDummy().Trace(),
+			"You cannot specify --trash with any of --version-id, --versions, --rewind, --incomplete and --force-delete flags.")
+	}
+
 	if versionID != "" && (isRecursive || isVersions || rewind != "") {
 		fatalIf(errDummy().Trace(),
 			"You cannot specify --version-id with any of --versions, --rewind and --recursive flags.")
 	}
 
+	// Validate the age filters before anything is removed.
+	for _, flag := range []string{"older-than", "newer-than"} {
+		if v := cliCtx.String(flag); v != "" {
+			if _, e := ParseDuration(v); e != nil {
+				fatalIf(probe.NewError(e).Trace(v), "Unable to parse --"+flag+"=`"+v+"`.")
+			}
+		}
+	}
+
 	if isNoncurrentVersion && !(isVersions && isRecursive) {
 		fatalIf(errDummy().Trace(),
 			"You cannot specify --non-current without --versions --recursive, please use --non-current --versions --recursive.")
@@ -239,6 +283,10 @@ func checkRmSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[string
 		// clean path for aliases like s3/.
 		// Note: UNC path using / works properly in go 1.9.2 even though it breaks the UNC specification.
 		url = filepath.ToSlash(filepath.Clean(url))
+		if trash != "" && strings.HasPrefix(filepath.ToSlash(filepath.Clean(trash))+"/", url+"/") {
+			fatalIf(errInvalidArgument().Trace(trash, url),
+				"The --trash prefix `"+trash+"` cannot be inside the removal target `"+url+"`.")
+		}
 		// namespace removal applies only for non FS. So filter out if passed url represents a directory
 		dir := isAliasURLDir(ctx, url, encKeyDB, time.Time{})
 		if dir {
@@ -260,8 +308,9 @@ func checkRmSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[string
 		showCommandHelpAndExit(cliCtx, exitCode)
 	}
 
-	// For all recursive or versions bulk deletion operations make sure to check for 'force' flag.
-	if (isVersions || isRecursive || isStdin) && !isForce {
+	// For all recursive or versions bulk deletion operations make sure to check for 'force' flag,
+	// unless the removal is confirmed after a preview.
+	if (isVersions || isRecursive || isStdin) && !isForce && !isPreview {
 		fatalIf(errDummy().Trace(),
 			"Removal requires --force flag. This operation is *IRREVERSIBLE*. Please review carefully before performing this *DANGEROUS* operation.")
 	}
@@ -304,7 +353,7 @@ func removeSingle(url, versionID string, opts removeOpts) error {
 	}
 
 	// We should not proceed
-	if ignoreStatError && opts.olderThan != "" || opts.newerThan != "" {
+	if ignoreStatError && (opts.olderThan != "" || opts.newerThan != "") {
 		errorIf(pErr.Trace(url), "Unable to stat `"+url+"`.")
 		return exitStatus(globalErrorExitStatus)
 	}
@@ -331,6 +380,13 @@ func removeSingle(url, versionID string, opts removeOpts) error {
 			targetURL = targetURL + string(clnt.GetURL().Separator)
 		}
 
+		if opts.trash != "" && content != nil && !isDir && !isVersionedTarget(ctx, clnt) {
+			if pErr := trashObject(ctx, targetAlias, content.URL.Path, content, opts); pErr != nil {
+				errorIf(pErr.Trace(url), "Failed to move `"+url+"` to trash.")
+				return exitStatus(globalErrorExitStatus)
+			}
+		}
+
 		contentCh := make(chan *ClientContent, 1)
 		contentURL := *newClientURL(targetURL)
 		contentCh <- &ClientContent{URL: contentURL, VersionID: versionID}
@@ -375,6 +431,7 @@ type removeOpts struct {
 	isForceDel        bool
 	olderThan         string
 	newerThan         string
+	trash             string
 	encKeyDB          map[string][]prefixSSEPair
 }
 
@@ -415,6 +472,12 @@ func listAndRemove(url string, opts removeOpts) error {
 	}
 	atLeastOneObjectFound := false
 
+	// Removals on versioned buckets create delete markers,
+	// there is no need to keep a copy in the trash.
+	if opts.trash != "" && isVersionedTarget(ctx, clnt) {
+		opts.trash = ""
+	}
+
 	resultCh := clnt.Remove(ctx, opts.isIncomplete, isRemoveBucket, opts.isBypass, false, contentCh)
 
 	var lastPath string
@@ -531,6 +594,15 @@ func listAndRemove(url string, opts removeOpts) error {
 		}
 
 		if !opts.isFake {
+			if opts.trash != "" && !content.Type.IsDir() {
+				if pErr := trashObject(ctx, targetAlias, clnt.GetURL().Path, content, opts); pErr != nil {
+					path := path.Join(targetAlias, getKey(content))
+					errorIf(pErr.Trace(path), "Failed to move `"+path+"` to trash.")
+					close(contentCh)
+					return exitStatus(globalErrorExitStatus)
+				}
+			}
+
 			sent := false
 			for !sent {
 				select {
@@ -691,6 +763,7 @@ func mainRm(cliCtx *cli.Context) error {
 	withVersions := cliCtx.Bool("versions")
 	versionID := cliCtx.String("version-id")
 	rewind := parseRewindFlag(cliCtx.String("rewind"))
+	trash := cliCtx.String("trash")
 
 	if withVersions && rewind.IsZero() {
 		rewind = time.Now().UTC()
@@ -699,6 +772,22 @@ func mainRm(cliCtx *cli.Context) error {
 	// Set color.
 	console.SetColor("Removed", color.New(color.FgGreen, color.Bold))
 
+	if cliCtx.Bool("preview") {
+		opts := removeOpts{
+			timeRef:           rewind,
+			withVersions:      withVersions,
+			nonCurrentVersion: withNoncurrentVersion,
+			isRecursive:       isRecursive,
+			isIncomplete:      isIncomplete,
+			olderThan:         olderThan,
+			newerThan:         newerThan,
+			encKeyDB:          encKeyDB,
+		}
+		if !confirmRemove(ctx, cliCtx.Args(), versionID, opts, isForce) {
+			return nil
+		}
+	}
+
 	var rerr error
 	var e error
 	// Support multiple targets.
@@ -715,6 +804,7 @@ func mainRm(cliCtx *cli.Context) error {
 				isBypass:          isBypass,
 				olderThan:         olderThan,
 				newerThan:         newerThan,
+				trash:             trash,
 				encKeyDB:          encKeyDB,
 			})
 		} else {
@@ -726,6 +816,7 @@ func mainRm(cliCtx *cli.Context) error {
 				isBypass:     isBypass,
 				olderThan:    olderThan,
 				newerThan:    newerThan,
+				trash:        trash,
 				encKeyDB:     encKeyDB,
 			})
 		}
@@ -753,6 +844,7 @@ func mainRm(cliCtx *cli.Context) error {
 				isBypass:          isBypass,
 				olderThan:         olderThan,
 				newerThan:         newerThan,
+				trash:             trash,
 				encKeyDB:          encKeyDB,
 			})
 		} else {
@@ -764,6 +856,7 @@ func mainRm(cliCtx *cli.Context) error {
 				isBypass:     isBypass,
 				olderThan:    olderThan,
 				newerThan:    newerThan,
+				trash:        trash,
 				encKeyDB:     encKeyDB,
 			})
 		}
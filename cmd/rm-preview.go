@@ -0,0 +1,158 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// rmPreviewMessage is the summary of what a removal would delete.
+type rmPreviewMessage struct {
+	Status     string `json:"status"`
+	Objects    int64  `json:"objects"`
+	Size       int64  `json:"size"`
+	IsVersions bool   `json:"isVersions"`
+}
+
+// Colorized message for console printing.
+func (r rmPreviewMessage) String() string {
+	cnt := fmt.Sprintf("%d object", r.Objects)
+	if r.IsVersions {
+		cnt = fmt.Sprintf("%d version", r.Objects)
+	}
+	if r.Objects != 1 {
+		cnt += "s" // pluralize
+	}
+	return fmt.Sprintf("Found %s (%s) to remove.", console.Colorize("Removed", cnt),
+		humanize.IBytes(uint64(r.Size)))
+}
+
+// JSON'ified message for scripting.
+func (r rmPreviewMessage) JSON() string {
+	r.Status = "success"
+	msgBytes, e := json.MarshalIndent(r, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// isRemoveCandidate - applies the same filters as the removal itself.
+func isRemoveCandidate(content *ClientContent, opts removeOpts) bool {
+	// Skip prefix levels.
+	if content.Time.IsZero() || content.Type.IsDir() {
+		return false
+	}
+	if opts.nonCurrentVersion && content.IsLatest && !content.IsDeleteMarker {
+		return false
+	}
+	if opts.olderThan != "" && isOlder(content.Time, opts.olderThan) {
+		return false
+	}
+	if opts.newerThan != "" && isNewer(content.Time, opts.newerThan) {
+		return false
+	}
+	return true
+}
+
+// previewRemove - counts the objects and bytes which would be removed.
+func previewRemove(ctx context.Context, url, versionID string, opts removeOpts) (objects, size int64, err *probe.Error) {
+	if !opts.isRecursive && !opts.withVersions {
+		_, content, err := url2Stat(ctx, url, versionID, false, opts.encKeyDB, time.Time{}, false)
+		if err != nil {
+			return 0, 0, err.Trace(url)
+		}
+		if !isRemoveCandidate(content, opts) {
+			return 0, 0, nil
+		}
+		return 1, content.Size, nil
+	}
+
+	targetAlias, targetURL, _ := mustExpandAlias(url)
+	clnt, err := newClientFromAlias(targetAlias, targetURL)
+	if err != nil {
+		return 0, 0, err.Trace(url)
+	}
+
+	listOpts := ListOptions{Recursive: opts.isRecursive, Incomplete: opts.isIncomplete, ShowDir: DirNone}
+	if !opts.timeRef.IsZero() {
+		listOpts.WithOlderVersions = opts.withVersions
+		listOpts.WithDeleteMarkers = true
+		listOpts.TimeRef = opts.timeRef
+	}
+	for content := range clnt.List(ctx, listOpts) {
+		if content.Err != nil {
+			switch content.Err.ToGoError().(type) {
+			case PathInsufficientPermission:
+				// Ignore Permission error.
+				continue
+			}
+			return 0, 0, content.Err.Trace(url)
+		}
+		// rm command is not supposed to remove buckets, ignore if this is a bucket name
+		if content.URL.Type == objectStorage && strings.LastIndex(content.URL.Path, string(content.URL.Separator)) == 0 {
+			continue
+		}
+		if !opts.isRecursive && !strings.HasPrefix(url, getStandardizedURL(targetAlias+getKey(content))) {
+			break
+		}
+		if !isRemoveCandidate(content, opts) {
+			continue
+		}
+		objects++
+		size += content.Size
+	}
+	return objects, size, nil
+}
+
+// confirmRemove - prints what would be removed by all the targets and
+// asks for a confirmation, unless force is set. Returns false if the
+// removal should not proceed.
+func confirmRemove(ctx context.Context, urls []string, versionID string, opts removeOpts, force bool) bool {
+	msg := rmPreviewMessage{IsVersions: opts.withVersions}
+	for _, url := range urls {
+		objects, size, err := previewRemove(ctx, url, versionID, opts)
+		fatalIf(err, "Unable to preview removal of `"+url+"`.")
+		msg.Objects += objects
+		msg.Size += size
+	}
+	printMsg(msg)
+
+	if force {
+		return true
+	}
+	if msg.Objects == 0 {
+		return false
+	}
+
+	fmt.Print("Proceed with removal? y/N: ")
+	answer, e := bufio.NewReader(os.Stdin).ReadString('\n')
+	if e != nil {
+		return false
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y\n" || answer == "yes\n"
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/minio/mc/pkg/probe"
+)
+
+// trashKey - returns the key of an object relative to the removal
+// root, which is preserved under the trash prefix.
+func trashKey(rootPath, objectPath, separator string) string {
+	if !strings.HasSuffix(rootPath, separator) {
+		rootPath = rootPath[:strings.LastIndex(rootPath, separator)+1]
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(objectPath, rootPath), separator)
+}
+
+// isVersionedTarget - returns true if the bucket of the removal target
+// has versioning enabled, removals there already create delete markers
+// which can be undone, so objects are not moved to the trash.
+func isVersionedTarget(ctx context.Context, clnt Client) bool {
+	if clnt.GetURL().Type != objectStorage {
+		return false
+	}
+	vcfg, err := clnt.GetVersion(ctx)
+	if err != nil {
+		return false
+	}
+	return vcfg.Status == "Enabled"
+}
+
+// trashObject - copies an object to the trash prefix, keeping its key
+// relative to rootPath, before it is removed.
+func trashObject(ctx context.Context, sourceAlias, rootPath string, content *ClientContent, opts removeOpts) *probe.Error {
+	key := trashKey(rootPath, content.URL.Path, string(content.URL.Separator))
+	trashAlias, trashURL, _ := mustExpandAlias(urlJoinPath(opts.trash, key))
+
+	urls := uploadSourceToTargetURL(ctx, URLs{
+		SourceAlias:   sourceAlias,
+		SourceContent: content,
+		TargetAlias:   trashAlias,
+		TargetContent: &ClientContent{URL: *newClientURL(trashURL)},
+	}, nil, opts.encKeyDB, false, false)
+	return urls.Error
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestTrashKey(t *testing.T) {
+	testCases := []struct {
+		rootPath, objectPath, expected string
+	}{
+		{"/bucket/dir/", "/bucket/dir/a/b.txt", "a/b.txt"},
+		{"/bucket/dir", "/bucket/dir/a/b.txt", "dir/a/b.txt"},
+		{"/bucket/dir/b.txt", "/bucket/dir/b.txt", "b.txt"},
+		{"/bucket/", "/bucket/b.txt", "b.txt"},
+	}
+	for i, testCase := range testCases {
+		if got := trashKey(testCase.rootPath, testCase.objectPath, "/"); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// shareListMessage - share URLs of the objects listed in --files,
+// printed as a table or as a JSON array.
+type shareListMessage struct {
+	Keys     []string
+	Shares   []shareMesssage
+	TimeLeft time.Duration
+}
+
+// String - one line per object with its key and share URL.
+func (s shareListMessage) String() string {
+	var width int
+	for _, key := range s.Keys {
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+	var b strings.Builder
+	b.WriteString(console.Colorize("Expire", fmt.Sprintf("Expire: %s\n", timeDurationToHumanizedDuration(s.TimeLeft))))
+	for i, share := range s.Shares {
+		b.WriteString(console.Colorize("URL", fmt.Sprintf("%-*s", width, s.Keys[i])))
+		b.WriteString("  ")
+		b.WriteString(console.Colorize("Share", share.ShareURL))
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// JSON - array of share messages.
+func (s shareListMessage) JSON() string {
+	shares := make([]shareMesssage, len(s.Shares))
+	for i, share := range s.Shares {
+		share.Status = "success"
+		shares[i] = share
+	}
+	shareMessageBytes, e := json.MarshalIndent(shares, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	// Keep share URLs usable, see shareMesssage.JSON()
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u0026"), []byte("&"), -1)
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u003c"), []byte("<"), -1)
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u003e"), []byte(">"), -1)
+
+	return string(shareMessageBytes)
+}
+
+// doShareDownloadFiles - generates a share URL for every key listed in
+// manifest, keys are relative to targetURL. The manifest uses the format
+// of 'mc cp --files'. Objects are not checked for existence, invalid lines
+// are reported and skipped.
+func doShareDownloadFiles(ctx context.Context, targetURL, manifest string, expiry time.Duration) *probe.Error {
+	var reader io.Reader = os.Stdin
+	if manifest != "-" {
+		f, e := os.Open(manifest)
+		if e != nil {
+			return probe.NewError(e).Trace(manifest)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	// Load previously saved upload-shares. Add new entries and write it back.
+	shareDB := newShareDBV1()
+	shareDownloadsFile := getShareDownloadsFile()
+	if err := shareDB.Load(shareDownloadsFile); err != nil {
+		return err.Trace(shareDownloadsFile)
+	}
+
+	msg := shareListMessage{TimeLeft: expiry}
+	scanner := bufio.NewScanner(reader)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if isFilesComment(scanner.Text()) {
+			continue
+		}
+		key, e := parseFilesEntry(scanner.Text())
+		if e != nil {
+			errorIf(probe.NewError(e).Trace(manifest, scanner.Text()), fmt.Sprintf("Skipping line %d of `%s`.", lineNum, manifest))
+			continue
+		}
+		clnt, err := newClient(urlJoinPath(targetURL, key))
+		if err != nil {
+			return err.Trace(targetURL, key)
+		}
+		objectURL := clnt.GetURL().String()
+		shareURL, err := clnt.ShareDownload(ctx, "", expiry)
+		if err != nil {
+			return err.Trace(objectURL, "expiry="+expiry.String())
+		}
+		shareDB.Set(objectURL, shareURL, expiry, "")
+		msg.Keys = append(msg.Keys, key)
+		msg.Shares = append(msg.Shares, shareMesssage{
+			ObjectURL: objectURL,
+			ShareURL:  shareURL,
+			TimeLeft:  expiry,
+		})
+	}
+	if e := scanner.Err(); e != nil {
+		return probe.NewError(e).Trace(manifest)
+	}
+	printMsg(msg)
+
+	// Save downloads and return.
+	return shareDB.Save(shareDownloadsFile)
+}
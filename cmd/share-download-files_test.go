@@ -0,0 +1,52 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShareListMessage(t *testing.T) {
+	msg := shareListMessage{
+		Keys: []string{"a.txt", "dir/b.txt"},
+		Shares: []shareMesssage{
+			{ObjectURL: "http://localhost/bucket/a.txt", ShareURL: "http://localhost/bucket/a.txt?X=1&Y=2", TimeLeft: time.Hour},
+			{ObjectURL: "http://localhost/bucket/dir/b.txt", ShareURL: "http://localhost/bucket/dir/b.txt?X=3&Y=4", TimeLeft: time.Hour},
+		},
+		TimeLeft: time.Hour,
+	}
+
+	var shares []shareMesssage
+	if e := json.Unmarshal([]byte(msg.JSON()), &shares); e != nil {
+		t.Fatal(e)
+	}
+	if len(shares) != 2 || shares[1].Status != "success" || shares[1].ShareURL != msg.Shares[1].ShareURL {
+		t.Fatalf("unexpected JSON output %s", msg.JSON())
+	}
+	if strings.Contains(msg.JSON(), "\\u0026") {
+		t.Fatalf("share URLs must not be escaped: %s", msg.JSON())
+	}
+
+	lines := strings.Split(msg.String(), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "a.txt      http://localhost/bucket/a.txt?X=1&Y=2") {
+		t.Fatalf("unexpected table output %q", msg.String())
+	}
+}
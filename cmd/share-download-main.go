@@ -35,6 +35,10 @@ var shareDownloadFlags = []cli.Flag{
 		Name:  "version-id, vid",
 		Usage: "share a particular object version",
 	},
+	cli.StringFlag{
+		Name:  "files",
+		Usage: "share objects listed in a file, one key relative to TARGET per line, lines starting with '#' are ignored ('-' for STDIN)",
+	},
 	shareFlagExpire,
 }
 
@@ -67,6 +71,9 @@ EXAMPLES:
 
   4. Share all objects under this bucket and all its folders and sub-folders with 5 days expiry.
      {{.Prompt}} {{.HelpName}} --recursive --expire=120h s3/backup/
+
+  5. Share the objects listed in 'keys.txt', relative to this folder, with 2 days expiry.
+     {{.Prompt}} {{.HelpName}} --files keys.txt --expire=48h s3/backup/2006-Mar-1/
 `,
 }
 
@@ -101,8 +108,14 @@ func checkShareDownloadSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB
 		fatalIf(errDummy().Trace(), "--version-id cannot be specified with --recursive flag.")
 	}
 
-	// Validate if object exists only if the `--recursive` flag was NOT specified
-	if !isRecursive {
+	filesManifest := cliCtx.String("files")
+	if filesManifest != "" && (len(args) != 1 || isRecursive || versionID != "") {
+		fatalIf(errDummy().Trace(args...), "--files requires a single target folder and cannot be used with --recursive and --version-id.")
+	}
+
+	// Validate if object exists only if the `--recursive` flag was NOT specified,
+	// objects listed in --files are not checked.
+	if !isRecursive && filesManifest == "" {
 		for _, url := range cliCtx.Args() {
 			_, _, err := url2Stat(ctx, url, "", false, encKeyDB, time.Time{}, false)
 			if err != nil {
@@ -228,7 +241,12 @@ func mainShareDownload(cliCtx *cli.Context) error {
 	}
 
 	for _, targetURL := range cliCtx.Args() {
-		err := doShareDownloadURL(ctx, targetURL, versionID, isRecursive, expiry)
+		var err *probe.Error
+		if filesManifest := cliCtx.String("files"); filesManifest != "" {
+			err = doShareDownloadFiles(ctx, targetURL, filesManifest, expiry)
+		} else {
+			err = doShareDownloadURL(ctx, targetURL, versionID, isRecursive, expiry)
+		}
 		if err != nil {
 			switch err.ToGoError().(type) {
 			case APINotImplemented:
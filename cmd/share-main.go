@@ -31,6 +31,7 @@ var shareFlags = []cli.Flag{}
 var shareSubcommands = []cli.Command{
 	shareDownload,
 	shareUpload,
+	sharePresign,
 	shareList,
 }
 
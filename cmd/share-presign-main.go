@@ -0,0 +1,282 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+var sharePresignFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "method, X",
+		Value: http.MethodGet,
+		Usage: "HTTP method of the presigned URL, one of GET, PUT, HEAD or DELETE",
+	},
+	cli.StringSliceFlag{
+		Name:  "header, H",
+		Usage: "sign an HTTP header as KEY=VALUE, the request must send it as is",
+	},
+	cli.StringFlag{
+		Name:  "version-id, vid",
+		Usage: "presign a particular object version",
+	},
+	shareFlagExpire,
+}
+
+// Presign object URLs for any HTTP method.
+var sharePresign = cli.Command{
+	Name:         "presign",
+	Usage:        "generate a presigned URL and `curl` command for a given HTTP method",
+	Action:       mainSharePresign,
+	OnUsageError: onUsageError,
+	Before:       setGlobalsFromContext,
+	Flags:        append(sharePresignFlags, globalFlags...),
+	CustomHelpTemplate: `NAME:
+  {{.HelpName}} - {{.Usage}}
+
+USAGE:
+  {{.HelpName}} [FLAGS] TARGET [TARGET...]
+
+FLAGS:
+  {{range .VisibleFlags}}{{.}}
+  {{end}}
+EXAMPLES:
+  1. Generate a presigned URL to upload a PNG image with a PUT request. URL expires in 1 hour.
+     {{.Prompt}} {{.HelpName}} --method PUT --header Content-Type=image/png --expire 1h myminio/bucket/photo.png
+
+  2. Generate a presigned URL to delete an object. URL expires in 7 days (default).
+     {{.Prompt}} {{.HelpName}} --method DELETE myminio/bucket/old.log
+
+  3. Generate a presigned URL to read the metadata of a particular object version.
+     {{.Prompt}} {{.HelpName}} --method HEAD --version-id "3ddac055-89a7-40fa-8cd3-530a5581b6b8" myminio/bucket/photo.png
+`,
+}
+
+// Structured share presign command message.
+type sharePresignMessage struct {
+	Status    string            `json:"status"`
+	ObjectURL string            `json:"url"`
+	Method    string            `json:"method"`
+	ShareURL  string            `json:"share"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Curl      string            `json:"curl"`
+	TimeLeft  time.Duration     `json:"timeLeft"`
+}
+
+// String - Themefied string message for console printing.
+func (s sharePresignMessage) String() string {
+	msg := console.Colorize("URL", fmt.Sprintf("URL: %s\n", s.ObjectURL))
+	msg += console.Colorize("Expire", fmt.Sprintf("Expire: %s\n", timeDurationToHumanizedDuration(s.TimeLeft)))
+	msg += console.Colorize("Content-type", fmt.Sprintf("Method: %s\n", s.Method))
+	for _, k := range sortedHeaderKeys(s.Headers) {
+		msg += console.Colorize("Content-type", fmt.Sprintf("Header: %s: %s\n", k, s.Headers[k]))
+	}
+	msg += console.Colorize("Share", fmt.Sprintf("Share: %s\n", s.ShareURL))
+	msg += console.Colorize("Share", fmt.Sprintf("Curl: %s\n", strings.Replace(s.Curl, "<FILE>", console.Colorize("File", "<FILE>"), 1)))
+	return msg
+}
+
+// JSON - JSONified message for scripting.
+func (s sharePresignMessage) JSON() string {
+	s.Status = "success"
+	shareMessageBytes, e := json.MarshalIndent(s, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	// Keep share URLs usable, see shareMesssage.JSON()
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u0026"), []byte("&"), -1)
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u003c"), []byte("<"), -1)
+	shareMessageBytes = bytes.Replace(shareMessageBytes, []byte("\\u003e"), []byte(">"), -1)
+
+	return string(shareMessageBytes)
+}
+
+func sortedHeaderKeys(headers map[string]string) []string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parsePresignHeaders - parses KEY=VALUE pairs of --header.
+func parsePresignHeaders(values []string) (map[string]string, *probe.Error) {
+	headers := make(map[string]string)
+	for _, value := range values {
+		k, v, ok := strings.Cut(value, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, errInvalidArgument().Trace(value)
+		}
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	return headers, nil
+}
+
+// makePresignCurlCmd constructs the curl command-line replaying the
+// signed headers of a presigned URL.
+func makePresignCurlCmd(method, shareURL string, headers map[string]string) string {
+	curlCommand := "curl"
+	switch method {
+	case http.MethodHead:
+		curlCommand += " -I"
+	case http.MethodGet:
+	default:
+		curlCommand += " -X " + method
+	}
+	for _, k := range sortedHeaderKeys(headers) {
+		curlCommand += " -H " + shellQuote(k+": "+headers[k])
+	}
+	switch method {
+	case http.MethodPut:
+		curlCommand += " -T <FILE>" // File to upload.
+	case http.MethodGet:
+		curlCommand += " -o <FILE>" // File to download to.
+	}
+	return curlCommand + " " + shellQuote(shareURL)
+}
+
+// checkSharePresignSyntax - validate command-line args.
+func checkSharePresignSyntax(cliCtx *cli.Context) {
+	if !cliCtx.Args().Present() {
+		showCommandHelpAndExit(cliCtx, 1) // last argument is exit code.
+	}
+
+	switch strings.ToUpper(cliCtx.String("method")) {
+	case http.MethodGet, http.MethodPut, http.MethodHead, http.MethodDelete:
+	default:
+		fatalIf(errInvalidArgument().Trace(cliCtx.String("method")), "Unsupported method, use one of GET, PUT, HEAD or DELETE.")
+	}
+
+	// Parse expiry.
+	expiry := shareDefaultExpiry
+	expireArg := cliCtx.String("expire")
+	if expireArg != "" {
+		var e error
+		expiry, e = time.ParseDuration(expireArg)
+		fatalIf(probe.NewError(e), "Unable to parse expire=`"+expireArg+"`.")
+	}
+
+	// Validate expiry.
+	if expiry.Seconds() < 1 {
+		fatalIf(errDummy().Trace(expiry.String()), "Expiry cannot be lesser than 1 second.")
+	}
+	if expiry.Seconds() > 604800 {
+		fatalIf(errDummy().Trace(expiry.String()), "Expiry cannot be larger than 7 days.")
+	}
+
+	for _, targetURL := range cliCtx.Args() {
+		url := newClientURL(targetURL)
+		if strings.HasSuffix(targetURL, string(url.Separator)) {
+			fatalIf(errInvalidArgument().Trace(targetURL), "Presigned URLs can only be generated for objects.")
+		}
+	}
+}
+
+// doSharePresignURL generates a presigned URL for targetURL.
+func doSharePresignURL(ctx context.Context, targetURL, method, versionID string, headers map[string]string, expiry time.Duration) *probe.Error {
+	clnt, err := newClient(targetURL)
+	if err != nil {
+		return err.Trace(targetURL)
+	}
+
+	signedHeaders := make(http.Header)
+	for k, v := range headers {
+		signedHeaders.Set(k, v)
+	}
+	shareURL, err := clnt.SharePresign(ctx, method, versionID, expiry, signedHeaders)
+	if err != nil {
+		return err.Trace(targetURL, "method="+method, "expiry="+expiry.String())
+	}
+
+	// Get the new expanded url.
+	objectURL := clnt.GetURL().String()
+	curlCmd := makePresignCurlCmd(method, shareURL, headers)
+	printMsg(sharePresignMessage{
+		ObjectURL: objectURL,
+		Method:    method,
+		ShareURL:  shareURL,
+		Headers:   headers,
+		Curl:      curlCmd,
+		TimeLeft:  expiry,
+	})
+
+	// Downloads and uploads are listed by 'mc share list'.
+	switch method {
+	case http.MethodGet:
+		shareDB := newShareDBV1()
+		if err = shareDB.Load(getShareDownloadsFile()); err != nil {
+			return err.Trace(getShareDownloadsFile())
+		}
+		shareDB.Set(objectURL, shareURL, expiry, headers["Content-Type"])
+		return shareDB.Save(getShareDownloadsFile())
+	case http.MethodPut:
+		return saveSharedURL(objectURL, curlCmd, expiry, headers["Content-Type"])
+	}
+	return nil
+}
+
+// main for share presign command.
+func mainSharePresign(cliCtx *cli.Context) error {
+	ctx, cancelSharePresign := context.WithCancel(globalContext)
+	defer cancelSharePresign()
+
+	// check input arguments.
+	checkSharePresignSyntax(cliCtx)
+
+	// Initialize share config folder.
+	initShareConfig()
+
+	// Additional command speific theme customization.
+	shareSetColor()
+
+	method := strings.ToUpper(cliCtx.String("method"))
+	versionID := cliCtx.String("version-id")
+	headers, err := parsePresignHeaders(cliCtx.StringSlice("header"))
+	fatalIf(err, "Invalid --header, use KEY=VALUE.")
+	expiry := shareDefaultExpiry
+	if cliCtx.String("expire") != "" {
+		var e error
+		expiry, e = time.ParseDuration(cliCtx.String("expire"))
+		fatalIf(probe.NewError(e), "Unable to parse expire=`"+cliCtx.String("expire")+"`.")
+	}
+
+	for _, targetURL := range cliCtx.Args() {
+		err := doSharePresignURL(ctx, targetURL, method, versionID, headers, expiry)
+		if err != nil {
+			switch err.ToGoError().(type) {
+			case APINotImplemented:
+				fatalIf(err.Trace(), "Unable to share a non S3 url `"+targetURL+"`.")
+			default:
+				fatalIf(err.Trace(targetURL), "Unable to generate presigned URL for `"+targetURL+"`.")
+			}
+		}
+	}
+	return nil
+}
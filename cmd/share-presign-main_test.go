@@ -0,0 +1,68 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePresignHeaders(t *testing.T) {
+	testCases := []struct {
+		values   []string
+		expected map[string]string
+		success  bool
+	}{
+		{nil, map[string]string{}, true},
+		{[]string{"content-type=image/png", "x-amz-meta-tag=a=b"}, map[string]string{"Content-Type": "image/png", "X-Amz-Meta-Tag": "a=b"}, true},
+		{[]string{"Content-Type"}, nil, false},
+		{[]string{"=value"}, nil, false},
+	}
+	for i, testCase := range testCases {
+		headers, err := parsePresignHeaders(testCase.values)
+		if testCase.success != (err == nil) {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if testCase.success && !reflect.DeepEqual(headers, testCase.expected) {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, headers)
+		}
+	}
+}
+
+func TestMakePresignCurlCmd(t *testing.T) {
+	shareURL := "http://localhost:9000/bucket/object?X-Amz-Expires=3600&X-Amz-Signature=abc"
+	testCases := []struct {
+		method   string
+		headers  map[string]string
+		expected string
+	}{
+		{"GET", nil, `curl -o <FILE> http://localhost:9000/bucket/object?X-Amz-Expires=3600\&X-Amz-Signature=abc`},
+		{"HEAD", nil, `curl -I http://localhost:9000/bucket/object?X-Amz-Expires=3600\&X-Amz-Signature=abc`},
+		{"DELETE", nil, `curl -X DELETE http://localhost:9000/bucket/object?X-Amz-Expires=3600\&X-Amz-Signature=abc`},
+		{
+			"PUT",
+			map[string]string{"X-Amz-Meta-A": "b", "Content-Type": "image/png"},
+			`curl -X PUT -H Content-Type:\ image/png -H X-Amz-Meta-A:\ b -T <FILE> http://localhost:9000/bucket/object?X-Amz-Expires=3600\&X-Amz-Signature=abc`,
+		},
+	}
+	for i, testCase := range testCases {
+		if got := makePresignCurlCmd(testCase.method, shareURL, testCase.headers); got != testCase.expected {
+			t.Fatalf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
@@ -20,8 +20,22 @@ package cmd
 import (
 	"os"
 	"os/signal"
+	"sync"
 )
 
+var (
+	signalHooksMu sync.Mutex
+	signalHooks   []func()
+)
+
+// registerSignalHook - registers f to be run once a trapped signal is
+// received, after the global context is canceled and before exiting.
+func registerSignalHook(f func()) {
+	signalHooksMu.Lock()
+	defer signalHooksMu.Unlock()
+	signalHooks = append(signalHooks, f)
+}
+
 // trapSignals traps the registered signals and cancel the global context.
 func trapSignals(sig ...os.Signal) {
 	// channel to receive signals.
@@ -45,6 +59,12 @@ func trapSignals(sig ...os.Signal) {
 	// Cancel the global context
 	globalCancel()
 
+	signalHooksMu.Lock()
+	for _, f := range signalHooks {
+		f()
+	}
+	signalHooksMu.Unlock()
+
 	var exitCode int
 	switch s.String() {
 	case "interrupt":
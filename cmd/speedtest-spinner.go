@@ -97,7 +97,7 @@ func (m *speedTestUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 	case PerfTestResult:
-		m.result = msg
+		m.result = filterPerfTestNodes(msg)
 		if msg.Final {
 			m.quitting = true
 			return m, tea.Quit
@@ -182,7 +182,7 @@ func (m *speedTestUI) View() string {
 
 		if m.quitting {
 			s.WriteString("\n" + objectTestShortResult(ores))
-			if globalPerfTestVerbose {
+			if globalPerfTestVerbose || globalPerfTestOnlyErrors {
 				s.WriteString("\n\n")
 				s.WriteString(objectTestVerboseResult(ores))
 			}
@@ -201,6 +201,9 @@ func (m *speedTestUI) View() string {
 			})
 		} else {
 			for _, nodeResult := range nres.NodeResults {
+				if globalPerfTestOnlyErrors && nodeResult.Error == "" {
+					continue
+				}
 				if nodeResult.Error != "" {
 					data = append(data, []string{
 						trailerIfGreaterThan(nodeResult.Endpoint, 64),
@@ -219,6 +222,20 @@ func (m *speedTestUI) View() string {
 			}
 		}
 
+		if len(data) == 0 {
+			// Only the final result tells that no server reported an error.
+			if m.quitting {
+				s.WriteString(perfAllServersHealthy + "\n")
+				return s.String()
+			}
+			data = append(data, []string{
+				"...",
+				whiteStyle.Render("-- MiB/s"),
+				whiteStyle.Render("-- MiB/s"),
+				"",
+			})
+		}
+
 		sort.Slice(data, func(i, j int) bool {
 			return data[i][0] < data[j][0]
 		})
@@ -239,7 +256,19 @@ func (m *speedTestUI) View() string {
 			})
 		} else {
 			for _, driveResult := range dres {
+				if driveResult.Error != "" {
+					data = append(data, []string{
+						trailerIfGreaterThan(driveResult.Endpoint, 64),
+						"",
+						crossTickCell,
+						crossTickCell,
+						"Err: " + driveResult.Error,
+					})
+				}
 				for _, result := range driveResult.DrivePerf {
+					if globalPerfTestOnlyErrors && result.Error == "" {
+						continue
+					}
 					if result.Error != "" {
 						data = append(data, []string{
 							trailerIfGreaterThan(driveResult.Endpoint, 64),
@@ -260,6 +289,19 @@ func (m *speedTestUI) View() string {
 				}
 			}
 		}
+		if len(data) == 0 {
+			if m.quitting {
+				s.WriteString(perfAllServersHealthy + "\n")
+				return s.String()
+			}
+			data = append(data, []string{
+				"...",
+				"...",
+				whiteStyle.Render("-- KiB/s"),
+				whiteStyle.Render("-- KiB/s"),
+				"",
+			})
+		}
 		table.AppendBulk(data)
 		table.Render()
 	}
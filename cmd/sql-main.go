@@ -72,6 +72,18 @@ var sqlFlags = []cli.Flag{
 		Name:  "json-output",
 		Usage: "json output serialization option",
 	},
+	cli.StringFlag{
+		Name:  "output-format",
+		Usage: "write the query result in the given file format to --output, supported: parquet",
+	},
+	cli.StringFlag{
+		Name:  "output",
+		Usage: "local path or object URL to write the query result to, requires --output-format",
+	},
+	cli.StringFlag{
+		Name:  "parquet-schema",
+		Usage: "comma separated name:type columns of the parquet output, inferred from the first rows if not set",
+	},
 }
 
 // Display contents of a file.
@@ -123,6 +135,11 @@ EXAMPLES:
      {{.Prompt}} {{.HelpName}} --compression GZIP --csv-input "rd=\n,fh=USE,fd=;" \
          --csv-output "rd=\n" --csv-output-header "device_id,uptime,lat,lon" \
          --query "select * from S3Object" myminio/iot-devices/data.csv
+
+  7. Write the result of a query to a Parquet file on MinIO with an explicit schema.
+     {{.Prompt}} {{.HelpName}} --csv-input "fh=USE" --output-format parquet --output myminio/iot-devices/power.parquet \
+         --parquet-schema "device_id:string,power:double" \
+         --query "select s.device_id, s.power from S3Object s" myminio/iot-devices/power-ratio.csv
 `,
 }
 
@@ -278,6 +295,15 @@ func getOutputSerializationOpts(ctx *cli.Context, csvHdrs []string) (opts map[st
 	csvType := ctx.IsSet("csv-output")
 	jsonType := ctx.IsSet("json-output")
 
+	if isParquetOutput(ctx) {
+		if csvType || jsonType || len(csvHdrs) > 0 {
+			fatalIf(errInvalidArgument(), "--csv-output, --json-output and --csv-output-header cannot be used with --output-format parquet")
+		}
+		// Parquet rows are converted from JSON records.
+		m["json"] = map[string]string{}
+		return m
+	}
+
 	if csvType && jsonType {
 		fatalIf(errInvalidArgument(), "Only one of --csv-output, or --json-output can be specified as output serialization option")
 	}
@@ -382,7 +408,7 @@ func isCSVOrJSON(inOpts map[string]map[string]string) bool {
 	return false
 }
 
-func sqlSelect(targetURL, expression string, encKeyDB map[string][]prefixSSEPair, selOpts SelectObjectOpts, csvHdrs []string, writeHdr bool) *probe.Error {
+func sqlSelect(targetURL, expression string, encKeyDB map[string][]prefixSSEPair, selOpts SelectObjectOpts, csvHdrs []string, writeHdr bool, out io.Writer) *probe.Error {
 	ctx, cancelSelect := context.WithCancel(globalContext)
 	defer cancelSelect()
 
@@ -403,11 +429,11 @@ func sqlSelect(targetURL, expression string, encKeyDB map[string][]prefixSSEPair
 	}
 	defer outputer.Close()
 
-	// write csv header to stdout
+	// write csv header to output
 	if len(csvHdrs) > 0 && writeHdr {
-		fmt.Println(strings.Join(csvHdrs, ","))
+		fmt.Fprintln(out, strings.Join(csvHdrs, ","))
 	}
-	_, e := io.Copy(os.Stdout, outputer)
+	_, e := io.Copy(out, outputer)
 	return probe.NewError(e)
 }
 
@@ -427,11 +453,25 @@ func getAndValidateArgs(ctx *cli.Context, encKeyDB map[string][]prefixSSEPair, u
 	return
 }
 
+// returns true if the query result is written as a Parquet file
+func isParquetOutput(ctx *cli.Context) bool {
+	return strings.EqualFold(ctx.String("output-format"), "parquet")
+}
+
 // check sql input arguments.
 func checkSQLSyntax(ctx *cli.Context) {
 	if len(ctx.Args()) == 0 {
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code.
 	}
+	if ctx.IsSet("output-format") && !isParquetOutput(ctx) {
+		fatalIf(errInvalidArgument().Trace(ctx.String("output-format")), "Unsupported --output-format, only `parquet` is supported.")
+	}
+	if isParquetOutput(ctx) && ctx.String("output") == "" {
+		fatalIf(errInvalidArgument(), "--output is required with --output-format parquet.")
+	}
+	if !isParquetOutput(ctx) && (ctx.IsSet("output") || ctx.IsSet("parquet-schema")) {
+		fatalIf(errInvalidArgument(), "--output and --parquet-schema require --output-format parquet.")
+	}
 }
 
 // mainSQL is the main entry point for sql command.
@@ -453,6 +493,21 @@ func mainSQL(cliCtx *cli.Context) error {
 	// extract URLs.
 	URLs := cliCtx.Args()
 	writeHdr := true
+
+	var out io.Writer = os.Stdout
+	if isParquetOutput(cliCtx) {
+		var columns []parquetColumn
+		if schema := cliCtx.String("parquet-schema"); schema != "" {
+			var e error
+			columns, e = parseParquetSchema(schema)
+			fatalIf(probe.NewError(e).Trace(schema), "Invalid --parquet-schema.")
+		}
+		w, wait := sqlParquetOutput(cliCtx.String("output"), columns)
+		defer func() {
+			fatalIf(wait().Trace(cliCtx.String("output")), "Unable to write parquet output.")
+		}()
+		out = w
+	}
 	for _, url := range URLs {
 		if _, targetContent, err := url2Stat(ctx, url, "", false, encKeyDB, time.Time{}, false); err != nil {
 			errorIf(err.Trace(url), "Unable to run sql for "+url+".")
@@ -461,7 +516,7 @@ func mainSQL(cliCtx *cli.Context) error {
 			if writeHdr {
 				query, csvHdrs, selOpts = getAndValidateArgs(cliCtx, encKeyDB, url)
 			}
-			errorIf(sqlSelect(url, query, encKeyDB, selOpts, csvHdrs, writeHdr).Trace(url), "Unable to run sql")
+			errorIf(sqlSelect(url, query, encKeyDB, selOpts, csvHdrs, writeHdr, out).Trace(url), "Unable to run sql")
 			writeHdr = false
 			continue
 		}
@@ -484,7 +539,7 @@ func mainSQL(cliCtx *cli.Context) error {
 			for _, cTypeSuffix := range supportedContentTypes {
 				if strings.Contains(contentType, cTypeSuffix) {
 					errorIf(sqlSelect(targetAlias+content.URL.Path, query,
-						encKeyDB, selOpts, csvHdrs, writeHdr).Trace(content.URL.String()), "Unable to run sql")
+						encKeyDB, selOpts, csvHdrs, writeHdr, out).Trace(content.URL.String()), "Unable to run sql")
 				}
 				writeHdr = false
 			}
@@ -0,0 +1,400 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/minio/mc/pkg/probe"
+)
+
+// Number of records used to infer the Parquet schema when no
+// --parquet-schema is given.
+const parquetSchemaInferRecords = 100
+
+// Size of the row groups of the Parquet output.
+const parquetRowGroupSize = 64 * humanize.MiByte
+
+// parquetType - the type of the values of a Parquet output column.
+type parquetType int
+
+// Supported Parquet output column types.
+const (
+	parquetBoolean parquetType = iota
+	parquetInt64
+	parquetDouble
+	parquetString
+)
+
+func (t parquetType) String() string {
+	switch t {
+	case parquetBoolean:
+		return "boolean"
+	case parquetInt64:
+		return "int64"
+	case parquetDouble:
+		return "double"
+	case parquetString:
+		return "string"
+	}
+	return "unknown"
+}
+
+// parquetColumn - an optional column of the Parquet output.
+type parquetColumn struct {
+	Name string
+	Type parquetType
+}
+
+// parquetSchema - returns the Parquet schema definition of columns.
+func parquetSchema(columns []parquetColumn) (*parquetschema.SchemaDefinition, error) {
+	root := &parquetschema.ColumnDefinition{
+		SchemaElement: &parquet.SchemaElement{Name: "schema"},
+	}
+	for _, column := range columns {
+		element := &parquet.SchemaElement{
+			Name:           column.Name,
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+		}
+		switch column.Type {
+		case parquetBoolean:
+			element.Type = parquet.TypePtr(parquet.Type_BOOLEAN)
+		case parquetInt64:
+			element.Type = parquet.TypePtr(parquet.Type_INT64)
+		case parquetDouble:
+			element.Type = parquet.TypePtr(parquet.Type_DOUBLE)
+		default:
+			element.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+			element.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+		}
+		root.Children = append(root.Children, &parquetschema.ColumnDefinition{SchemaElement: element})
+	}
+	sd := parquetschema.SchemaDefinitionFromColumnDefinition(root)
+	if e := sd.Validate(); e != nil {
+		return nil, e
+	}
+	return sd, nil
+}
+
+// parseParquetSchema - parses a --parquet-schema value of the form
+// "name:type,name:type", valid types are string, int64, double and
+// boolean.
+func parseParquetSchema(schema string) ([]parquetColumn, error) {
+	var columns []parquetColumn
+	seen := map[string]bool{}
+	for _, field := range strings.Split(schema, ",") {
+		name, typ, ok := strings.Cut(strings.TrimSpace(field), ":")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field `%s`, expected name:type", field)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate field `%s`", name)
+		}
+		seen[name] = true
+		column := parquetColumn{Name: name}
+		switch strings.ToLower(typ) {
+		case "string":
+			column.Type = parquetString
+		case "int64":
+			column.Type = parquetInt64
+		case "double":
+			column.Type = parquetDouble
+		case "boolean":
+			column.Type = parquetBoolean
+		default:
+			return nil, fmt.Errorf("unsupported type `%s` of field `%s`", typ, name)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// sqlRecord - a JSON record returned by S3 Select, keys are kept in
+// the order they appear in.
+type sqlRecord struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+// parseSQLRecord - decodes a JSON record returned by S3 Select.
+func parseSQLRecord(line []byte) (sqlRecord, error) {
+	r := sqlRecord{values: map[string]json.RawMessage{}}
+	dec := json.NewDecoder(bytes.NewReader(line))
+	if tok, e := dec.Token(); e != nil || tok != json.Delim('{') {
+		return r, fmt.Errorf("record is not a JSON object: %s", line)
+	}
+	for dec.More() {
+		tok, e := dec.Token()
+		if e != nil {
+			return r, e
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if e = dec.Decode(&value); e != nil {
+			return r, e
+		}
+		if _, ok := r.values[key]; !ok {
+			r.keys = append(r.keys, key)
+		}
+		r.values[key] = value
+	}
+	return r, nil
+}
+
+// inferParquetType - returns the column type of a JSON value, the
+// second return value is false for null.
+func inferParquetType(value json.RawMessage) (parquetType, bool) {
+	switch {
+	case len(value) == 0 || string(value) == "null":
+		return parquetString, false
+	case string(value) == "true" || string(value) == "false":
+		return parquetBoolean, true
+	case value[0] == '-' || (value[0] >= '0' && value[0] <= '9'):
+		if bytes.ContainsAny(value, ".eE") {
+			return parquetDouble, true
+		}
+		return parquetInt64, true
+	}
+	return parquetString, true
+}
+
+// inferParquetSchema - returns the columns of records, in the order
+// their keys first appear in. Integer columns with decimal values are
+// doubles, columns mixing other types or only holding nulls are strings.
+func inferParquetSchema(records []sqlRecord) []parquetColumn {
+	var columns []parquetColumn
+	index := map[string]int{}
+	known := map[string]bool{}
+	for _, r := range records {
+		for _, key := range r.keys {
+			i, ok := index[key]
+			if !ok {
+				i = len(columns)
+				index[key] = i
+				columns = append(columns, parquetColumn{Name: key, Type: parquetString})
+			}
+			typ, ok := inferParquetType(r.values[key])
+			if !ok {
+				continue
+			}
+			switch {
+			case !known[key]:
+				columns[i].Type = typ
+				known[key] = true
+			case columns[i].Type == typ:
+			case columns[i].Type == parquetInt64 && typ == parquetDouble,
+				columns[i].Type == parquetDouble && typ == parquetInt64:
+				columns[i].Type = parquetDouble
+			default:
+				columns[i].Type = parquetString
+			}
+		}
+	}
+	return columns
+}
+
+// parquetValue - converts a JSON value to the Go type of a column,
+// strings are returned as bytes,
+// numbers and booleans may also be quoted as returned for CSV objects.
+func parquetValue(value json.RawMessage, typ parquetType) (interface{}, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return nil, nil
+	}
+	text := string(value)
+	if value[0] == '"' {
+		if e := json.Unmarshal(value, &text); e != nil {
+			return nil, e
+		}
+		if typ != parquetString && text == "" {
+			return nil, nil
+		}
+	}
+	switch typ {
+	case parquetBoolean:
+		return strconv.ParseBool(text)
+	case parquetInt64:
+		return strconv.ParseInt(text, 10, 64)
+	case parquetDouble:
+		return strconv.ParseFloat(text, 64)
+	}
+	return []byte(text), nil
+}
+
+// sqlParquetWriter - receives the JSON records returned by S3 Select
+// and writes them as rows of a Parquet file.
+type sqlParquetWriter struct {
+	out     io.Writer
+	columns []parquetColumn
+	fw      *goparquet.FileWriter
+	pending []byte
+	records []sqlRecord
+	nrecord int
+	err     error
+}
+
+// newSQLParquetWriter - returns a writer of the Parquet file to out,
+// if columns is empty the schema is inferred from the first records.
+func newSQLParquetWriter(out io.Writer, columns []parquetColumn) *sqlParquetWriter {
+	return &sqlParquetWriter{out: out, columns: columns}
+}
+
+// Write implements io.Writer, records are separated by new lines.
+func (w *sqlParquetWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := bytes.TrimSpace(w.pending[:i])
+		w.pending = w.pending[i+1:]
+		if len(line) == 0 {
+			continue
+		}
+		if w.err = w.addRecord(line); w.err != nil {
+			return 0, w.err
+		}
+	}
+}
+
+func (w *sqlParquetWriter) addRecord(line []byte) error {
+	w.nrecord++
+	r, e := parseSQLRecord(line)
+	if e != nil {
+		return fmt.Errorf("record %d: %v", w.nrecord, e)
+	}
+	if w.fw == nil {
+		w.records = append(w.records, r)
+		if len(w.columns) == 0 && len(w.records) < parquetSchemaInferRecords {
+			return nil
+		}
+		return w.start()
+	}
+	return w.writeRecord(r, w.nrecord)
+}
+
+// start creates the Parquet writer once the schema is known and
+// writes the buffered records.
+func (w *sqlParquetWriter) start() error {
+	if len(w.columns) == 0 {
+		w.columns = inferParquetSchema(w.records)
+	}
+	if len(w.columns) == 0 {
+		return fmt.Errorf("unable to infer the schema of empty records, please use --parquet-schema")
+	}
+	sd, e := parquetSchema(w.columns)
+	if e != nil {
+		return e
+	}
+	w.fw = goparquet.NewFileWriter(w.out,
+		goparquet.WithSchemaDefinition(sd),
+		goparquet.WithCreator("mc"),
+		goparquet.WithMaxRowGroupSize(parquetRowGroupSize),
+	)
+	first := w.nrecord - len(w.records) + 1
+	for i, r := range w.records {
+		if e = w.writeRecord(r, first+i); e != nil {
+			return e
+		}
+	}
+	w.records = nil
+	return nil
+}
+
+func (w *sqlParquetWriter) writeRecord(r sqlRecord, n int) error {
+	for _, key := range r.keys {
+		if !w.hasColumn(key) {
+			return fmt.Errorf("record %d: field `%s` is not part of the schema, please use --parquet-schema", n, key)
+		}
+	}
+	row := make(map[string]interface{}, len(w.columns))
+	for _, column := range w.columns {
+		v, e := parquetValue(r.values[column.Name], column.Type)
+		if e != nil {
+			return fmt.Errorf("record %d: field `%s` is not a valid %s: %v", n, column.Name, column.Type, e)
+		}
+		// Null values are left out of the row.
+		if v != nil {
+			row[column.Name] = v
+		}
+	}
+	return w.fw.AddData(row)
+}
+
+func (w *sqlParquetWriter) hasColumn(name string) bool {
+	for _, column := range w.columns {
+		if column.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Close writes the remaining records and the Parquet footer.
+func (w *sqlParquetWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if line := bytes.TrimSpace(w.pending); len(line) > 0 {
+		w.pending = nil
+		if e := w.addRecord(line); e != nil {
+			return e
+		}
+	}
+	if w.fw == nil {
+		if e := w.start(); e != nil {
+			return e
+		}
+	}
+	return w.fw.Close()
+}
+
+// sqlParquetOutput - uploads the Parquet file written by the
+// returned writer to targetURL, wait returns the upload result once
+// the writer is closed.
+func sqlParquetOutput(targetURL string, columns []parquetColumn) (w *sqlParquetWriter, wait func() *probe.Error) {
+	pr, pw := io.Pipe()
+	doneCh := make(chan *probe.Error, 1)
+	go func() {
+		_, err := putTargetStreamWithURL(targetURL, pr, -1, PutOptions{})
+		pr.CloseWithError(err.ToGoError())
+		doneCh <- err
+	}()
+	w = newSQLParquetWriter(pw, columns)
+	return w, func() *probe.Error {
+		if e := w.Close(); e != nil {
+			pw.CloseWithError(e)
+			<-doneCh
+			return probe.NewError(e)
+		}
+		pw.Close()
+		return <-doneCh
+	}
+}
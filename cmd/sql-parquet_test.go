@@ -0,0 +1,166 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	goparquet "github.com/fraugster/parquet-go"
+)
+
+func TestParseParquetSchema(t *testing.T) {
+	testCases := []struct {
+		schema   string
+		expected []parquetColumn
+		success  bool
+	}{
+		{"a:string,b:int64, c:double,d:BOOLEAN", []parquetColumn{
+			{Name: "a", Type: parquetString},
+			{Name: "b", Type: parquetInt64},
+			{Name: "c", Type: parquetDouble},
+			{Name: "d", Type: parquetBoolean},
+		}, true},
+		{"a", nil, false},
+		{":string", nil, false},
+		{"a:int32", nil, false},
+		{"a:string,a:int64", nil, false},
+	}
+	for i, testCase := range testCases {
+		columns, err := parseParquetSchema(testCase.schema)
+		if testCase.success != (err == nil) {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if testCase.success && !reflect.DeepEqual(columns, testCase.expected) {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, columns)
+		}
+	}
+}
+
+func TestInferParquetSchema(t *testing.T) {
+	var records []sqlRecord
+	for _, line := range []string{
+		`{"name":"a","count":1,"ratio":1,"ok":true,"note":null,"mixed":1}`,
+		`{"name":"b","count":2,"ratio":0.5,"ok":false,"note":null,"mixed":"x","extra":3}`,
+	} {
+		r, err := parseSQLRecord([]byte(line))
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+	expected := []parquetColumn{
+		{Name: "name", Type: parquetString},
+		{Name: "count", Type: parquetInt64},
+		{Name: "ratio", Type: parquetDouble},
+		{Name: "ok", Type: parquetBoolean},
+		{Name: "note", Type: parquetString},
+		{Name: "mixed", Type: parquetString},
+		{Name: "extra", Type: parquetInt64},
+	}
+	if columns := inferParquetSchema(records); !reflect.DeepEqual(columns, expected) {
+		t.Fatalf("expected %v, got %v", expected, columns)
+	}
+}
+
+func TestSQLParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newSQLParquetWriter(&buf, nil)
+	// Records may be split across writes.
+	for _, p := range []string{`{"a":"1","b":2}`, "\n{\"a\":", `"x","b":3}`} {
+		if _, err := w.Write([]byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("PAR1")) || !bytes.HasSuffix(buf.Bytes(), []byte("PAR1")) {
+		t.Fatal("output is not a parquet file")
+	}
+
+	// Quoted numbers are converted to the schema type.
+	w = newSQLParquetWriter(&bytes.Buffer{}, []parquetColumn{{Name: "a", Type: parquetInt64}})
+	if _, err := w.Write([]byte("{\"a\":\"1\"}\n{\"a\":\"x\"}\n")); err == nil {
+		t.Fatal("expected an error for an invalid int64 value")
+	}
+	// The error is kept for following writes.
+	if _, err := w.Write([]byte("{\"a\":2}\n")); err == nil {
+		t.Fatal("expected previous error")
+	}
+
+	// Fields missing in the schema are rejected.
+	w = newSQLParquetWriter(&bytes.Buffer{}, []parquetColumn{{Name: "a", Type: parquetInt64}})
+	if _, err := w.Write([]byte("{\"a\":1,\"b\":2}\n")); err == nil {
+		t.Fatal("expected an error for a field not in the schema")
+	}
+}
+
+func TestSQLParquetWriterRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w := newSQLParquetWriter(&buf, []parquetColumn{
+		{Name: "name", Type: parquetString},
+		{Name: "count", Type: parquetInt64},
+		{Name: "ratio", Type: parquetDouble},
+		{Name: "ok", Type: parquetBoolean},
+	})
+	lines := "{\"name\":\"a\",\"count\":1,\"ratio\":0.5,\"ok\":true}\n" +
+		"{\"name\":null,\"count\":\"-2\",\"ok\":\"false\"}\n" +
+		"{\"name\":\"\",\"count\":\"\",\"ratio\":\"1.5\"}\n"
+	if _, err := w.Write([]byte(lines)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := goparquet.NewFileReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedSchema := `message schema {
+  optional binary name (UTF8);
+  optional int64 count;
+  optional double ratio;
+  optional boolean ok;
+}
+`
+	if schema := r.GetSchemaDefinition().String(); schema != expectedSchema {
+		t.Errorf("expected schema %q, got %q", expectedSchema, schema)
+	}
+	// Null values are absent, strings are read back as bytes.
+	expected := []map[string]interface{}{
+		{"name": []byte("a"), "count": int64(1), "ratio": 0.5, "ok": true},
+		{"count": int64(-2), "ok": false},
+		{"name": []byte(""), "ratio": 1.5},
+	}
+	for i, row := range expected {
+		got, err := r.NextRow()
+		if err != nil {
+			t.Fatalf("row %d: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, row) {
+			t.Errorf("row %d: expected %#v, got %#v", i, row, got)
+		}
+	}
+	if _, err = r.NextRow(); err != io.EOF {
+		t.Errorf("expected io.EOF after the last row, got %v", err)
+	}
+}
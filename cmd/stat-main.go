@@ -46,6 +46,14 @@ var (
 			Name:  "recursive, r",
 			Usage: "stat all objects recursively",
 		},
+		cli.StringFlag{
+			Name:  "group-by",
+			Usage: "summarize objects by 'storage-class', requires --recursive",
+		},
+		cli.BoolFlag{
+			Name:  "table",
+			Usage: "print one table row per object, requires --recursive",
+		},
 	}
 )
 
@@ -91,11 +99,17 @@ EXAMPLES:
 
   7. Stat all objects versions recursively created before 1st January 2020.
      {{.Prompt}} {{.HelpName}} --versions --rewind 2020.01.01T00:00 s3/personal-docs/
+
+  8. Stat all objects under a prefix and summarize their storage class distribution.
+     {{.Prompt}} {{.HelpName}} --recursive --group-by storage-class s3/personal-docs/2018/
+
+  9. Stat all objects under a prefix and print them as a table.
+     {{.Prompt}} {{.HelpName}} --recursive --table s3/personal-docs/2018/
 `,
 }
 
 // parseAndCheckStatSyntax - parse and validate all the passed arguments
-func parseAndCheckStatSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[string][]prefixSSEPair) ([]string, bool, string, time.Time, bool) {
+func parseAndCheckStatSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB map[string][]prefixSSEPair) ([]string, bool, string, time.Time, bool, string, bool) {
 	if !cliCtx.Args().Present() {
 		showCommandHelpAndExit(cliCtx, 1) // last argument is exit code
 	}
@@ -111,6 +125,8 @@ func parseAndCheckStatSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB
 	versionID := cliCtx.String("version-id")
 	withVersions := cliCtx.Bool("versions")
 	rewind := parseRewindFlag(cliCtx.String("rewind"))
+	groupBy := cliCtx.String("group-by")
+	asTable := cliCtx.Bool("table")
 
 	// extract URLs.
 	URLs := cliCtx.Args()
@@ -123,6 +139,19 @@ func parseAndCheckStatSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB
 		fatalIf(errInvalidArgument().Trace(args...), "You cannot specify --version-id with either --rewind, --versions or --recursive.")
 	}
 
+	if groupBy != "" {
+		if groupBy != statGroupByStorageClass {
+			fatalIf(errInvalidArgument().Trace(groupBy), "Unsupported --group-by value `"+groupBy+"`, only `"+statGroupByStorageClass+"` is supported.")
+		}
+		if !recursive {
+			fatalIf(errInvalidArgument().Trace(args...), "You must specify --recursive with --group-by.")
+		}
+	}
+
+	if asTable && !recursive {
+		fatalIf(errInvalidArgument().Trace(args...), "You must specify --recursive with --table.")
+	}
+
 	for _, url := range URLs {
 		_, _, err := url2Stat(ctx, url, versionID, false, encKeyDB, rewind, false)
 		if err != nil {
@@ -130,7 +159,7 @@ func parseAndCheckStatSyntax(ctx context.Context, cliCtx *cli.Context, encKeyDB
 		}
 	}
 
-	return URLs, recursive, versionID, rewind, withVersions
+	return URLs, recursive, versionID, rewind, withVersions, groupBy, asTable
 }
 
 // mainStat - is a handler for mc stat command
@@ -149,13 +178,15 @@ func mainStat(cliCtx *cli.Context) error {
 	console.SetColor("Value", color.New(color.FgYellow))
 	console.SetColor("Unset", color.New(color.FgRed))
 	console.SetColor("Set", color.New(color.FgGreen))
+	// theme specific to recursive stat tables
+	console.SetColor("Headers", color.New(color.Bold, color.FgHiGreen))
 
 	// Parse encryption keys per command.
 	encKeyDB, err := getEncKeys(cliCtx)
 	fatalIf(err, "Unable to parse encryption keys.")
 
 	// check 'stat' cli arguments.
-	args, isRecursive, versionID, rewind, withVersions := parseAndCheckStatSyntax(ctx, cliCtx, encKeyDB)
+	args, isRecursive, versionID, rewind, withVersions, groupBy, asTable := parseAndCheckStatSyntax(ctx, cliCtx, encKeyDB)
 	// mimic operating system tool behavior.
 	if len(args) == 0 {
 		args = []string{"."}
@@ -167,10 +198,19 @@ func mainStat(cliCtx *cli.Context) error {
 		if err != nil {
 			fatalIf(err, "Unable to stat `"+targetURL+"`.")
 		}
+		stats := make([]statMessage, 0, len(contents))
 		for _, content := range contents {
 			stat := parseStat(content)
 			stat.singleObject = len(contents) == 1
-			printMsg(stat)
+			stats = append(stats, stat)
+		}
+		// The table is for the console only, JSON output stays one message per object.
+		if asTable && !globalJSON {
+			printMsg(statListMessage{Objects: stats, withVersions: withVersions})
+		} else {
+			for _, stat := range stats {
+				printMsg(stat)
+			}
 		}
 		for _, binfo := range bstats {
 			printMsg(bucketInfoMessage{
@@ -179,7 +219,9 @@ func mainStat(cliCtx *cli.Context) error {
 				Metadata: *binfo,
 			})
 		}
-
+		if groupBy != "" {
+			printMsg(groupStats(stats))
+		}
 	}
 	return cErr
 }
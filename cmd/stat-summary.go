@@ -0,0 +1,184 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+)
+
+// Supported values for --group-by.
+const statGroupByStorageClass = "storage-class"
+
+// maxStatNameWidth caps the object name column of the stat table.
+const maxStatNameWidth = 80
+
+// statListMessage is printed by 'mc stat --recursive --table', one
+// table row per object.
+type statListMessage struct {
+	Objects      []statMessage
+	withVersions bool
+}
+
+func (s statListMessage) String() string {
+	nameWidth := len("Name")
+	for _, stat := range s.Objects {
+		if len(stat.Key) > nameWidth {
+			nameWidth = len(stat.Key)
+		}
+	}
+	if nameWidth > maxStatNameWidth {
+		nameWidth = maxStatNameWidth
+	}
+	fields := []Field{
+		{"Name", nameWidth},
+		{"Size", 10},
+		{"Date", len(printDate)},
+		{"StorageClass", 20},
+		{"ETag", 34},
+	}
+	header := []string{"Name", "Size", "Last Modified", "Storage Class", "ETag"}
+	if s.withVersions {
+		fields = append(fields, Field{"VersionID", 36})
+		header = append(header, "VersionID")
+	}
+	table := newPrettyTable("  ", fields...)
+
+	var b strings.Builder
+	b.WriteString(console.Colorize("Headers", table.buildRow(header...)) + "\n")
+	for _, stat := range s.Objects {
+		row := []string{
+			stat.Key,
+			humanize.IBytes(uint64(stat.Size)),
+			stat.Date.Format(printDate),
+			statOrDash(stat.StorageClass),
+			statOrDash(stat.ETag),
+		}
+		if s.withVersions {
+			versionID := statOrDash(stat.VersionID)
+			if stat.DeleteMarker {
+				versionID += " (delete-marker)"
+			}
+			row = append(row, versionID)
+		}
+		b.WriteString(table.buildRow(row...) + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (s statListMessage) JSON() string {
+	var b strings.Builder
+	for _, stat := range s.Objects {
+		b.WriteString(stat.JSON() + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func statOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// statGroup is the aggregate of all objects sharing a group key.
+type statGroup struct {
+	Key          string    `json:"key"`
+	Objects      int64     `json:"objects"`
+	Size         int64     `json:"size"`
+	SizePercent  float64   `json:"sizePercent"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// statGroupMessage is the --group-by summary of 'mc stat --recursive'.
+type statGroupMessage struct {
+	Status  string      `json:"status"`
+	GroupBy string      `json:"groupBy"`
+	Groups  []statGroup `json:"groups"`
+	Objects int64       `json:"objects"`
+	Size    int64       `json:"size"`
+}
+
+func (s statGroupMessage) String() string {
+	table := newPrettyTable("  ",
+		Field{"Key", 20},
+		Field{"Objects", 10},
+		Field{"Size", 10},
+		Field{"Percent", 7},
+	)
+	var b strings.Builder
+	b.WriteString(console.Colorize("Headers", table.buildRow("Storage Class", "Objects", "Size", "Size %")) + "\n")
+	for _, g := range s.Groups {
+		b.WriteString(table.buildRow(g.Key, humanize.Comma(g.Objects), humanize.IBytes(uint64(g.Size)),
+			fmt.Sprintf("%.1f%%", g.SizePercent)) + "\n")
+	}
+	b.WriteString(console.Colorize("Headers", table.buildRow("Total", humanize.Comma(s.Objects), humanize.IBytes(uint64(s.Size)), "")))
+	return b.String()
+}
+
+func (s statGroupMessage) JSON() string {
+	s.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(s, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+// groupStats aggregates object count and size per storage class, groups
+// are sorted by descending size. Folders and delete markers are skipped.
+func groupStats(stats []statMessage) statGroupMessage {
+	msg := statGroupMessage{GroupBy: statGroupByStorageClass, Groups: []statGroup{}}
+	groups := map[string]*statGroup{}
+	for _, stat := range stats {
+		if stat.Type == "folder" || stat.DeleteMarker {
+			continue
+		}
+		key := statOrDash(stat.StorageClass)
+		g, ok := groups[key]
+		if !ok {
+			g = &statGroup{Key: key}
+			groups[key] = g
+		}
+		g.Objects++
+		g.Size += stat.Size
+		if stat.Date.After(g.LastModified) {
+			g.LastModified = stat.Date
+		}
+		msg.Objects++
+		msg.Size += stat.Size
+	}
+	for _, g := range groups {
+		if msg.Size > 0 {
+			g.SizePercent = float64(g.Size) * 100 / float64(msg.Size)
+		}
+		msg.Groups = append(msg.Groups, *g)
+	}
+	sort.Slice(msg.Groups, func(i, j int) bool {
+		if msg.Groups[i].Size != msg.Groups[j].Size {
+			return msg.Groups[i].Size > msg.Groups[j].Size
+		}
+		return msg.Groups[i].Key < msg.Groups[j].Key
+	})
+	return msg
+}
@@ -52,9 +52,22 @@ type statMessage struct {
 	Metadata          map[string]string `json:"metadata,omitempty"`
 	VersionID         string            `json:"versionID,omitempty"`
 	DeleteMarker      bool              `json:"deleteMarker,omitempty"`
+	ChecksumCRC32     string            `json:"checksumCRC32,omitempty"`
+	ChecksumCRC32C    string            `json:"checksumCRC32C,omitempty"`
+	ChecksumSHA1      string            `json:"checksumSHA1,omitempty"`
+	ChecksumSHA256    string            `json:"checksumSHA256,omitempty"`
+	ChecksumType      string            `json:"checksumType,omitempty"`
+	StorageClass      string            `json:"storageClass,omitempty"`
 	singleObject      bool
 }
 
+// Checksum types of an object, a composite checksum is the checksum
+// of the checksums of the parts of a multipart object.
+const (
+	checksumTypeComposite  = "COMPOSITE"
+	checksumTypeFullObject = "FULL_OBJECT"
+)
+
 func (stat statMessage) String() (msg string) {
 	var msgBuilder strings.Builder
 	// Format properly for alignment based on maxKey leng
@@ -73,6 +86,16 @@ func (stat statMessage) String() (msg string) {
 		msgBuilder.WriteString(fmt.Sprintf("%-10s: %s ", "VersionID", versionIDField) + "\n")
 	}
 	msgBuilder.WriteString(fmt.Sprintf("%-10s: %s ", "Type", stat.Type) + "\n")
+	for _, checksum := range []struct{ algo, value string }{
+		{"CRC32", stat.ChecksumCRC32},
+		{"CRC32C", stat.ChecksumCRC32C},
+		{"SHA1", stat.ChecksumSHA1},
+		{"SHA256", stat.ChecksumSHA256},
+	} {
+		if checksum.value != "" {
+			msgBuilder.WriteString(fmt.Sprintf("%-10s: %s %s (%s) ", "Checksum", checksum.algo, checksum.value, stat.ChecksumType) + "\n")
+		}
+	}
 	if stat.Expires != nil {
 		msgBuilder.WriteString(fmt.Sprintf("%-10s: %s ", "Expires", stat.Expires.Format(printDate)) + "\n")
 	}
@@ -153,6 +176,21 @@ func parseStat(c *ClientContent) statMessage {
 	}
 	content.ExpirationRuleID = c.ExpirationRuleID
 	content.ReplicationStatus = c.ReplicationStatus
+	content.StorageClass = c.StorageClass
+	// S3 omits the storage class header for STANDARD objects.
+	if content.StorageClass == "" && c.URL.Type == objectStorage && !c.Type.IsDir() {
+		content.StorageClass = "STANDARD"
+	}
+	content.ChecksumCRC32 = c.Checksum["CRC32"]
+	content.ChecksumCRC32C = c.Checksum["CRC32C"]
+	content.ChecksumSHA1 = c.Checksum["SHA1"]
+	content.ChecksumSHA256 = c.Checksum["SHA256"]
+	for _, checksum := range c.Checksum {
+		content.ChecksumType = checksumTypeFullObject
+		if checksumPartsCount(checksum) != "" {
+			content.ChecksumType = checksumTypeComposite
+		}
+	}
 	return content
 }
 
@@ -229,10 +267,18 @@ func statURL(ctx context.Context, targetURL, versionID string, timeRef time.Time
 				continue
 			}
 		}
-		clnt, stat, err := url2Stat(ctx, url, content.VersionID, true, encKeyDB, timeRef, false)
+		clnt, stat, err := url2StatWithOptions(ctx, url, StatOptions{
+			preserve:  true,
+			timeRef:   timeRef,
+			versionID: content.VersionID,
+			checksum:  true,
+		}, encKeyDB)
 		if err != nil {
 			continue
 		}
+		if stat.StorageClass == "" {
+			stat.StorageClass = content.StorageClass
+		}
 		// if stat is on a bucket and non-recursive mode, serve the bucket metadata
 		if clnt != nil && !isRecursive && stat.Type.IsDir() {
 			bstat, err := clnt.GetBucketInfo(ctx)
@@ -68,3 +68,69 @@ func TestParseStat(t *testing.T) {
 		})
 	}
 }
+
+func TestParseStatChecksum(t *testing.T) {
+	testCases := []struct {
+		checksum     map[string]string
+		expectedType string
+	}{
+		{nil, ""},
+		{map[string]string{"CRC32C": "yZRlqg=="}, checksumTypeFullObject},
+		{map[string]string{"SHA256": "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=-3"}, checksumTypeComposite},
+	}
+	for i, testCase := range testCases {
+		statMsg := parseStat(&ClientContent{URL: *newClientURL("https://play.min.io/yrdy"), Checksum: testCase.checksum})
+		if statMsg.ChecksumCRC32C != testCase.checksum["CRC32C"] || statMsg.ChecksumSHA256 != testCase.checksum["SHA256"] {
+			t.Errorf("Test %d: unexpected checksums %s, %s", i+1, statMsg.ChecksumCRC32C, statMsg.ChecksumSHA256)
+		}
+		if statMsg.ChecksumType != testCase.expectedType {
+			t.Errorf("Test %d: expected checksum type %s, got %s", i+1, testCase.expectedType, statMsg.ChecksumType)
+		}
+	}
+}
+
+func TestParseStatStorageClass(t *testing.T) {
+	testCases := []struct {
+		content  ClientContent
+		expected string
+	}{
+		{ClientContent{URL: *newClientURL("https://play.min.io/bucket/obj"), Type: 0o644}, "STANDARD"},
+		{ClientContent{URL: *newClientURL("https://play.min.io/bucket/obj"), Type: 0o644, StorageClass: "GLACIER"}, "GLACIER"},
+		{ClientContent{URL: *newClientURL("https://play.min.io/bucket/"), Type: os.ModeDir}, ""},
+		{ClientContent{URL: *newClientURL("/tmp/obj"), Type: 0o644}, ""},
+	}
+	for i, testCase := range testCases {
+		if got := parseStat(&testCase.content).StorageClass; got != testCase.expected {
+			t.Errorf("Test %d: expected %q, got %q", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestGroupStats(t *testing.T) {
+	older := time.Unix(1000, 0)
+	newer := time.Unix(2000, 0)
+	stats := []statMessage{
+		{Key: "a", Size: 100, StorageClass: "STANDARD", Date: older, Type: "file"},
+		{Key: "b", Size: 300, StorageClass: "GLACIER", Date: older, Type: "file"},
+		{Key: "c", Size: 100, StorageClass: "STANDARD", Date: newer, Type: "file"},
+		{Key: "d", Size: 0, Type: "file"},
+		{Key: "e/", Type: "folder"},
+		{Key: "f", Size: 50, StorageClass: "STANDARD", DeleteMarker: true, Type: "file"},
+	}
+	msg := groupStats(stats)
+	if msg.Objects != 4 || msg.Size != 500 {
+		t.Fatalf("expected 4 objects and 500 bytes, got %d objects and %d bytes", msg.Objects, msg.Size)
+	}
+	expected := []statGroup{
+		{Key: "GLACIER", Objects: 1, Size: 300, SizePercent: 60, LastModified: older},
+		{Key: "STANDARD", Objects: 2, Size: 200, SizePercent: 40, LastModified: newer},
+		{Key: "-", Objects: 1, Size: 0, SizePercent: 0, LastModified: time.Time{}},
+	}
+	if !reflect.DeepEqual(msg.Groups, expected) {
+		t.Errorf("expected %+v, got %+v", expected, msg.Groups)
+	}
+
+	if empty := groupStats(nil); len(empty.Groups) != 0 || empty.Groups == nil {
+		t.Errorf("expected an empty, non-nil group list, got %#v", empty.Groups)
+	}
+}
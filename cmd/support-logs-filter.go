@@ -0,0 +1,123 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+// Log severities in increasing order, as accepted by --level.
+var logLevels = map[string]int{
+	"info":        0,
+	"information": 0,
+	"warning":     1,
+	"warn":        1,
+	"error":       2,
+	"fatal":       3,
+}
+
+// parseLogLevel returns the severity of a log level name, unknown
+// levels are considered informational.
+func parseLogLevel(level string) (int, bool) {
+	severity, ok := logLevels[strings.ToLower(strings.TrimSpace(level))]
+	return severity, ok
+}
+
+// logFilter selects the log entries printed by 'mc support logs show'.
+type logFilter struct {
+	minLevel  int
+	subsystem string
+	since     time.Time
+}
+
+func newLogFilter(level, subsystem string, since time.Duration) (f logFilter, err error) {
+	if level != "" {
+		var ok bool
+		if f.minLevel, ok = parseLogLevel(level); !ok {
+			return f, fmt.Errorf("unknown log level '%s', valid options are [info, warning, error, fatal]", level)
+		}
+	}
+	f.subsystem = strings.ToLower(subsystem)
+	if since > 0 {
+		f.since = UTCNow().Add(-since)
+	}
+	return f, nil
+}
+
+// match returns true if the entry is at least as severe as the minimum
+// level, newer than since and, when a subsystem is given, contains it
+// in its API name or in one of its source locations.
+func (f logFilter) match(l madmin.LogInfo) bool {
+	if severity, _ := parseLogLevel(l.Level); severity < f.minLevel {
+		return false
+	}
+	if !f.since.IsZero() {
+		if t, e := time.Parse(time.RFC3339Nano, l.Time); e == nil && t.Before(f.since) {
+			return false
+		}
+	}
+	if f.subsystem == "" {
+		return true
+	}
+	if l.API != nil && strings.Contains(strings.ToLower(l.API.Name), f.subsystem) {
+		return true
+	}
+	if l.Trace != nil {
+		for _, source := range l.Trace.Source {
+			if strings.Contains(strings.ToLower(source), f.subsystem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// logDedup drops the entries the server sends again after reconnecting,
+// that is entries older than the newest one already printed, or
+// printed already with the same timestamp.
+type logDedup struct {
+	last   time.Time
+	atLast map[string]struct{}
+}
+
+func (d *logDedup) seen(l madmin.LogInfo) bool {
+	t, e := time.Parse(time.RFC3339Nano, l.Time)
+	if e != nil {
+		return false
+	}
+	if t.Before(d.last) {
+		return true
+	}
+	buf, _ := json.Marshal(l)
+	key := string(buf)
+	if t.After(d.last) {
+		d.last = t
+		d.atLast = map[string]struct{}{key: {}}
+		return false
+	}
+	if _, ok := d.atLast[key]; ok {
+		return true
+	}
+	d.atLast[key] = struct{}{}
+	return false
+}
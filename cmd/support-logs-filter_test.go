@@ -0,0 +1,95 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+func newTestLogInfo(t *testing.T, entry string) (l madmin.LogInfo) {
+	if err := json.Unmarshal([]byte(entry), &l); err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestLogFilter(t *testing.T) {
+	now := UTCNow()
+	recent := now.Add(-time.Minute).Format(time.RFC3339Nano)
+	old := now.Add(-2 * time.Hour).Format(time.RFC3339Nano)
+
+	healErr := newTestLogInfo(t, `{"level":"ERROR","time":"`+recent+`","api":{"name":"SYSTEM"},"error":{"message":"disk not found","source":["[1] erasure-healing.go:123:cmd.healObject()"]}}`)
+	putWarn := newTestLogInfo(t, `{"level":"WARNING","time":"`+recent+`","api":{"name":"PutObject"}}`)
+	oldErr := newTestLogInfo(t, `{"level":"ERROR","time":"`+old+`","api":{"name":"PutObject"}}`)
+
+	testCases := []struct {
+		level, subsystem string
+		since            time.Duration
+		entry            madmin.LogInfo
+		match            bool
+	}{
+		{"", "", 0, putWarn, true},
+		{"error", "", 0, putWarn, false},
+		{"warning", "", 0, healErr, true},
+		{"", "heal", 0, healErr, true},
+		{"", "heal", 0, putWarn, false},
+		{"", "putobject", 0, putWarn, true},
+		{"", "", time.Hour, oldErr, false},
+		{"error", "", time.Hour, healErr, true},
+	}
+	for i, tc := range testCases {
+		f, err := newLogFilter(tc.level, tc.subsystem, tc.since)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if match := f.match(tc.entry); match != tc.match {
+			t.Errorf("Test %d: expected match %v, got %v", i+1, tc.match, match)
+		}
+	}
+	if _, err := newLogFilter("debug", "", 0); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
+
+func TestLogDedup(t *testing.T) {
+	first := newTestLogInfo(t, `{"level":"ERROR","time":"2022-06-01T10:00:00Z","message":"a"}`)
+	second := newTestLogInfo(t, `{"level":"ERROR","time":"2022-06-01T10:00:01Z","message":"b"}`)
+	sameTime := newTestLogInfo(t, `{"level":"ERROR","time":"2022-06-01T10:00:01Z","message":"c"}`)
+
+	var d logDedup
+	for i, tc := range []struct {
+		entry madmin.LogInfo
+		seen  bool
+	}{
+		{first, false},
+		{second, false},
+		{sameTime, false},
+		// Replayed after reconnecting.
+		{first, true},
+		{second, true},
+		{sameTime, true},
+	} {
+		if seen := d.seen(tc.entry); seen != tc.seen {
+			t.Errorf("Test %d: expected seen %v, got %v", i+1, tc.seen, seen)
+		}
+	}
+}
@@ -44,6 +44,45 @@ var logsShowFlags = []cli.Flag{
 		Usage: "list error logs by type. Valid options are '[minio, application, all]'",
 		Value: "all",
 	},
+	cli.StringFlag{
+		Name:  "level",
+		Usage: "show only logs of this severity or higher. Valid options are '[info, warning, error, fatal]'",
+	},
+	cli.StringFlag{
+		Name:  "subsystem",
+		Usage: "show only logs whose API name or source location contains this text, e.g. 'heal' or 'replication'",
+	},
+	cli.DurationFlag{
+		Name:  "since",
+		Usage: "replay the available logs of this recent period, e.g. '1h'",
+	},
+}
+
+const (
+	// Delay before reconnecting a lost log stream, doubled after
+	// every failed reconnect up to logsMaxReconnectDelay.
+	logsReconnectDelay    = time.Second
+	logsMaxReconnectDelay = time.Minute
+)
+
+// isLogsFatalError - returns true for the errors of the log stream
+// which a reconnect cannot fix, such as invalid credentials or
+// arguments. Server side (5xx) and network errors are retried.
+func isLogsFatalError(e error) bool {
+	errResp, ok := e.(madmin.ErrorResponse)
+	if !ok {
+		return false
+	}
+	switch errResp.Code {
+	case "AccessDenied", "InvalidAccessKeyId", "SignatureDoesNotMatch",
+		"ExpiredToken", "InvalidToken", "XMinioInvalidIAMCredentials",
+		"XMinioAdminInvalidAccessKey", "XMinioAdminInvalidSecretKey",
+		"InvalidArgument", "XMinioAdminInvalidArgument",
+		"NotImplemented", "XMinioAdminNotImplemented":
+		return true
+	}
+	// Unparsable responses carry the HTTP status as their code.
+	return strings.HasPrefix(errResp.Code, "4")
 }
 
 var supportLogsShowCmd = cli.Command{
@@ -68,6 +107,8 @@ EXAMPLES:
      {{.Prompt}} {{.HelpName}} --last 5 myminio node1
   3. Show application errors in logs for a MinIO server with alias 'myminio'
      {{.Prompt}} {{.HelpName}} --type application myminio
+  4. Replay the error logs of the last hour related to healing, then keep following them as JSON lines
+     {{.Prompt}} {{.HelpName}} --level error --subsystem heal --since 1h --json myminio | tee heal-errors.log
 `,
 }
 
@@ -196,20 +237,53 @@ func mainLogsShowConsole(ctx *cli.Context) error {
 		return nil
 	}
 
+	filter, e := newLogFilter(ctx.String("level"), ctx.String("subsystem"), ctx.Duration("since"))
+	fatalIf(probe.NewError(e).Trace(ctx.Args()...), "Invalid log filter.")
+
 	ctxt, cancel := context.WithCancel(globalContext)
 	defer cancel()
 
-	// Start listening on all console log activity.
-	logCh := client.GetLogs(ctxt, node, last, logType)
-	for logInfo := range logCh {
-		if logInfo.Err != nil {
-			fatalIf(probe.NewError(logInfo.Err), "Unable to listen to console logs")
+	// Start listening on all console log activity, reconnecting
+	// whenever the server closes the stream.
+	var dedup logDedup
+	delay := logsReconnectDelay
+	for {
+		var received, failed bool
+		logCh := client.GetLogs(ctxt, node, last, logType)
+		for logInfo := range logCh {
+			if logInfo.Err != nil {
+				if isLogsFatalError(logInfo.Err) {
+					fatalIf(probe.NewError(logInfo.Err), "Unable to listen to console logs")
+				}
+				if ctxt.Err() == nil {
+					errorIf(probe.NewError(logInfo.Err), fmt.Sprintf("Unable to listen to console logs, reconnecting in %s.", delay))
+				}
+				failed = true
+				continue
+			}
+			received, delay = true, logsReconnectDelay
+			if dedup.seen(logInfo) || !filter.match(logInfo) {
+				continue
+			}
+			// drop nodeName from output if specified as cli arg
+			if node != "" {
+				logInfo.NodeName = ""
+			}
+			printMsg(logMessage{LogInfo: logInfo})
+		}
+		if ctxt.Err() != nil {
+			return nil
+		}
+		if !received && !failed {
+			errorIf(errDummy().Trace(aliasedURL), fmt.Sprintf("Log stream of `%s` lost, reconnecting in %s.", aliasedURL, delay))
+		}
+		select {
+		case <-ctxt.Done():
+			return nil
+		case <-time.After(delay):
 		}
-		// drop nodeName from output if specified as cli arg
-		if node != "" {
-			logInfo.NodeName = ""
+		if delay *= 2; delay > logsMaxReconnectDelay {
+			delay = logsMaxReconnectDelay
 		}
-		printMsg(logMessage{LogInfo: logInfo})
 	}
-	return nil
 }
@@ -0,0 +1,47 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestIsLogsFatalError(t *testing.T) {
+	testCases := []struct {
+		err   error
+		fatal bool
+	}{
+		{madmin.ErrorResponse{Code: "XMinioServerNotInitialized"}, false},
+		{madmin.ErrorResponse{Code: "InternalError"}, false},
+		{madmin.ErrorResponse{Code: "503 Service Unavailable"}, false},
+		{&net.OpError{Op: "dial", Err: errors.New("connection refused")}, false},
+		{madmin.ErrorResponse{Code: "AccessDenied"}, true},
+		{madmin.ErrorResponse{Code: "XMinioAdminInvalidAccessKey"}, true},
+		{madmin.ErrorResponse{Code: "InvalidArgument"}, true},
+		{madmin.ErrorResponse{Code: "403 Forbidden"}, true},
+	}
+	for i, testCase := range testCases {
+		if fatal := isLogsFatalError(testCase.err); fatal != testCase.fatal {
+			t.Errorf("Test %d: %v: expected %v, got %v", i+1, testCase.err, testCase.fatal, fatal)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"archive/zip"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+	"github.com/olekukonko/tablewriter"
+)
+
+// perfCompareResult - throughput of a test in both the perf reports
+type perfCompareResult struct {
+	Test   string  `json:"test"`
+	Old    uint64  `json:"old"`
+	New    uint64  `json:"new"`
+	Change float64 `json:"changePercent"`
+}
+
+// perfCompareMessage - comparison of two perf reports
+type perfCompareMessage struct {
+	Status  string              `json:"status"`
+	OldFile string              `json:"oldFile"`
+	NewFile string              `json:"newFile"`
+	Results []perfCompareResult `json:"results"`
+	Warning string              `json:"warning,omitempty"`
+}
+
+func (m perfCompareMessage) String() string {
+	var s strings.Builder
+	if m.Warning != "" {
+		s.WriteString(warnText("WARNING: "+m.Warning) + "\n\n")
+	}
+
+	table := tablewriter.NewWriter(&s)
+	table.SetAutoWrapText(false)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetBorder(false)
+	table.SetHeader([]string{"Test", "Old", "New", "Change"})
+	for _, r := range m.Results {
+		change := fmt.Sprintf("%+.2f%%", r.Change)
+		switch {
+		case r.Change > 0:
+			change = greenText(change)
+		case r.Change < 0:
+			change = warnText(change)
+		}
+		table.Append([]string{
+			r.Test,
+			humanize.IBytes(r.Old) + "/s",
+			humanize.IBytes(r.New) + "/s",
+			change,
+		})
+	}
+	table.Render()
+	return s.String()
+}
+
+func (m perfCompareMessage) JSON() string {
+	JSONBytes, e := json.MarshalIndent(m, "", "    ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(JSONBytes)
+}
+
+// readPerfResultZip - decodes the perf test output saved in a perf report zip
+func readPerfResultZip(filename string) (PerfTestOutput, error) {
+	var out PerfTestOutput
+
+	r, e := zip.OpenReader(filename)
+	if e != nil {
+		return out, e
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if path.Ext(f.Name) != ".json" {
+			continue
+		}
+		rc, e := f.Open()
+		if e != nil {
+			return out, e
+		}
+		defer rc.Close()
+		e = gojson.NewDecoder(rc).Decode(&out)
+		return out, e
+	}
+	return out, errors.New("no perf test results found in " + filename)
+}
+
+func comparePerfOutputs(oldOut, newOut PerfTestOutput) (results []perfCompareResult) {
+	oldThroughputs := perfOutputThroughputs(oldOut)
+	newThroughputs := perfOutputThroughputs(newOut)
+	for _, test := range perfThroughputTests {
+		o, okOld := oldThroughputs[test]
+		n, okNew := newThroughputs[test]
+		if !okOld || !okNew {
+			continue
+		}
+		r := perfCompareResult{Test: test, Old: o, New: n}
+		if o > 0 {
+			r.Change = (float64(n) - float64(o)) * 100 / float64(o)
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// mainSupportPerfCompare - compares the perf reports of two runs
+func mainSupportPerfCompare(oldFile, newFile string) {
+	oldOut, e := readPerfResultZip(oldFile)
+	fatalIf(probe.NewError(e).Trace(oldFile), "Unable to read perf test results")
+	newOut, e := readPerfResultZip(newFile)
+	fatalIf(probe.NewError(e).Trace(newFile), "Unable to read perf test results")
+
+	msg := perfCompareMessage{
+		Status:  "success",
+		OldFile: oldFile,
+		NewFile: newFile,
+		Results: comparePerfOutputs(oldOut, newOut),
+	}
+	if o, n := oldOut.ObjectResults, newOut.ObjectResults; o != nil && n != nil &&
+		(o.ObjectSize != n.ObjectSize || o.Threads != n.Threads) {
+		msg.Warning = fmt.Sprintf("object tests were run with different object sizes (%s vs %s) or threads (%d vs %d), the comparison may not be meaningful",
+			humanize.IBytes(uint64(o.ObjectSize)), humanize.IBytes(uint64(n.ObjectSize)), o.Threads, n.Threads)
+	}
+	if len(msg.Results) == 0 {
+		console.Infoln("No common perf tests found to compare.")
+		return
+	}
+	printMsg(msg)
+}
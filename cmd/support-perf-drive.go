@@ -35,7 +35,7 @@ func mainAdminSpeedTestDrive(ctx *cli.Context, aliasedURL string, outCh chan<- P
 		return nil
 	}
 
-	ctxt, cancel := context.WithCancel(globalContext)
+	ctxt, cancel := context.WithCancel(globalPerfTestContext)
 	defer cancel()
 
 	blocksize, e := humanize.ParseBytes(ctx.String("blocksize"))
@@ -66,13 +66,19 @@ func mainAdminSpeedTestDrive(ctx *cli.Context, aliasedURL string, outCh chan<- P
 		FileSize:  uint64(filesize),
 	})
 
-	if globalJSON {
+	if globalJSON || globalPerfTestProm {
 		if e != nil {
-			printMsg(convertPerfResult(PerfTestResult{
+			r := PerfTestResult{
 				Type:  DrivePerfTest,
 				Err:   e.Error(),
 				Final: true,
-			}))
+			}
+			if globalJSON {
+				printMsg(convertPerfResult(r))
+			}
+			if outCh != nil {
+				outCh <- r
+			}
 
 			return nil
 		}
@@ -83,11 +89,17 @@ func mainAdminSpeedTestDrive(ctx *cli.Context, aliasedURL string, outCh chan<- P
 				results = append(results, result)
 			}
 		}
-		printMsg(convertPerfResult(PerfTestResult{
+		r := PerfTestResult{
 			Type:        DrivePerfTest,
 			DriveResult: results,
 			Final:       true,
-		}))
+		}
+		if globalJSON {
+			printMsg(convertPerfResult(r))
+		}
+		if outCh != nil {
+			outCh <- r
+		}
 
 		return nil
 	}
@@ -35,7 +35,7 @@ func mainAdminSpeedTestNetperf(ctx *cli.Context, aliasedURL string, outCh chan<-
 		return nil
 	}
 
-	ctxt, cancel := context.WithCancel(globalContext)
+	ctxt, cancel := context.WithCancel(globalPerfTestContext)
 	defer cancel()
 
 	duration, e := time.ParseDuration(ctx.String("duration"))
@@ -61,20 +61,27 @@ func mainAdminSpeedTestNetperf(ctx *cli.Context, aliasedURL string, outCh chan<-
 		resultCh <- result
 	}()
 
-	if globalJSON {
+	if globalJSON || globalPerfTestProm {
+		var r PerfTestResult
 		select {
 		case e := <-errorCh:
-			printMsg(convertPerfResult(PerfTestResult{
+			r = PerfTestResult{
 				Type:  NetPerfTest,
 				Err:   e.Error(),
 				Final: true,
-			}))
+			}
 		case result := <-resultCh:
-			printMsg(convertPerfResult(PerfTestResult{
+			r = PerfTestResult{
 				Type:      NetPerfTest,
 				NetResult: &result,
 				Final:     true,
-			}))
+			}
+		}
+		if globalJSON {
+			printMsg(convertPerfResult(r))
+		}
+		if outCh != nil {
+			outCh <- r
 		}
 		return nil
 	}
@@ -46,14 +46,14 @@ func mainAdminSpeedtest(ctx *cli.Context) error {
 	return nil
 }
 
-func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, outCh chan<- PerfTestResult) error {
+func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, objectSize string, outCh chan<- PerfTestResult) error {
 	client, perr := newAdminClient(aliasedURL)
 	if perr != nil {
 		fatalIf(perr.Trace(aliasedURL), "Unable to initialize admin client.")
 		return nil
 	}
 
-	ctxt, cancel := context.WithCancel(globalContext)
+	ctxt, cancel := context.WithCancel(globalPerfTestContext)
 	defer cancel()
 
 	duration, e := time.ParseDuration(ctx.String("duration"))
@@ -65,7 +65,7 @@ func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, outCh chan<-
 		fatalIf(errInvalidArgument(), "duration cannot be 0 or negative")
 		return nil
 	}
-	size, e := humanize.ParseBytes(ctx.String("size"))
+	size, e := humanize.ParseBytes(objectSize)
 	if e != nil {
 		fatalIf(probe.NewError(e), "Unable to parse object size")
 		return nil
@@ -80,6 +80,9 @@ func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, outCh chan<-
 		return nil
 	}
 	globalPerfTestVerbose = ctx.Bool("verbose")
+	percentiles, perr := parsePerfPercentiles(ctx.String("percentiles"))
+	fatalIf(perr, "Unable to parse percentiles, supported values are 50,75,95,99,99.9")
+	globalPerfTestPercentiles = percentiles
 
 	// Turn-off autotuning only when "concurrent" is specified
 	// in all other scenarios keep auto-tuning on.
@@ -93,13 +96,19 @@ func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, outCh chan<-
 		Bucket:      ctx.String("bucket"), // This is a hidden flag.
 	})
 
-	if globalJSON {
+	if globalJSON || globalPerfTestProm {
 		if e != nil {
-			printMsg(convertPerfResult(PerfTestResult{
+			r := PerfTestResult{
 				Type:  ObjectPerfTest,
 				Err:   e.Error(),
 				Final: true,
-			}))
+			}
+			if globalJSON {
+				printMsg(convertPerfResult(r))
+			}
+			if outCh != nil {
+				outCh <- r
+			}
 			return nil
 		}
 
@@ -110,11 +119,17 @@ func mainAdminSpeedTestObject(ctx *cli.Context, aliasedURL string, outCh chan<-
 			}
 		}
 
-		printMsg(convertPerfResult(PerfTestResult{
+		r := PerfTestResult{
 			Type:         ObjectPerfTest,
 			ObjectResult: &result,
 			Final:        true,
-		}))
+		}
+		if globalJSON {
+			printMsg(convertPerfResult(r))
+		}
+		if outCh != nil {
+			outCh <- r
+		}
 
 		return nil
 	}
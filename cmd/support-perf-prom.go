@@ -0,0 +1,143 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// perfPromSample - a single sample of a perf metric family
+type perfPromSample struct {
+	labels [][2]string
+	value  uint64
+}
+
+// perfPromMetric - a perf metric family in prometheus text exposition format
+type perfPromMetric struct {
+	name    string
+	help    string
+	samples []perfPromSample
+}
+
+var perfPromLabelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func (m *perfPromMetric) add(value uint64, labels ...[2]string) {
+	m.samples = append(m.samples, perfPromSample{labels: labels, value: value})
+}
+
+func (m perfPromMetric) write(w io.Writer) error {
+	if len(m.samples) == 0 {
+		return nil
+	}
+	if _, e := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", m.name, m.help, m.name); e != nil {
+		return e
+	}
+	for _, s := range m.samples {
+		labels := make([]string, 0, len(s.labels))
+		for _, l := range s.labels {
+			labels = append(labels, fmt.Sprintf(`%s="%s"`, l[0], perfPromLabelEscaper.Replace(l[1])))
+		}
+		if _, e := fmt.Fprintf(w, "%s{%s} %d\n", m.name, strings.Join(labels, ","), s.value); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func perfPromEndpoint(endpoint string) [2]string {
+	return [2]string{"endpoint", endpoint}
+}
+
+// perfResultPromMetrics - converts the perf test output into metric families,
+// object test samples carry the object size to tell --size-sweep runs apart.
+func perfResultPromMetrics(out PerfTestOutput) []perfPromMetric {
+	putThroughput := perfPromMetric{name: "mc_perf_put_throughput_bytes", help: "PUT throughput in bytes/sec per server"}
+	putObjects := perfPromMetric{name: "mc_perf_put_objects_per_sec", help: "PUT objects/sec per server"}
+	getThroughput := perfPromMetric{name: "mc_perf_get_throughput_bytes", help: "GET throughput in bytes/sec per server"}
+	getObjects := perfPromMetric{name: "mc_perf_get_objects_per_sec", help: "GET objects/sec per server"}
+	netTX := perfPromMetric{name: "mc_perf_net_tx_bytes", help: "Network transmit throughput in bytes/sec per server"}
+	netRX := perfPromMetric{name: "mc_perf_net_rx_bytes", help: "Network receive throughput in bytes/sec per server"}
+	driveRead := perfPromMetric{name: "mc_perf_drive_read_throughput_bytes", help: "Drive read throughput in bytes/sec per drive"}
+	driveWrite := perfPromMetric{name: "mc_perf_drive_write_throughput_bytes", help: "Drive write throughput in bytes/sec per drive"}
+	serverErrors := perfPromMetric{name: "mc_perf_server_error", help: "Set to 1 when a server reported an error for a test"}
+
+	addObjResults := func(r ObjTestResults) {
+		size := [2]string{"object_size", strconv.Itoa(r.ObjectSize)}
+		for _, s := range r.PUTResults.Servers {
+			putThroughput.add(s.Perf.Throughput, perfPromEndpoint(s.Endpoint), size)
+			putObjects.add(s.Perf.ObjectsPerSec, perfPromEndpoint(s.Endpoint), size)
+			if s.Error != "" {
+				serverErrors.add(1, perfPromEndpoint(s.Endpoint), [2]string{"test", "object_put"}, size)
+			}
+		}
+		for _, s := range r.GETResults.Servers {
+			getThroughput.add(s.Perf.Throughput, perfPromEndpoint(s.Endpoint), size)
+			getObjects.add(s.Perf.ObjectsPerSec, perfPromEndpoint(s.Endpoint), size)
+			if s.Error != "" {
+				serverErrors.add(1, perfPromEndpoint(s.Endpoint), [2]string{"test", "object_get"}, size)
+			}
+		}
+	}
+	if out.ObjectResults != nil {
+		addObjResults(*out.ObjectResults)
+	}
+	for _, r := range out.ObjectSweepResults {
+		addObjResults(r)
+	}
+	if out.NetResults != nil {
+		for _, r := range out.NetResults.Results {
+			netTX.add(r.Perf.TX, perfPromEndpoint(r.Endpoint))
+			netRX.add(r.Perf.RX, perfPromEndpoint(r.Endpoint))
+			if r.Error != "" {
+				serverErrors.add(1, perfPromEndpoint(r.Endpoint), [2]string{"test", "net"})
+			}
+		}
+	}
+	if out.DriveResults != nil {
+		for _, r := range out.DriveResults.Results {
+			failed := r.Error != ""
+			for _, d := range r.Perf {
+				driveRead.add(d.ReadThroughput, perfPromEndpoint(r.Endpoint), [2]string{"path", d.Path})
+				driveWrite.add(d.WriteThroughput, perfPromEndpoint(r.Endpoint), [2]string{"path", d.Path})
+				failed = failed || d.Error != ""
+			}
+			if failed {
+				serverErrors.add(1, perfPromEndpoint(r.Endpoint), [2]string{"test", "drive"})
+			}
+		}
+	}
+
+	return []perfPromMetric{
+		putThroughput, putObjects, getThroughput, getObjects,
+		netTX, netRX, driveRead, driveWrite, serverErrors,
+	}
+}
+
+// writePerfResultProm - writes the perf test output in prometheus text
+// exposition format, HELP and TYPE are written once per metric family.
+func writePerfResultProm(w io.Writer, out PerfTestOutput) error {
+	for _, m := range perfResultPromMetrics(out) {
+		if e := m.write(w); e != nil {
+			return e
+		}
+	}
+	return nil
+}
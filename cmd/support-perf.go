@@ -19,10 +19,17 @@ package cmd
 
 import (
 	"archive/zip"
+	"context"
+	"encoding/csv"
 	gojson "encoding/json"
 	"fmt"
+	"math"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	humanize "github.com/dustin/go-humanize"
 	"github.com/fatih/color"
@@ -31,6 +38,7 @@ import (
 	"github.com/minio/madmin-go"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/pkg/console"
+	"github.com/olekukonko/tablewriter"
 )
 
 var supportPerfFlags = append([]cli.Flag{
@@ -39,16 +47,50 @@ var supportPerfFlags = append([]cli.Flag{
 		Usage: "duration the entire perf tests are run",
 		Value: "10s",
 	},
+	cli.StringFlag{
+		Name:  "timeout",
+		Usage: "maximum duration all the perf tests are allowed to take, no limit by default",
+	},
 	cli.BoolFlag{
 		Name:  "verbose, v",
 		Usage: "display per-server stats",
 	},
+	cli.StringFlag{
+		Name:  "percentiles",
+		Usage: "comma separated list of latency percentiles to display with --verbose, supported: 50,75,95,99,99.9",
+		Value: "50,95,99",
+	},
+	cli.StringFlag{
+		Name:  "filter-nodes",
+		Usage: "comma separated list of nodes to display the results of, all nodes still run the tests",
+	},
+	cli.BoolFlag{
+		Name:  "only-errors",
+		Usage: "display only the servers which reported an error",
+	},
+	cli.IntFlag{
+		Name:  "repeat",
+		Usage: "number of times each test is run, throughput statistics are reported across the runs",
+		Value: 1,
+	},
+	cli.BoolFlag{
+		Name:  "prom",
+		Usage: "print the results in prometheus text exposition format",
+	},
+	cli.StringFlag{
+		Name:  "csv",
+		Usage: "save the results as CSV to the given local file path",
+	},
 	cli.StringFlag{
 		Name:   "size",
 		Usage:  "size of the object used for uploads/downloads",
 		Value:  "64MiB",
 		Hidden: true,
 	},
+	cli.StringFlag{
+		Name:  "size-sweep",
+		Usage: "comma separated list of object sizes, the object test is run once per size",
+	},
 	cli.IntFlag{
 		Name:   "concurrent",
 		Usage:  "number of concurrent requests per server",
@@ -93,25 +135,77 @@ var supportPerfCmd = cli.Command{
 
 USAGE:
   {{.HelpName}} [COMMAND] [FLAGS] TARGET
+  {{.HelpName}} compare OLD-REPORT.zip NEW-REPORT.zip
 
 FLAGS:
   {{range .VisibleFlags}}{{.}}
   {{end}}
+CSV COLUMNS:
+  endpoint,test,throughput,objects_per_sec,tx,rx,error,object_size
+  'test' is one of object_put, object_get, net, drive_read or drive_write.
+  'throughput', 'tx' and 'rx' are in bytes/sec, columns not applicable to a test are left empty.
+  'object_size' is in bytes, --size-sweep writes the object test records once per object size.
+
+NODE FILTER:
+  --filter-nodes only filters the displayed and saved results, the tests always run on every node
+  of the cluster and the nodes left out still load the cluster and the network.
 
 EXAMPLES:
   1. Upload object storage, network, and drive performance analysis for cluster with alias 'myminio' to SUBNET
      {{.Prompt}} {{.HelpName}} myminio
   2. Run object storage, network, and drive performance tests on cluster with alias 'myminio', save and upload to SUBNET manually
      {{.Prompt}} {{.HelpName}} --airgap myminio
+  3. Run object storage performance test on cluster with alias 'myminio', display per-server stats and P50/P99 latencies
+     {{.Prompt}} {{.HelpName}} object --verbose --percentiles 50,99 myminio
+  4. Run all the performance tests on cluster with alias 'myminio' and also save the results to 'perf.csv'
+     {{.Prompt}} {{.HelpName}} --csv perf.csv myminio
+  5. Run network performance test on cluster with alias 'myminio' 5 times and report throughput variance
+     {{.Prompt}} {{.HelpName}} net --repeat 5 myminio
+  6. Run drive performance test on cluster with alias 'myminio' and display only the drives which reported an error
+     {{.Prompt}} {{.HelpName}} drive --only-errors myminio
+  7. Run network performance test on cluster with alias 'myminio' and display the results of 'node1' and 'node2' only
+     {{.Prompt}} {{.HelpName}} net --filter-nodes node1:9000,node2:9000 myminio
+  8. Run all the performance tests on cluster with alias 'myminio' and push the results to a Prometheus Pushgateway
+     {{.Prompt}} {{.HelpName}} --prom myminio | curl --data-binary @- http://pushgateway:9091/metrics/job/mc_perf
+  9. Run object storage performance test on cluster with alias 'myminio' for a range of object sizes
+     {{.Prompt}} {{.HelpName}} object --size-sweep 1MiB,16MiB,64MiB,256MiB myminio
+  10. Compare the throughput of two saved performance reports
+     {{.Prompt}} {{.HelpName}} compare myminio-perf_20221101120000.zip myminio-perf_20221108120000.zip
+  11. Run all the performance tests on cluster with alias 'myminio' and give up on the tests still running after 2 minutes
+     {{.Prompt}} {{.HelpName}} --timeout 2m myminio
 `,
 }
 
 // PerfTestOutput - stores the final output of performance test(s)
 type PerfTestOutput struct {
-	ObjectResults *ObjTestResults   `json:"object,omitempty"`
-	NetResults    *NetTestResults   `json:"network,omitempty"`
-	DriveResults  *DriveTestResults `json:"drive,omitempty"`
-	Error         string            `json:"error,omitempty"`
+	Status        string          `json:"status,omitempty"`
+	ObjectResults *ObjTestResults `json:"object,omitempty"`
+	// ObjectRepeatResults - results of the object test runs following
+	// the first one, as their cluster wide stats cannot be merged.
+	ObjectRepeatResults []ObjTestResults `json:"objectRepeat,omitempty"`
+	// ObjectSweepResults - results of the object test per object size with --size-sweep
+	ObjectSweepResults []ObjTestResults `json:"objectSweep,omitempty"`
+	NetResults         *NetTestResults  `json:"network,omitempty"`
+	// NetRepeatResults - results of the network test runs following
+	// the first one, one entry per run.
+	NetRepeatResults []NetTestResults  `json:"networkRepeat,omitempty"`
+	DriveResults     *DriveTestResults `json:"drive,omitempty"`
+	// DriveRepeatResults - results of the drive test runs following
+	// the first one, one entry per run.
+	DriveRepeatResults []DriveTestResults    `json:"driveRepeat,omitempty"`
+	Stats              []PerfThroughputStats `json:"stats,omitempty"`
+	Error              string                `json:"error,omitempty"`
+}
+
+// PerfThroughputStats - throughput (bytes/sec) statistics of a test
+// across repeated runs
+type PerfThroughputStats struct {
+	Test   string  `json:"test"`
+	Runs   int     `json:"runs"`
+	Min    uint64  `json:"min"`
+	Max    uint64  `json:"max"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stddev"`
 }
 
 // DriveTestResult - result of the drive performance test on a given endpoint
@@ -190,7 +284,73 @@ type NetTestResults struct {
 	Results []NetTestResult `json:"servers"`
 }
 
+// perfLatencyPercentiles - latency percentiles reported by the server
+// in madmin.Timings, keyed by the value accepted in --percentiles.
+var perfLatencyPercentiles = map[string]func(madmin.Timings) time.Duration{
+	"50":   func(t madmin.Timings) time.Duration { return t.P50 },
+	"75":   func(t madmin.Timings) time.Duration { return t.P75 },
+	"95":   func(t madmin.Timings) time.Duration { return t.P95 },
+	"99":   func(t madmin.Timings) time.Duration { return t.P99 },
+	"99.9": func(t madmin.Timings) time.Duration { return t.P999 },
+}
+
+// parsePerfPercentiles - parses a comma separated list of percentiles
+// and validates that each of them is reported by the server.
+func parsePerfPercentiles(value string) ([]string, *probe.Error) {
+	var percentiles []string
+	for _, p := range strings.Split(value, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, ok := perfLatencyPercentiles[p]; !ok {
+			return nil, errInvalidArgument().Trace(p)
+		}
+		percentiles = append(percentiles, p)
+	}
+	return percentiles, nil
+}
+
+func latencyPercentilesResult(name string, timings madmin.Timings) string {
+	msg := fmt.Sprintf("   * %s:", name)
+	for _, p := range globalPerfTestPercentiles {
+		msg += fmt.Sprintf(" P%s: %s", p, perfLatencyPercentiles[p](timings).Round(time.Microsecond))
+	}
+	return msg + "\n"
+}
+
+// perfAllServersHealthy - displayed with --only-errors when no server reported an error
+const perfAllServersHealthy = "all servers healthy"
+
+func objectTestErrorsResult(result *madmin.SpeedTestResult) (msg string) {
+	for _, stats := range []struct {
+		name    string
+		servers []madmin.SpeedTestStatServer
+	}{
+		{"PUT", result.PUTStats.Servers},
+		{"GET", result.GETStats.Servers},
+	} {
+		var lines string
+		for _, node := range stats.servers {
+			if node.Err != "" {
+				lines += fmt.Sprintf("   * %s: Err: %s\n", node.Endpoint, node.Err)
+			}
+		}
+		if lines != "" {
+			msg += stats.name + ":\n" + lines
+		}
+	}
+	if msg == "" {
+		msg = perfAllServersHealthy + "\n"
+	}
+	return msg
+}
+
 func objectTestVerboseResult(result *madmin.SpeedTestResult) (msg string) {
+	if globalPerfTestOnlyErrors {
+		return objectTestErrorsResult(result)
+	}
+
 	msg += "PUT:\n"
 	for _, node := range result.PUTStats.Servers {
 		msg += fmt.Sprintf("   * %s: %s/s %s objs/s", node.Endpoint, humanize.IBytes(node.ThroughputPerSec), humanize.Comma(int64(node.ObjectsPerSec)))
@@ -199,6 +359,10 @@ func objectTestVerboseResult(result *madmin.SpeedTestResult) (msg string) {
 		}
 		msg += "\n"
 	}
+	if len(globalPerfTestPercentiles) > 0 {
+		// Latencies are only reported aggregated across all the servers.
+		msg += latencyPercentilesResult("Response Time (all servers)", result.PUTStats.Response)
+	}
 
 	msg += "GET:\n"
 	for _, node := range result.GETStats.Servers {
@@ -208,6 +372,9 @@ func objectTestVerboseResult(result *madmin.SpeedTestResult) (msg string) {
 		}
 		msg += "\n"
 	}
+	if len(globalPerfTestPercentiles) > 0 {
+		msg += latencyPercentilesResult("TTFB (all servers)", result.GETStats.TTFB)
+	}
 
 	return msg
 }
@@ -227,12 +394,193 @@ func (p PerfTestOutput) String() string {
 
 // JSON - jsonified output of the perf tests
 func (p PerfTestOutput) JSON() string {
+	if globalPerfTestOnlyErrors {
+		p = p.onlyErrors()
+	}
+	p.Status = "success"
+	if p.Error != "" {
+		p.Status = "error"
+	}
 	JSONBytes, e := json.MarshalIndent(p, "", "    ")
 	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
 	return string(JSONBytes)
 }
 
-var globalPerfTestVerbose bool
+func objStatServersWithErrors(servers []ObjStatServer) []ObjStatServer {
+	out := []ObjStatServer{}
+	for _, s := range servers {
+		if s.Error != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func objTestResultsWithErrors(r ObjTestResults) ObjTestResults {
+	r.PUTResults.Servers = objStatServersWithErrors(r.PUTResults.Servers)
+	r.GETResults.Servers = objStatServersWithErrors(r.GETResults.Servers)
+	return r
+}
+
+// onlyErrors - returns a copy of the output retaining only the
+// server (and drive) results which reported an error.
+func (p PerfTestOutput) onlyErrors() PerfTestOutput {
+	if p.ObjectResults != nil {
+		r := objTestResultsWithErrors(*p.ObjectResults)
+		p.ObjectResults = &r
+	}
+	if p.ObjectRepeatResults != nil {
+		repeats := make([]ObjTestResults, 0, len(p.ObjectRepeatResults))
+		for _, r := range p.ObjectRepeatResults {
+			repeats = append(repeats, objTestResultsWithErrors(r))
+		}
+		p.ObjectRepeatResults = repeats
+	}
+	if p.NetResults != nil {
+		r := netTestResultsWithErrors(*p.NetResults)
+		p.NetResults = &r
+	}
+	if p.NetRepeatResults != nil {
+		repeats := make([]NetTestResults, 0, len(p.NetRepeatResults))
+		for _, r := range p.NetRepeatResults {
+			repeats = append(repeats, netTestResultsWithErrors(r))
+		}
+		p.NetRepeatResults = repeats
+	}
+	if p.DriveResults != nil {
+		r := driveTestResultsWithErrors(*p.DriveResults)
+		p.DriveResults = &r
+	}
+	if p.DriveRepeatResults != nil {
+		repeats := make([]DriveTestResults, 0, len(p.DriveRepeatResults))
+		for _, r := range p.DriveRepeatResults {
+			repeats = append(repeats, driveTestResultsWithErrors(r))
+		}
+		p.DriveRepeatResults = repeats
+	}
+	return p
+}
+
+func netTestResultsWithErrors(r NetTestResults) NetTestResults {
+	results := []NetTestResult{}
+	for _, n := range r.Results {
+		if n.Error != "" {
+			results = append(results, n)
+		}
+	}
+	return NetTestResults{Results: results}
+}
+
+func driveTestResultsWithErrors(r DriveTestResults) DriveTestResults {
+	results := []DriveTestResult{}
+	for _, n := range r.Results {
+		perf := []madmin.DrivePerf{}
+		for _, d := range n.Perf {
+			if d.Error != "" {
+				perf = append(perf, d)
+			}
+		}
+		if n.Error != "" || len(perf) > 0 {
+			n.Perf = perf
+			results = append(results, n)
+		}
+	}
+	return DriveTestResults{Results: results}
+}
+
+var (
+	globalPerfTestVerbose     bool
+	globalPerfTestOnlyErrors  bool
+	globalPerfTestPercentiles []string
+	globalPerfTestNodes       []string
+	globalPerfTestProm        bool
+	globalPerfTestSizeSweep   bool
+	// globalPerfTestContext - context of the running tests, bounded by --timeout
+	globalPerfTestContext = globalContext
+)
+
+// matchPerfTestNode - returns true if the endpoint is one of the
+// nodes selected with --filter-nodes, a node matches the endpoint either
+// exactly or by its host name.
+func matchPerfTestNode(nodes []string, endpoint string) bool {
+	if len(nodes) == 0 {
+		return true
+	}
+	host := endpoint
+	if h, _, e := net.SplitHostPort(endpoint); e == nil {
+		host = h
+	}
+	for _, node := range nodes {
+		if node == endpoint || node == host {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePerfTestNodes - parses the comma separated list of nodes and
+// validates each of them against the endpoints of the cluster.
+func parsePerfTestNodes(value string, endpoints []string) ([]string, *probe.Error) {
+	var nodes []string
+	for _, node := range strings.Split(value, ",") {
+		node = strings.TrimSpace(node)
+		if node == "" {
+			continue
+		}
+		found := false
+		for _, endpoint := range endpoints {
+			if matchPerfTestNode([]string{node}, endpoint) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errInvalidArgument().Trace(node)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// filterPerfTestNodes - drops the results of the nodes not selected with
+// --filter-nodes, the server runs the tests on all the nodes regardless.
+func filterPerfTestNodes(r PerfTestResult) PerfTestResult {
+	if len(globalPerfTestNodes) == 0 {
+		return r
+	}
+	if r.ObjectResult != nil {
+		result := *r.ObjectResult
+		for _, stats := range []*madmin.SpeedTestStats{&result.PUTStats, &result.GETStats} {
+			servers := []madmin.SpeedTestStatServer{}
+			for _, server := range stats.Servers {
+				if matchPerfTestNode(globalPerfTestNodes, server.Endpoint) {
+					servers = append(servers, server)
+				}
+			}
+			stats.Servers = servers
+		}
+		r.ObjectResult = &result
+	}
+	if r.NetResult != nil {
+		result := madmin.NetperfResult{NodeResults: []madmin.NetperfNodeResult{}}
+		for _, nr := range r.NetResult.NodeResults {
+			if matchPerfTestNode(globalPerfTestNodes, nr.Endpoint) {
+				result.NodeResults = append(result.NodeResults, nr)
+			}
+		}
+		r.NetResult = &result
+	}
+	if r.DriveResult != nil {
+		results := []madmin.DriveSpeedTestResult{}
+		for _, dr := range r.DriveResult {
+			if matchPerfTestNode(globalPerfTestNodes, dr.Endpoint) {
+				results = append(results, dr)
+			}
+		}
+		r.DriveResult = results
+	}
+	return r
+}
 
 func mainSupportPerf(ctx *cli.Context) error {
 	args := ctx.Args()
@@ -251,10 +599,36 @@ func mainSupportPerf(ctx *cli.Context) error {
 	case 2:
 		perfType = args[0]
 		aliasedURL = args[1]
+	case 3:
+		if args[0] != "compare" {
+			showCommandHelpAndExit(ctx, 1)
+		}
+		mainSupportPerfCompare(args[1], args[2])
+		return nil
 	default:
 		showCommandHelpAndExit(ctx, 1) // last argument is exit code
 	}
 
+	if ctx.Bool("prom") {
+		if globalJSON {
+			fatalIf(errDummy().Trace(), "--prom and --json cannot be used together")
+		}
+		if ctx.Int("repeat") > 1 {
+			fatalIf(errDummy().Trace(), "--prom and --repeat cannot be used together")
+		}
+	}
+	if ctx.IsSet("size-sweep") {
+		if ctx.IsSet("size") {
+			fatalIf(errDummy().Trace(), "--size and --size-sweep cannot be used together")
+		}
+		if ctx.Int("repeat") > 1 {
+			fatalIf(errDummy().Trace(), "--size-sweep and --repeat cannot be used together")
+		}
+		if perfType != "" && perfType != "object" {
+			fatalIf(errDummy().Trace(perfType), "--size-sweep is only supported by the object test")
+		}
+	}
+
 	// Main execution
 	execSupportPerf(ctx, aliasedURL, perfType)
 
@@ -357,14 +731,45 @@ func convertObjTestResults(objResult *madmin.SpeedTestResult) *ObjTestResults {
 	return &result
 }
 
+// updatePerfOutput - adds the result to the output, results of a test
+// type already present in the output are kept as a repeated run instead
+// of replacing it.
 func updatePerfOutput(r PerfTestResult, out *PerfTestOutput) {
+	if r.Err != "" {
+		if out.Error != "" {
+			out.Error += "; "
+		}
+		out.Error += r.Type.Name() + ": " + r.Err
+	}
 	switch r.Type {
 	case DrivePerfTest:
-		out.DriveResults = convertDriveTestResults(r.DriveResult)
+		dr := convertDriveTestResults(r.DriveResult)
+		switch {
+		case out.DriveResults == nil:
+			out.DriveResults = dr
+		case dr != nil:
+			out.DriveRepeatResults = append(out.DriveRepeatResults, *dr)
+		}
 	case ObjectPerfTest:
-		out.ObjectResults = convertObjTestResults(r.ObjectResult)
+		objResults := convertObjTestResults(r.ObjectResult)
+		switch {
+		case globalPerfTestSizeSweep:
+			if objResults != nil {
+				out.ObjectSweepResults = append(out.ObjectSweepResults, *objResults)
+			}
+		case out.ObjectResults == nil:
+			out.ObjectResults = objResults
+		case objResults != nil:
+			out.ObjectRepeatResults = append(out.ObjectRepeatResults, *objResults)
+		}
 	case NetPerfTest:
-		out.NetResults = convertNetTestResults(r.NetResult)
+		nr := convertNetTestResults(r.NetResult)
+		switch {
+		case out.NetResults == nil:
+			out.NetResults = nr
+		case nr != nil:
+			out.NetRepeatResults = append(out.NetRepeatResults, *nr)
+		}
 	default:
 		fatalIf(errDummy().Trace(), fmt.Sprintf("Invalid test type %d", r.Type))
 	}
@@ -372,19 +777,123 @@ func updatePerfOutput(r PerfTestResult, out *PerfTestOutput) {
 
 func convertPerfResult(r PerfTestResult) PerfTestOutput {
 	out := PerfTestOutput{}
-	updatePerfOutput(r, &out)
+	updatePerfOutput(filterPerfTestNodes(r), &out)
 	return out
 }
 
 func convertPerfResults(results []PerfTestResult) PerfTestOutput {
 	out := PerfTestOutput{}
+	samples := map[string][]uint64{}
 	for _, r := range results {
+		r = filterPerfTestNodes(r)
 		updatePerfOutput(r, &out)
+		for test, throughput := range perfResultThroughputs(r) {
+			samples[test] = append(samples[test], throughput)
+		}
+	}
+	for _, test := range perfThroughputTests {
+		if len(samples[test]) > 1 {
+			out.Stats = append(out.Stats, computePerfThroughputStats(test, samples[test]))
+		}
 	}
 	return out
 }
 
+// perfThroughputTests - names of the throughput measurements, in display order
+var perfThroughputTests = []string{"object_put", "object_get", "net_tx", "net_rx", "drive_read", "drive_write"}
+
+// perfResultThroughputs - cluster wide throughput measured by a perf test run
+func perfResultThroughputs(r PerfTestResult) map[string]uint64 {
+	if r.Err != "" {
+		return map[string]uint64{}
+	}
+	return perfOutputThroughputs(PerfTestOutput{
+		ObjectResults: convertObjTestResults(r.ObjectResult),
+		NetResults:    convertNetTestResults(r.NetResult),
+		DriveResults:  convertDriveTestResults(r.DriveResult),
+	})
+}
+
+// perfOutputThroughputs - cluster wide throughput in a perf test output
+func perfOutputThroughputs(out PerfTestOutput) map[string]uint64 {
+	m := map[string]uint64{}
+	if out.ObjectResults != nil {
+		m["object_put"] = out.ObjectResults.PUTResults.Perf.Throughput
+		m["object_get"] = out.ObjectResults.GETResults.Perf.Throughput
+	}
+	if out.NetResults != nil {
+		var tx, rx uint64
+		for _, r := range out.NetResults.Results {
+			tx += r.Perf.TX
+			rx += r.Perf.RX
+		}
+		m["net_tx"] = tx
+		m["net_rx"] = rx
+	}
+	if out.DriveResults != nil {
+		var read, write uint64
+		for _, r := range out.DriveResults.Results {
+			for _, d := range r.Perf {
+				read += d.ReadThroughput
+				write += d.WriteThroughput
+			}
+		}
+		m["drive_read"] = read
+		m["drive_write"] = write
+	}
+	return m
+}
+
+func computePerfThroughputStats(test string, samples []uint64) PerfThroughputStats {
+	stats := PerfThroughputStats{
+		Test: test,
+		Runs: len(samples),
+		Min:  samples[0],
+		Max:  samples[0],
+	}
+	var sum float64
+	for _, v := range samples {
+		if v < stats.Min {
+			stats.Min = v
+		}
+		if v > stats.Max {
+			stats.Max = v
+		}
+		sum += float64(v)
+	}
+	stats.Mean = sum / float64(len(samples))
+
+	var variance float64
+	for _, v := range samples {
+		variance += math.Pow(float64(v)-stats.Mean, 2)
+	}
+	stats.StdDev = math.Sqrt(variance / float64(len(samples)))
+	return stats
+}
+
+func perfThroughputStatsResult(stats []PerfThroughputStats) (msg string) {
+	msg += "Throughput across runs:\n"
+	for _, st := range stats {
+		msg += fmt.Sprintf("   * %s (%d runs): min %s/s max %s/s mean %s/s stddev %s/s\n", st.Test, st.Runs,
+			humanize.IBytes(st.Min), humanize.IBytes(st.Max), humanize.IBytes(uint64(st.Mean)), humanize.IBytes(uint64(st.StdDev)))
+	}
+	return msg
+}
+
 func execSupportPerf(ctx *cli.Context, aliasedURL string, perfType string) {
+	globalPerfTestOnlyErrors = ctx.Bool("only-errors")
+	globalPerfTestProm = ctx.Bool("prom")
+	globalPerfTestSizeSweep = ctx.IsSet("size-sweep")
+	if ctx.IsSet("filter-nodes") {
+		var endpoints []string
+		for _, srv := range getAdminInfo(aliasedURL).Servers {
+			endpoints = append(endpoints, srv.Endpoint)
+		}
+		nodes, perr := parsePerfTestNodes(ctx.String("filter-nodes"), endpoints)
+		fatalIf(perr, "Unknown node, valid endpoints are: %s", strings.Join(endpoints, ", "))
+		globalPerfTestNodes = nodes
+	}
+
 	alias, apiKey := initSubnetConnectivity(ctx, aliasedURL, true)
 	if len(apiKey) == 0 {
 		// api key not passed as flag. Check that the cluster is registered.
@@ -392,6 +901,31 @@ func execSupportPerf(ctx *cli.Context, aliasedURL string, perfType string) {
 	}
 
 	results := runPerfTests(ctx, aliasedURL, perfType)
+	if ctx.Int("repeat") > 1 {
+		if stats := convertPerfResults(results).Stats; len(stats) > 0 {
+			if globalJSON {
+				printMsg(PerfTestOutput{Stats: stats})
+			} else {
+				console.Println("\n" + perfThroughputStatsResult(stats))
+			}
+		}
+	}
+	if csvPath := ctx.String("csv"); csvPath != "" {
+		e := writePerfResultCSV(convertPerfResults(results), csvPath)
+		fatalIf(probe.NewError(e), "Unable to save perf test results as CSV:")
+		if !globalJSON && !globalPerfTestProm {
+			console.Infoln("MinIO performance report saved as CSV at", csvPath)
+		}
+	}
+	if globalPerfTestSizeSweep && !globalJSON && !globalPerfTestProm {
+		console.Println("\n" + objectSizeSweepResult(convertPerfResults(results).ObjectSweepResults))
+	}
+	if globalPerfTestProm {
+		e := writePerfResultProm(os.Stdout, convertPerfResults(results))
+		fatalIf(probe.NewError(e), "Unable to write perf test results in prometheus format:")
+		// No file to be saved or uploaded to SUBNET in case of `--prom`
+		return
+	}
 	if globalJSON {
 		// No file to be saved or uploaded to SUBNET in case of `--json`
 		return
@@ -430,8 +964,16 @@ func savePerfResultFile(tmpFileName string, resultFileNamePfx string, alias stri
 	console.Infoln("MinIO performance report saved at", zipFileName)
 }
 
+// perfTestTypes - perf test type of each of the test names
+var perfTestTypes = map[string]PerfTestType{
+	"net":    NetPerfTest,
+	"drive":  DrivePerfTest,
+	"object": ObjectPerfTest,
+}
+
 func runPerfTests(ctx *cli.Context, aliasedURL string, perfType string) []PerfTestResult {
-	resultCh := make(chan PerfTestResult)
+	// Buffered, as in JSON mode the result is sent before the test returns.
+	resultCh := make(chan PerfTestResult, 1)
 	results := []PerfTestResult{}
 	defer close(resultCh)
 
@@ -441,26 +983,195 @@ func runPerfTests(ctx *cli.Context, aliasedURL string, perfType string) []PerfTe
 		tests = []string{"net", "drive", "object"}
 	}
 
-	for _, t := range tests {
-		switch t {
-		case "drive":
-			mainAdminSpeedTestDrive(ctx, aliasedURL, resultCh)
-		case "object":
-			mainAdminSpeedTestObject(ctx, aliasedURL, resultCh)
-		case "net":
-			mainAdminSpeedTestNetperf(ctx, aliasedURL, resultCh)
-		default:
-			showCommandHelpAndExit(ctx, 1) // last argument is exit code
+	repeat := ctx.Int("repeat")
+	if repeat <= 0 {
+		fatalIf(errInvalidArgument(), "repeat cannot be '0' or negative")
+	}
+
+	objectSizes := []string{ctx.String("size")}
+	if ctx.IsSet("size-sweep") {
+		objectSizes = nil
+		for _, size := range strings.Split(ctx.String("size-sweep"), ",") {
+			if size = strings.TrimSpace(size); size != "" {
+				objectSizes = append(objectSizes, size)
+			}
+		}
+		if len(objectSizes) == 0 {
+			fatalIf(errInvalidArgument(), "size-sweep expects at least one object size")
+		}
+	}
+
+	var timeout time.Duration
+	if ctx.IsSet("timeout") {
+		var e error
+		timeout, e = time.ParseDuration(ctx.String("timeout"))
+		fatalIf(probe.NewError(e), "Unable to parse timeout")
+		if timeout <= 0 {
+			fatalIf(errInvalidArgument(), "timeout cannot be 0 or negative")
+		}
+		var cancel context.CancelFunc
+		globalPerfTestContext, cancel = context.WithTimeout(globalContext, timeout)
+		defer cancel()
+	}
+
+	timedOut := func(t string) bool {
+		if globalPerfTestContext.Err() != context.DeadlineExceeded {
+			return false
+		}
+		if !globalJSON && !globalPerfTestProm {
+			console.Errorln(fmt.Sprintf("%s test timed out after %s", t, timeout))
 		}
+		return true
+	}
 
-		if !globalJSON {
-			results = append(results, <-resultCh)
+	for _, t := range tests {
+		sizes := []string{""}
+		if t == "object" {
+			sizes = objectSizes
+		}
+		for _, size := range sizes {
+			for i := 0; i < repeat; i++ {
+				if timedOut(t) {
+					// Deadline passed before the test could be started.
+					results = append(results, PerfTestResult{
+						Type:  perfTestTypes[t],
+						Err:   "timed out before the test could run",
+						Final: true,
+					})
+					continue
+				}
+				switch t {
+				case "drive":
+					mainAdminSpeedTestDrive(ctx, aliasedURL, resultCh)
+				case "object":
+					mainAdminSpeedTestObject(ctx, aliasedURL, size, resultCh)
+				case "net":
+					mainAdminSpeedTestNetperf(ctx, aliasedURL, resultCh)
+				default:
+					showCommandHelpAndExit(ctx, 1) // last argument is exit code
+				}
+
+				r := <-resultCh
+				if timedOut(t) && r.Err == "" {
+					r.Err = "timed out, results are partial"
+				}
+				results = append(results, r)
+			}
 		}
 	}
 
 	return results
 }
 
+// objectSizeSweepResult - table of object size vs throughput of a --size-sweep run
+func objectSizeSweepResult(results []ObjTestResults) string {
+	var s strings.Builder
+	table := tablewriter.NewWriter(&s)
+	table.SetAutoWrapText(false)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetBorder(false)
+	table.SetHeader([]string{"Size", "PUT", "GET"})
+	for _, r := range results {
+		table.Append([]string{
+			humanize.IBytes(uint64(r.ObjectSize)),
+			humanize.IBytes(r.PUTResults.Perf.Throughput) + "/s",
+			humanize.IBytes(r.GETResults.Perf.Throughput) + "/s",
+		})
+	}
+	table.Render()
+	return s.String()
+}
+
+// perfCSVHeader - stable set of columns of the CSV perf report
+var perfCSVHeader = []string{"endpoint", "test", "throughput", "objects_per_sec", "tx", "rx", "error", "object_size"}
+
+func objStatServersCSVRecords(test string, objectSize int, servers []ObjStatServer) (records [][]string) {
+	for _, s := range servers {
+		records = append(records, []string{
+			s.Endpoint,
+			test,
+			strconv.FormatUint(s.Perf.Throughput, 10),
+			strconv.FormatUint(s.Perf.ObjectsPerSec, 10),
+			"",
+			"",
+			s.Error,
+			strconv.Itoa(objectSize),
+		})
+	}
+	return records
+}
+
+func objTestResultsCSVRecords(r ObjTestResults) (records [][]string) {
+	records = append(records, objStatServersCSVRecords("object_put", r.ObjectSize, r.PUTResults.Servers)...)
+	return append(records, objStatServersCSVRecords("object_get", r.ObjectSize, r.GETResults.Servers)...)
+}
+
+// perfResultCSVRecords - flattens the perf test output into one
+// record per server per test type, and per object size with --size-sweep.
+func perfResultCSVRecords(out PerfTestOutput) (records [][]string) {
+	if out.ObjectResults != nil {
+		records = append(records, objTestResultsCSVRecords(*out.ObjectResults)...)
+	}
+	for _, r := range out.ObjectSweepResults {
+		records = append(records, objTestResultsCSVRecords(r)...)
+	}
+	if out.NetResults != nil {
+		for _, r := range out.NetResults.Results {
+			records = append(records, []string{
+				r.Endpoint,
+				"net",
+				"",
+				"",
+				strconv.FormatUint(r.Perf.TX, 10),
+				strconv.FormatUint(r.Perf.RX, 10),
+				r.Error,
+				"",
+			})
+		}
+	}
+	if out.DriveResults != nil {
+		for _, r := range out.DriveResults.Results {
+			var read, write uint64
+			errs := []string{}
+			if r.Error != "" {
+				errs = append(errs, r.Error)
+			}
+			for _, d := range r.Perf {
+				read += d.ReadThroughput
+				write += d.WriteThroughput
+				if d.Error != "" {
+					errs = append(errs, d.Path+": "+d.Error)
+				}
+			}
+			errStr := strings.Join(errs, "; ")
+			records = append(records,
+				[]string{r.Endpoint, "drive_read", strconv.FormatUint(read, 10), "", "", "", errStr, ""},
+				[]string{r.Endpoint, "drive_write", strconv.FormatUint(write, 10), "", "", "", errStr, ""},
+			)
+		}
+	}
+	return records
+}
+
+// writePerfResultCSV - saves the perf test output as CSV at the given path
+func writePerfResultCSV(out PerfTestOutput, path string) error {
+	f, e := os.Create(path)
+	if e != nil {
+		return e
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if e = w.Write(perfCSVHeader); e != nil {
+		return e
+	}
+	if e = w.WriteAll(perfResultCSVRecords(out)); e != nil {
+		return e
+	}
+	return f.Close()
+}
+
 func writeJSONObjToZip(zipWriter *zip.Writer, obj interface{}, filename string) error {
 	writer, e := zipWriter.Create(filename)
 	if e != nil {
@@ -0,0 +1,159 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestConvertPerfResultsRepeated(t *testing.T) {
+	results := []PerfTestResult{
+		{
+			Type: DrivePerfTest,
+			DriveResult: []madmin.DriveSpeedTestResult{
+				{Endpoint: "server1:9000", DrivePerf: []madmin.DrivePerf{{Path: "/disk1", ReadThroughput: 100, WriteThroughput: 50}}},
+			},
+			Final: true,
+		},
+		{
+			Type: DrivePerfTest,
+			DriveResult: []madmin.DriveSpeedTestResult{
+				{Endpoint: "server1:9000", DrivePerf: []madmin.DrivePerf{{Path: "/disk1", ReadThroughput: 300, WriteThroughput: 150}}},
+			},
+			Final: true,
+		},
+		{
+			Type:         ObjectPerfTest,
+			ObjectResult: &madmin.SpeedTestResult{Size: 1},
+			Final:        true,
+		},
+		{
+			Type:         ObjectPerfTest,
+			ObjectResult: &madmin.SpeedTestResult{Size: 2},
+			Final:        true,
+		},
+	}
+
+	out := convertPerfResults(results)
+	if out.DriveResults == nil || len(out.DriveResults.Results) != 1 || out.DriveResults.Results[0].Perf[0].ReadThroughput != 100 {
+		t.Fatalf("expected first drive result to be retained, got %+v", out.DriveResults)
+	}
+	if len(out.DriveRepeatResults) != 1 || out.DriveRepeatResults[0].Results[0].Perf[0].ReadThroughput != 300 {
+		t.Fatalf("expected repeated drive result to be retained, got %+v", out.DriveRepeatResults)
+	}
+	if out.ObjectResults == nil || out.ObjectResults.ObjectSize != 1 {
+		t.Fatalf("expected first object result to be retained, got %+v", out.ObjectResults)
+	}
+	if len(out.ObjectRepeatResults) != 1 || out.ObjectRepeatResults[0].ObjectSize != 2 {
+		t.Fatalf("expected repeated object result to be retained, got %+v", out.ObjectRepeatResults)
+	}
+	if len(out.Stats) != 4 || out.Stats[2].Test != "drive_read" || out.Stats[2].Mean != 200 {
+		t.Fatalf("unexpected throughput stats %+v", out.Stats)
+	}
+}
+
+func TestPerfResultSizeSweepOutput(t *testing.T) {
+	sweep := func(size int, throughput uint64) ObjTestResults {
+		servers := []ObjStatServer{{Endpoint: "server1:9000", Perf: ObjStats{Throughput: throughput}}}
+		return ObjTestResults{
+			ObjectSize: size,
+			PUTResults: ObjPUTPerfResults{Servers: servers},
+			GETResults: ObjGETPerfResults{Servers: servers},
+		}
+	}
+	out := PerfTestOutput{ObjectSweepResults: []ObjTestResults{sweep(1024, 10), sweep(2048, 20)}}
+
+	records := perfResultCSVRecords(out)
+	if len(records) != 4 {
+		t.Fatalf("expected a PUT and a GET record per object size, got %v", records)
+	}
+	if records[0][7] != "1024" || records[2][7] != "2048" || records[2][2] != "20" {
+		t.Fatalf("unexpected size sweep records %v", records)
+	}
+
+	var b strings.Builder
+	if e := writePerfResultProm(&b, out); e != nil {
+		t.Fatal(e)
+	}
+	for _, sample := range []string{
+		`mc_perf_put_throughput_bytes{endpoint="server1:9000",object_size="1024"} 10`,
+		`mc_perf_put_throughput_bytes{endpoint="server1:9000",object_size="2048"} 20`,
+	} {
+		if !strings.Contains(b.String(), sample) {
+			t.Errorf("expected sample %s in %s", sample, b.String())
+		}
+	}
+}
+
+func TestPerfTestOutputJSON(t *testing.T) {
+	out := PerfTestOutput{Stats: []PerfThroughputStats{{Test: "net_tx", Runs: 2, StdDev: 1}}}
+	var m map[string]interface{}
+	if e := json.Unmarshal([]byte(out.JSON()), &m); e != nil {
+		t.Fatal(e)
+	}
+	if m["status"] != "success" {
+		t.Fatalf("expected status success, got %v", m["status"])
+	}
+	stats := m["stats"].([]interface{})[0].(map[string]interface{})
+	if _, ok := stats["stddev"]; !ok {
+		t.Fatalf("expected stddev key in %v", stats)
+	}
+
+	out.Error = "net: timeout"
+	if e := json.Unmarshal([]byte(out.JSON()), &m); e != nil {
+		t.Fatal(e)
+	}
+	if m["status"] != "error" {
+		t.Fatalf("expected status error, got %v", m["status"])
+	}
+
+	// The result saved in the zip file keeps its schema.
+	b, e := json.Marshal(out)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if strings.Contains(string(b), `"status"`) {
+		t.Fatalf("unexpected status in %s", b)
+	}
+}
+
+func TestSpeedTestUIOnlyErrorsHealthy(t *testing.T) {
+	defer func(v bool) { globalPerfTestOnlyErrors = v }(globalPerfTestOnlyErrors)
+	globalPerfTestOnlyErrors = true
+
+	ui := initSpeedTestUI()
+	ui.Update(PerfTestResult{
+		Type:      NetPerfTest,
+		NetResult: &madmin.NetperfResult{NodeResults: []madmin.NetperfNodeResult{{Endpoint: "server1:9000", TX: 1, RX: 1}}},
+	})
+	if strings.Contains(ui.View(), perfAllServersHealthy) {
+		t.Fatal("expected no verdict before the final result")
+	}
+	ui.Update(PerfTestResult{
+		Type:      NetPerfTest,
+		NetResult: &madmin.NetperfResult{NodeResults: []madmin.NetperfNodeResult{{Endpoint: "server1:9000", TX: 1, RX: 1}}},
+		Final:     true,
+	})
+	if !strings.Contains(ui.View(), perfAllServersHealthy) {
+		t.Fatal("expected all servers healthy on the final result")
+	}
+}
@@ -18,9 +18,34 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/pkg/console"
+	"github.com/minio/pkg/wildcard"
 )
 
+// Flags common to tag set and tag remove for tagging many objects.
+var tagRecursiveFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "recursive, r",
+		Usage: "apply to all objects under the prefix",
+	},
+	cli.StringSliceFlag{
+		Name:  "include",
+		Usage: "with --recursive, only apply to object(s) that match specified object name pattern",
+	},
+	cli.StringSliceFlag{
+		Name:  "exclude",
+		Usage: "with --recursive, skip object(s) that match specified object name pattern",
+	},
+}
+
 var tagSubcommands = []cli.Command{
 	tagListCmd,
 	tagRemoveCmd,
@@ -41,3 +66,83 @@ func mainTag(ctx *cli.Context) error {
 	commandNotFound(ctx, tagSubcommands)
 	return nil
 }
+
+// tagSummaryMessage - totals of a recursive tag set or remove.
+type tagSummaryMessage struct {
+	Status  string `json:"status"`
+	op      string
+	Target  string `json:"target"`
+	Objects int    `json:"objects"`
+	Failed  int    `json:"failed"`
+}
+
+func (t tagSummaryMessage) String() string {
+	msg := fmt.Sprintf("Tags %s for %d object(s) under %s.", t.op, t.Objects, t.Target)
+	if t.Failed > 0 {
+		msg += fmt.Sprintf(" Failed for %d object(s).", t.Failed)
+	}
+	return console.Colorize("List", msg)
+}
+
+func (t tagSummaryMessage) JSON() string {
+	t.Status = "success"
+	msgBytes, e := json.MarshalIndent(t, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// matchTagFilters - reports whether an object, by its name relative to
+// the prefix, passes the --include and --exclude patterns.
+func matchTagFilters(key string, includes, excludes []string) bool {
+	if len(includes) > 0 {
+		var included bool
+		for _, pattern := range includes {
+			if wildcard.Match(pattern, key) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return !matchExcludeOptions(excludes, key)
+}
+
+// tagObjectsRecursive - calls apply for every object, or every object
+// version, under targetURL which passes the filters. Failures are
+// reported and skipped, the number of successes and failures is returned.
+func tagObjectsRecursive(ctx context.Context, cliCtx *cli.Context, targetURL string, timeRef time.Time, withVersions bool,
+	apply func(clnt Client, versionID string) *probe.Error,
+) (objects, failed int) {
+	alias, _, _ := mustExpandAlias(targetURL)
+	clnt, err := newClient(targetURL)
+	fatalIf(err.Trace(targetURL), "Unable to initialize target "+targetURL)
+
+	prefix := clnt.GetURL().Path
+	includes, excludes := cliCtx.StringSlice("include"), cliCtx.StringSlice("exclude")
+	opts := ListOptions{Recursive: true, TimeRef: timeRef, WithOlderVersions: withVersions, ShowDir: DirNone}
+	for content := range clnt.List(ctx, opts) {
+		if content.Err != nil {
+			fatalIf(content.Err.Trace(), "Unable to list target "+targetURL)
+		}
+		// Delete markers carry no tags.
+		if content.Type.IsDir() || content.IsDeleteMarker {
+			continue
+		}
+		if !matchTagFilters(strings.TrimPrefix(content.URL.Path, prefix), includes, excludes) {
+			continue
+		}
+		objectClnt, err := newClientFromAlias(alias, content.URL.String())
+		if err == nil {
+			err = apply(objectClnt, content.VersionID)
+		}
+		if err != nil {
+			errorIf(err.Trace(content.URL.String()), "Unable to update tags of "+content.URL.String())
+			failed++
+			continue
+		}
+		objects++
+	}
+	return objects, failed
+}
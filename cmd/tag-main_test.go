@@ -0,0 +1,41 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestMatchTagFilters(t *testing.T) {
+	testCases := []struct {
+		key      string
+		includes []string
+		excludes []string
+		expected bool
+	}{
+		{"a/b.csv", nil, nil, true},
+		{"a/b.csv", []string{"*.csv"}, nil, true},
+		{"a/b.log", []string{"*.csv"}, nil, false},
+		{"a/b.log", nil, []string{"*.log"}, false},
+		{"a/b.csv", []string{"a/*"}, []string{"*.log"}, true},
+		{"a/b.log", []string{"a/*"}, []string{"*.log"}, false},
+	}
+	for i, testCase := range testCases {
+		if got := matchTagFilters(testCase.key, testCase.includes, testCase.excludes); got != testCase.expected {
+			t.Errorf("Test %d: expected %v for %s, got %v", i+1, testCase.expected, testCase.key, got)
+		}
+	}
+}
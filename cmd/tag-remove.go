@@ -49,7 +49,7 @@ var tagRemoveCmd = cli.Command{
 	Action:       mainRemoveTag,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        append(tagRemoveFlags, globalFlags...),
+	Flags:        append(append(tagRemoveFlags, tagRecursiveFlags...), globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
@@ -74,6 +74,9 @@ EXAMPLES:
 
   4. Remove the tags assigned to a bucket.
      {{.Prompt}} {{.HelpName}} play/testbucket
+
+  5. Remove the tags assigned to all versions of the .csv objects under a prefix.
+     {{.Prompt}} {{.HelpName}} --recursive --versions --include "*.csv" myminio/testbucket/prefix/
 `,
 }
 
@@ -115,6 +118,9 @@ func parseRemoveTagSyntax(ctx *cli.Context) (targetURL, versionID string, timeRe
 	if versionID != "" && (rewind != "" || withVersions) {
 		fatalIf(errDummy().Trace(), "You cannot specify both --version-id and --rewind or --versions flags at the same time")
 	}
+	if versionID != "" && ctx.Bool("recursive") {
+		fatalIf(errDummy().Trace(), "You cannot specify --version-id with --recursive")
+	}
 
 	timeRef = parseRewindFlag(rewind)
 	return
@@ -151,6 +157,26 @@ func mainRemoveTag(cliCtx *cli.Context) error {
 		timeRef = time.Now().UTC()
 	}
 
+	if cliCtx.Bool("recursive") {
+		console.SetColor("List", color.New(color.FgGreen))
+		objects, failed := tagObjectsRecursive(ctx, cliCtx, targetURL, timeRef, withVersions, func(clnt Client, versionID string) *probe.Error {
+			if err := clnt.DeleteTags(ctx, versionID); err != nil {
+				return err
+			}
+			printMsg(tagRemoveMessage{
+				Status:    "success",
+				Name:      clnt.GetURL().String(),
+				VersionID: versionID,
+			})
+			return nil
+		})
+		printMsg(tagSummaryMessage{op: "removed", Target: targetURL, Objects: objects, Failed: failed})
+		if failed > 0 {
+			return exitStatus(globalErrorExitStatus)
+		}
+		return nil
+	}
+
 	clnt, pErr := newClient(targetURL)
 	fatalIf(pErr, "Unable to initialize target "+targetURL)
 
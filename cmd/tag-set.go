@@ -48,7 +48,7 @@ var tagSetCmd = cli.Command{
 	Action:       mainSetTag,
 	OnUsageError: onUsageError,
 	Before:       setGlobalsFromContext,
-	Flags:        append(tagSetFlags, globalFlags...),
+	Flags:        append(append(tagSetFlags, tagRecursiveFlags...), globalFlags...),
 	CustomHelpTemplate: `NAME:
   {{.HelpName}} - {{.Usage}}
 
@@ -73,6 +73,12 @@ EXAMPLES:
 
   4. Assign tags to a bucket.
      {{.Prompt}} {{.HelpName}} myminio/testbucket "key1=value1&key2=value2&key3=value3"
+
+  5. Assign tags to all objects under a prefix, except .log files.
+     {{.Prompt}} {{.HelpName}} --recursive --exclude "*.log" myminio/testbucket/prefix/ "key1=value1"
+
+  6. Assign tags to all versions of all objects in a bucket.
+     {{.Prompt}} {{.HelpName}} --recursive --versions myminio/testbucket "key1=value1"
 `,
 }
 
@@ -115,6 +121,9 @@ func parseSetTagSyntax(ctx *cli.Context) (targetURL, versionID string, timeRef t
 	if versionID != "" && (rewind != "" || withVersions) {
 		fatalIf(errDummy().Trace(), "You cannot specify both --version-id and --rewind or --versions flags at the same time")
 	}
+	if versionID != "" && ctx.Bool("recursive") {
+		fatalIf(errDummy().Trace(), "You cannot specify --version-id with --recursive")
+	}
 
 	timeRef = parseRewindFlag(rewind)
 	return
@@ -150,6 +159,25 @@ func mainSetTag(cliCtx *cli.Context) error {
 		timeRef = time.Now().UTC()
 	}
 
+	if cliCtx.Bool("recursive") {
+		objects, failed := tagObjectsRecursive(ctx, cliCtx, targetURL, timeRef, withVersions, func(clnt Client, versionID string) *probe.Error {
+			if err := clnt.SetTags(ctx, versionID, tags); err != nil {
+				return err.Trace(tags)
+			}
+			printMsg(tagSetMessage{
+				Status:    "success",
+				Name:      clnt.GetURL().String(),
+				VersionID: versionID,
+			})
+			return nil
+		})
+		printMsg(tagSummaryMessage{op: "set", Target: targetURL, Objects: objects, Failed: failed})
+		if failed > 0 {
+			return exitStatus(globalErrorExitStatus)
+		}
+		return nil
+	}
+
 	clnt, err := newClient(targetURL)
 	fatalIf(err.Trace(cliCtx.Args()...), "Unable to initialize target "+targetURL)
 
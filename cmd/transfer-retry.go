@@ -0,0 +1,157 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	json "github.com/minio/colorjson"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/pkg/console"
+)
+
+// Default delay before the first retry of a failed transfer.
+const defaultRetryDelay = time.Second
+
+// Number of transfers which succeeded only after a retry.
+var globalRetriedTransfers int64
+
+// isRetryableError - returns true for transient failures worth retrying,
+// server side errors and broken connections. Client side errors such as
+// access denied are not retried, since they fail the same way again.
+func isRetryableError(err *probe.Error) bool {
+	if err == nil {
+		return false
+	}
+	e := err.ToGoError()
+	if resp := minio.ToErrorResponse(e); resp.StatusCode != 0 {
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+	if errors.Is(e, syscall.ECONNRESET) || errors.Is(e, syscall.ECONNREFUSED) ||
+		errors.Is(e, syscall.EPIPE) || errors.Is(e, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(e, &netErr) && netErr.Timeout()
+}
+
+// attemptProgress forwards the progress of a single transfer attempt
+// and counts the bytes it reported, so that a failed attempt can be
+// taken back before the transfer is retried.
+type attemptProgress struct {
+	progress io.Reader
+	n        int64
+}
+
+func (a *attemptProgress) Read(p []byte) (n int, err error) {
+	n, err = a.progress.Read(p)
+	atomic.AddInt64(&a.n, int64(n))
+	return n, err
+}
+
+// rollback removes the bytes reported by the attempt from the progress.
+func (a *attemptProgress) rollback() {
+	n := atomic.SwapInt64(&a.n, 0)
+	if n == 0 {
+		return
+	}
+	switch p := a.progress.(type) {
+	case Status:
+		p.Add(-n)
+	case *progressBar:
+		p.Add64(-n)
+	case *accounter:
+		p.Add(-n)
+	}
+}
+
+// retryTransfer - runs transfer, retrying it up to --retry times while it
+// fails with a retryable error. The delay between attempts starts at
+// --retry-delay and doubles after every attempt. Every attempt reports
+// to progress through its own reader, the bytes reported by a failed
+// attempt are rolled back so that a retry does not count them twice.
+func retryTransfer(ctx context.Context, progress io.Reader, transfer func(progress io.Reader) URLs) URLs {
+	var ap *attemptProgress
+	run := func() URLs {
+		if progress == nil {
+			return transfer(nil)
+		}
+		ap = &attemptProgress{progress: progress}
+		return transfer(ap)
+	}
+	urls := run()
+	delay := globalRetryDelay
+	for attempt := 1; attempt <= globalRetry && isRetryableError(urls.Error); attempt++ {
+		if globalDebug {
+			console.Debugln(fmt.Sprintf("Retrying transfer in %s (%d/%d): %s", delay, attempt, globalRetry, urls.Error.ToGoError()))
+		}
+		select {
+		case <-ctx.Done():
+			return urls
+		case <-time.After(delay):
+		}
+		delay *= 2
+
+		if ap != nil {
+			ap.rollback()
+		}
+		urls = run()
+		if urls.Error == nil {
+			atomic.AddInt64(&globalRetriedTransfers, 1)
+		}
+	}
+	return urls
+}
+
+// retryMessage - reports how many transfers succeeded only after a retry.
+type retryMessage struct {
+	Status  string `json:"status"`
+	Retried int64  `json:"retried"`
+}
+
+// String colorized retry message.
+func (r retryMessage) String() string {
+	if r.Retried == 1 {
+		return "1 object was transferred after retrying."
+	}
+	return fmt.Sprintf("%d objects were transferred after retrying.", r.Retried)
+}
+
+// JSON jsonified retry message.
+func (r retryMessage) JSON() string {
+	r.Status = "success"
+	msgBytes, e := json.MarshalIndent(r, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(msgBytes)
+}
+
+// getRetryMessage - returns the retry report, false when no
+// transfer had to be retried.
+func getRetryMessage() (retryMessage, bool) {
+	retried := atomic.LoadInt64(&globalRetriedTransfers)
+	return retryMessage{Retried: retried}, retried > 0
+}
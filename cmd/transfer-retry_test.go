@@ -0,0 +1,112 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	testCases := []struct {
+		err       *probe.Error
+		retryable bool
+	}{
+		{nil, false},
+		{probe.NewError(minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable, Code: "SlowDown"}), true},
+		{probe.NewError(minio.ErrorResponse{StatusCode: http.StatusInternalServerError}), true},
+		{probe.NewError(minio.ErrorResponse{StatusCode: http.StatusForbidden, Code: "AccessDenied"}), false},
+		{probe.NewError(fmt.Errorf("read: %w", syscall.ECONNRESET)), true},
+		{probe.NewError(errors.New("invalid argument")), false},
+	}
+	for i, testCase := range testCases {
+		if got := isRetryableError(testCase.err); got != testCase.retryable {
+			t.Errorf("Test %d: expected %t, got %t", i+1, testCase.retryable, got)
+		}
+	}
+}
+
+func TestRetryTransfer(t *testing.T) {
+	defer func(retry int, delay time.Duration) {
+		globalRetry, globalRetryDelay = retry, delay
+	}(globalRetry, globalRetryDelay)
+	globalRetry, globalRetryDelay = 3, time.Millisecond
+
+	slowDown := probe.NewError(minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable})
+	accessDenied := probe.NewError(minio.ErrorResponse{StatusCode: http.StatusForbidden})
+
+	testCases := []struct {
+		errs             []*probe.Error
+		expectedAttempts int
+		shouldPass       bool
+	}{
+		{[]*probe.Error{nil}, 1, true},
+		{[]*probe.Error{slowDown, slowDown, nil}, 3, true},
+		{[]*probe.Error{slowDown, slowDown, slowDown, slowDown}, 4, false},
+		{[]*probe.Error{accessDenied, nil}, 1, false},
+	}
+	for i, testCase := range testCases {
+		attempts := 0
+		urls := retryTransfer(context.Background(), nil, func(io.Reader) URLs {
+			attempts++
+			return URLs{}.WithError(testCase.errs[attempts-1])
+		})
+		if attempts != testCase.expectedAttempts {
+			t.Errorf("Test %d: expected %d attempts, got %d", i+1, testCase.expectedAttempts, attempts)
+		}
+		if (urls.Error == nil) != testCase.shouldPass {
+			t.Errorf("Test %d: unexpected result %v", i+1, urls.Error)
+		}
+	}
+}
+
+func TestRetryTransferProgress(t *testing.T) {
+	defer func(retry int, delay time.Duration) {
+		globalRetry, globalRetryDelay = retry, delay
+	}(globalRetry, globalRetryDelay)
+	globalRetry, globalRetryDelay = 3, time.Millisecond
+
+	slowDown := probe.NewError(minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable})
+	pg := newAccounter(100)
+	attempts := 0
+	urls := retryTransfer(context.Background(), pg, func(progress io.Reader) URLs {
+		attempts++
+		if attempts < 3 {
+			// A failed attempt reports part of the object before failing.
+			progress.Read(make([]byte, 40))
+			return URLs{}.WithError(slowDown)
+		}
+		progress.Read(make([]byte, 100))
+		return URLs{}
+	})
+	if urls.Error != nil {
+		t.Fatalf("unexpected error %v", urls.Error)
+	}
+	if got := pg.Get(); got != 100 {
+		t.Errorf("expected progress of 100 bytes, got %d", got)
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
+	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/pkg/console"
 )
@@ -75,6 +76,10 @@ var treeFlags = []cli.Flag{
 		Name:  "rewind",
 		Usage: "display tree no later than specified date",
 	},
+	cli.IntFlag{
+		Name:  "max-children",
+		Usage: "display at most N entries per folder, the others are summarized",
+	},
 }
 
 // trees files and folders.
@@ -109,36 +114,82 @@ EXAMPLES:
 
    5. List all directories upto depth level '2' in tree format.
       {{.Prompt}} {{.HelpName}} --depth 2 myminio/mybucket/
+
+   6. List all directories and objects in "mybucket", displaying at most 10 entries per directory.
+      {{.Prompt}} {{.HelpName}} --files --max-children 10 myminio/mybucket/
+
+   7. Print the directories of "mybucket" upto depth level '2' as a nested JSON document.
+      {{.Prompt}} {{.HelpName}} --json --depth 2 myminio/mybucket/
 `,
 }
 
 // parseTreeSyntax - validate all the passed arguments
-func parseTreeSyntax(ctx context.Context, cliCtx *cli.Context) (args []string, depth int, files bool, timeRef time.Time) {
+func parseTreeSyntax(ctx context.Context, cliCtx *cli.Context) (args []string, o treeOpts) {
 	args = cliCtx.Args()
-	depth = cliCtx.Int("depth")
-	files = cliCtx.Bool("files")
+	o.depth = cliCtx.Int("depth")
+	o.includeFiles = cliCtx.Bool("files")
+	o.maxChildren = cliCtx.Int("max-children")
 
 	rewind := cliCtx.String("rewind")
-	timeRef = parseRewindFlag(rewind)
+	o.timeRef = parseRewindFlag(rewind)
 
-	if depth < -1 || cliCtx.Int("depth") == 0 {
+	if o.depth < -1 || cliCtx.Int("depth") == 0 {
 		fatalIf(errInvalidArgument().Trace(args...),
 			"please set a proper depth, for example: '--depth 1' to limit the tree output, default (-1) output displays everything")
 	}
 
+	if o.maxChildren < 0 {
+		fatalIf(errInvalidArgument().Trace(args...), "--max-children cannot be negative")
+	}
+
 	if len(args) == 0 {
 		return
 	}
 
 	for _, url := range args {
-		_, _, err := url2Stat(ctx, url, "", false, nil, timeRef, false)
+		_, _, err := url2Stat(ctx, url, "", false, nil, o.timeRef, false)
 		fatalIf(err.Trace(url), "Unable to tree `"+url+"`.")
 	}
 	return
 }
 
-// doTree - list all entities inside a folder in a tree format.
-func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf bool, branchString string, depth int, includeFiles bool) error {
+// treeOpts - options of the tree command.
+type treeOpts struct {
+	timeRef      time.Time
+	depth        int
+	includeFiles bool
+	maxChildren  int
+}
+
+// treeNode - a folder or an object of the tree printed with --json.
+type treeNode struct {
+	Name     string      `json:"name"`
+	IsDir    bool        `json:"isDir"`
+	Children []*treeNode `json:"children,omitempty"`
+	// Number of entries not listed in Children because of --max-children.
+	Omitted int `json:"omitted,omitempty"`
+}
+
+// treeJSONMessage - the whole tree of a target, printed with --json.
+type treeJSONMessage struct {
+	Status string `json:"status"`
+	treeNode
+}
+
+func (t treeJSONMessage) String() string {
+	return ""
+}
+
+func (t treeJSONMessage) JSON() string {
+	t.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(t, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+	return string(jsonMessageBytes)
+}
+
+// newTreeClient - returns the client listing url along with its
+// alias and the prefix to trim from the listed entries.
+func newTreeClient(url string) (clnt Client, targetAlias, prefixPath string) {
 	targetAlias, targetURL, _ := mustExpandAlias(url)
 	if !strings.HasSuffix(targetURL, "/") {
 		targetURL += "/"
@@ -147,25 +198,32 @@ func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf
 	clnt, err := newClientFromAlias(targetAlias, targetURL)
 	fatalIf(err.Trace(targetURL), "Unable to initialize target `"+targetURL+"`.")
 
-	prefixPath := clnt.GetURL().Path
+	prefixPath = clnt.GetURL().Path
 	separator := string(clnt.GetURL().Separator)
 	if !strings.HasSuffix(prefixPath, separator) {
 		prefixPath = filepath.Dir(prefixPath) + "/"
 	}
+	// Convert any os specific delimiters to "/" and
+	// trim prefix of current working dir.
+	prefixPath = strings.TrimPrefix(filepath.ToSlash(prefixPath), "."+separator)
+	return clnt, targetAlias, prefixPath
+}
 
-	bucketNameShowed := false
-	var prev *ClientContent
-	show := func(end bool) error {
-		currbranchString := branchString
-		if level == 1 && !bucketNameShowed {
-			bucketNameShowed = true
-			printMsg(treeMessage{
-				Entry:        url,
-				IsDir:        true,
-				BranchString: branchString,
-			})
-		}
+// treeChildURL - returns the URL to list the content of a folder.
+func treeChildURL(targetAlias, contentURL string) string {
+	if targetAlias != "" {
+		return targetAlias + "/" + contentURL
+	}
+	return contentURL
+}
 
+// doTree - list all entities inside a folder in a tree format.
+func doTree(ctx context.Context, url string, level int, leaf bool, branchString string, o treeOpts) error {
+	clnt, targetAlias, prefixPath := newTreeClient(url)
+
+	// childBranch - returns the branch string of an entry of this level.
+	childBranch := func(end bool) string {
+		currbranchString := branchString
 		isLevelClosed := strings.HasSuffix(currbranchString, treeLastEntry)
 		if isLevelClosed {
 			currbranchString = strings.TrimSuffix(currbranchString, treeLastEntry)
@@ -186,13 +244,23 @@ func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf
 		} else {
 			currbranchString += treeEntry
 		}
+		return currbranchString
+	}
 
-		// Convert any os specific delimiters to "/".
-		contentURL := filepath.ToSlash(prev.URL.Path)
-		prefixPath = filepath.ToSlash(prefixPath)
+	bucketNameShowed := false
+	var prev *ClientContent
+	show := func(end bool) error {
+		if level == 1 && !bucketNameShowed {
+			bucketNameShowed = true
+			printMsg(treeMessage{
+				Entry:        url,
+				IsDir:        true,
+				BranchString: branchString,
+			})
+		}
 
-		// Trim prefix of current working dir
-		prefixPath = strings.TrimPrefix(prefixPath, "."+separator)
+		currbranchString := childBranch(end)
+		contentURL := filepath.ToSlash(prev.URL.Path)
 
 		if prev.Type.IsDir() {
 			printMsg(treeMessage{
@@ -209,15 +277,8 @@ func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf
 		}
 
 		if prev.Type.IsDir() {
-			url := ""
-			if targetAlias != "" {
-				url = targetAlias + "/" + contentURL
-			} else {
-				url = contentURL
-			}
-
-			if depth == -1 || level <= depth {
-				if err := doTree(ctx, url, timeRef, level+1, end, currbranchString, depth, includeFiles); err != nil {
+			if o.depth == -1 || level <= o.depth {
+				if err := doTree(ctx, treeChildURL(targetAlias, contentURL), level+1, end, currbranchString, o); err != nil {
 					return err
 				}
 			}
@@ -226,13 +287,19 @@ func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf
 		return nil
 	}
 
-	for content := range clnt.List(ctx, ListOptions{Recursive: false, TimeRef: timeRef, ShowDir: DirFirst}) {
+	var shown, omitted int
+	for content := range clnt.List(ctx, ListOptions{Recursive: false, TimeRef: o.timeRef, ShowDir: DirFirst}) {
 		if content.Err != nil {
 			errorIf(content.Err.Trace(clnt.GetURL().String()), "Unable to tree.")
 			continue
 		}
 
-		if !includeFiles && !content.Type.IsDir() {
+		if !o.includeFiles && !content.Type.IsDir() {
+			continue
+		}
+
+		if o.maxChildren > 0 && shown == o.maxChildren {
+			omitted++
 			continue
 		}
 
@@ -243,17 +310,64 @@ func doTree(ctx context.Context, url string, timeRef time.Time, level int, leaf
 		}
 
 		prev = content
+		shown++
 	}
 
 	if prev != nil {
-		if err := show(true); err != nil {
+		if err := show(omitted == 0); err != nil {
 			return err
 		}
 	}
+	if omitted > 0 {
+		printMsg(treeMessage{
+			Entry:        fmt.Sprintf("… %d more", omitted),
+			BranchString: childBranch(true),
+		})
+	}
 
 	return nil
 }
 
+// buildTree - lists all entities inside a folder into a nested tree,
+// following the same rules as doTree. Listing errors are reported as
+// they happen and the partial tree is kept; a non-nil error is returned
+// if any listing failed.
+func buildTree(ctx context.Context, url string, level int, node *treeNode, o treeOpts) error {
+	clnt, targetAlias, prefixPath := newTreeClient(url)
+
+	var cErr error
+	for content := range clnt.List(ctx, ListOptions{Recursive: false, TimeRef: o.timeRef, ShowDir: DirFirst}) {
+		if content.Err != nil {
+			errorIf(content.Err.Trace(clnt.GetURL().String()), "Unable to tree.")
+			cErr = exitStatus(globalErrorExitStatus)
+			continue
+		}
+
+		if !o.includeFiles && !content.Type.IsDir() {
+			continue
+		}
+
+		if o.maxChildren > 0 && len(node.Children) == o.maxChildren {
+			node.Omitted++
+			continue
+		}
+
+		contentURL := filepath.ToSlash(content.URL.Path)
+		child := &treeNode{
+			Name:  strings.TrimSuffix(strings.TrimPrefix(contentURL, prefixPath), "/"),
+			IsDir: content.Type.IsDir(),
+		}
+		node.Children = append(node.Children, child)
+
+		if child.IsDir && (o.depth == -1 || level <= o.depth) {
+			if e := buildTree(ctx, treeChildURL(targetAlias, contentURL), level+1, child, o); e != nil {
+				cErr = e
+			}
+		}
+	}
+	return cErr
+}
+
 // mainTree - is a handler for mc tree command
 func mainTree(cliCtx *cli.Context) error {
 	ctx, cancelList := context.WithCancel(globalContext)
@@ -263,7 +377,7 @@ func mainTree(cliCtx *cli.Context) error {
 	console.SetColor("Dir", color.New(color.FgCyan, color.Bold))
 
 	// parse 'tree' cliCtx arguments.
-	args, depth, includeFiles, timeRef := parseTreeSyntax(ctx, cliCtx)
+	args, o := parseTreeSyntax(ctx, cliCtx)
 
 	// mimic operating system tool behavior.
 	if len(args) == 0 {
@@ -273,28 +387,15 @@ func mainTree(cliCtx *cli.Context) error {
 	var cErr error
 	for _, targetURL := range args {
 		if !globalJSON {
-			if e := doTree(ctx, targetURL, timeRef, 1, false, "", depth, includeFiles); e != nil {
+			if e := doTree(ctx, targetURL, 1, false, "", o); e != nil {
 				cErr = e
 			}
 		} else {
-			targetAlias, targetURL, _ := mustExpandAlias(targetURL)
-			if !strings.HasSuffix(targetURL, "/") {
-				targetURL += "/"
-			}
-			clnt, err := newClientFromAlias(targetAlias, targetURL)
-			fatalIf(err.Trace(targetURL), "Unable to initialize target `"+targetURL+"`.")
-			opts := doListOptions{
-				timeRef:           timeRef,
-				isRecursive:       true,
-				isIncomplete:      false,
-				isSummary:         false,
-				withOlderVersions: false,
-				listZip:           false,
-				filter:            "*",
-			}
-			if e := doList(ctx, clnt, opts); e != nil {
+			msg := treeJSONMessage{treeNode: treeNode{Name: targetURL, IsDir: true}}
+			if e := buildTree(ctx, targetURL, 1, &msg.treeNode, o); e != nil {
 				cErr = e
 			}
+			printMsg(msg)
 		}
 	}
 	return cErr
@@ -126,3 +126,11 @@ var errConflictSSE = func(sseServer, sseKeys string) *probe.Error {
 	err := fmt.Errorf("SSE alias '%s' overlaps with SSE-C aliases '%s'", sseServer, sseKeys)
 	return probe.NewError(conflictSSEErr(err)).Untrace()
 }
+
+type checksumMismatchErr error
+
+var errChecksumMismatch = func(source, target, algo, sourceSum, targetSum string) *probe.Error {
+	err := fmt.Errorf("%s checksum mismatch, source `%s` has %s, target `%s` has %s",
+		algo, source, sourceSum, target, targetSum)
+	return probe.NewError(checksumMismatchErr(err)).Untrace()
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
@@ -49,6 +50,10 @@ var undoFlags = []cli.Flag{
 		Name:  "dry-run",
 		Usage: "fake an undo operation",
 	},
+	cli.StringFlag{
+		Name:  "as-of",
+		Usage: "undo all changes made after the specified time e.g. \"2022.10.01T10:00\" or a duration e.g. \"7d\"",
+	},
 }
 
 var undoCmd = cli.Command{
@@ -73,6 +78,12 @@ EXAMPLES:
 
   2. Undo the last upload/removal change of all objects under a prefix
      {{.Prompt}} {{.HelpName}} s3/backups/prefix/ --recursive --force
+
+  3. Show which versions would be removed to roll back a prefix to its state of October 1st, 2022
+     {{.Prompt}} {{.HelpName}} s3/backups/prefix/ --recursive --force --as-of "2022.10.01T00:00" --dry-run
+
+  4. Roll back a prefix to its state of 7 days ago
+     {{.Prompt}} {{.HelpName}} s3/backups/prefix/ --recursive --force --as-of 7d
 `,
 }
 
@@ -108,8 +119,52 @@ func (c undoMessage) JSON() string {
 	return string(jsonMessageBytes)
 }
 
+// undoAsOfMessage container for an object version removed or restored
+// when rolling back to a point in time.
+type undoAsOfMessage struct {
+	Status         string    `json:"status"`
+	Action         string    `json:"action"`
+	URL            string    `json:"url,omitempty"`
+	Key            string    `json:"key,omitempty"`
+	VersionID      string    `json:"versionId,omitempty"`
+	IsDeleteMarker bool      `json:"isDeleteMarker,omitempty"`
+	LastModified   time.Time `json:"lastModified"`
+	DryRun         bool      `json:"dryRun,omitempty"`
+}
+
+// String colorized string message.
+func (c undoAsOfMessage) String() string {
+	yellow := color.New(color.FgYellow).SprintFunc()
+	kind := "Version"
+	if c.IsDeleteMarker {
+		kind = "Delete marker"
+	}
+	action := c.Action + "d"
+	if c.DryRun {
+		action = "would be " + action
+	}
+	msg := color.GreenString("\u2713 ")
+	if c.Action == "restore" {
+		action = color.BlueString(action)
+	} else {
+		action = color.RedString(action)
+	}
+	msg += fmt.Sprintf("%s `%s` (vid=%s, %s) %s.", kind, yellow(c.Key), c.VersionID,
+		c.LastModified.Format(printDate), action)
+	return msg
+}
+
+// JSON jsonified content message.
+func (c undoAsOfMessage) JSON() string {
+	c.Status = "success"
+	jsonMessageBytes, e := json.MarshalIndent(c, "", " ")
+	fatalIf(probe.NewError(e), "Unable to marshal into JSON.")
+
+	return string(jsonMessageBytes)
+}
+
 // parseUndoSyntax performs command-line input validation for cat command.
-func parseUndoSyntax(ctx *cli.Context) (targetAliasedURL string, last int, recursive, dryRun bool) {
+func parseUndoSyntax(ctx *cli.Context) (targetAliasedURL string, last int, recursive, dryRun bool, asOf time.Time) {
 	targetAliasedURL = ctx.Args().Get(0)
 	if targetAliasedURL == "" {
 		fatalIf(errInvalidArgument().Trace(), "The argument should not be empty")
@@ -127,6 +182,109 @@ func parseUndoSyntax(ctx *cli.Context) (targetAliasedURL string, last int, recur
 	}
 
 	dryRun = ctx.Bool("dry-run")
+
+	if ctx.IsSet("as-of") {
+		if ctx.IsSet("last") {
+			fatalIf(errInvalidArgument().Trace(), "--as-of and --last cannot be specified together")
+		}
+		asOf = parseTimeRefFlag(ctx.String("as-of"), "--as-of")
+		if asOf.IsZero() {
+			fatalIf(errInvalidArgument().Trace(), "--as-of value should not be empty")
+		}
+	}
+	return
+}
+
+// undoAsOfVersions returns the versions of an object created after asOf which
+// need to be removed, and the version that becomes current once they are gone.
+func undoAsOfVersions(objectVersions []*ClientContent, asOf time.Time) (remove []*ClientContent, restore *ClientContent) {
+	sortObjectVersions(objectVersions)
+	for i, objectVersion := range objectVersions {
+		if !objectVersion.Time.After(asOf) {
+			if i > 0 {
+				restore = objectVersion
+			}
+			break
+		}
+		remove = append(remove, objectVersion)
+	}
+	return remove, restore
+}
+
+func undoKeyName(clnt Client, content *ClientContent) string {
+	prefixPath := clnt.GetURL().Path
+	prefixPath = filepath.ToSlash(prefixPath)
+	if !strings.HasSuffix(prefixPath, "/") {
+		prefixPath = prefixPath[:strings.LastIndex(prefixPath, "/")+1]
+	}
+	prefixPath = strings.TrimPrefix(prefixPath, "./")
+
+	// Convert any os specific delimiters to "/".
+	contentURL := filepath.ToSlash(content.URL.Path)
+	// Trim prefix path from the content path.
+	keyName := strings.TrimPrefix(contentURL, prefixPath)
+	return getOSDependantKey(keyName, content.Type.IsDir())
+}
+
+// undoAsOf removes all versions of an object created after asOf, making the
+// version which was current at that time the latest one again.
+func undoAsOf(ctx context.Context, clnt Client, objectVersions []*ClientContent, asOf time.Time, dryRun bool) (exitErr error) {
+	remove, restore := undoAsOfVersions(objectVersions, asOf)
+	if len(remove) == 0 {
+		return
+	}
+
+	newMsg := func(action string, objectVersion *ClientContent) undoAsOfMessage {
+		return undoAsOfMessage{
+			Status:         "success",
+			Action:         action,
+			Key:            undoKeyName(clnt, objectVersion),
+			URL:            objectVersion.URL.String(),
+			VersionID:      objectVersion.VersionID,
+			IsDeleteMarker: objectVersion.IsDeleteMarker,
+			LastModified:   objectVersion.Time,
+			DryRun:         dryRun,
+		}
+	}
+
+	if dryRun {
+		for _, objectVersion := range remove {
+			printMsg(newMsg("remove", objectVersion))
+		}
+		if restore != nil {
+			printMsg(newMsg("restore", restore))
+		}
+		return
+	}
+
+	contentCh := make(chan *ClientContent)
+	resultCh := clnt.Remove(ctx, false, false, false, false, contentCh)
+	go func() {
+		for _, objectVersion := range remove {
+			contentCh <- objectVersion
+		}
+		close(contentCh)
+	}()
+
+	var failed bool
+	for result := range resultCh {
+		if result.Err != nil {
+			errorIf(result.Err.Trace(), "Unable to undo")
+			exitErr = exitStatus(globalErrorExitStatus) // Set the exit status.
+			failed = true
+			continue
+		}
+		for _, objectVersion := range remove {
+			if objectVersion.VersionID == result.ObjectVersionID || objectVersion.VersionID == result.DeleteMarkerVersionID {
+				printMsg(newMsg("remove", objectVersion))
+				break
+			}
+		}
+	}
+	if !failed && restore != nil {
+		printMsg(newMsg("restore", restore))
+	}
+
 	return
 }
 
@@ -144,27 +302,15 @@ func undoLastNOperations(ctx context.Context, clnt Client, objectVersions []*Cli
 	contentCh := make(chan *ClientContent)
 	resultCh := clnt.Remove(ctx, false, false, false, false, contentCh)
 
-	prefixPath := clnt.GetURL().Path
-	prefixPath = filepath.ToSlash(prefixPath)
-	if !strings.HasSuffix(prefixPath, "/") {
-		prefixPath = prefixPath[:strings.LastIndex(prefixPath, "/")+1]
-	}
-	prefixPath = strings.TrimPrefix(prefixPath, "./")
-
 	go func() {
 		for _, objectVersion := range objectVersions {
 			if !dryRun {
 				contentCh <- objectVersion
 			}
 
-			// Convert any os specific delimiters to "/".
-			contentURL := filepath.ToSlash(objectVersion.URL.Path)
-			// Trim prefix path from the content path.
-			keyName := strings.TrimPrefix(contentURL, prefixPath)
-
 			printMsg(undoMessage{
 				Status:         "success",
-				Key:            getOSDependantKey(keyName, objectVersion.Type.IsDir()),
+				Key:            undoKeyName(clnt, objectVersion),
 				URL:            objectVersion.URL.String(),
 				VersionID:      objectVersion.VersionID,
 				IsDeleteMarker: objectVersion.IsDeleteMarker,
@@ -184,7 +330,7 @@ func undoLastNOperations(ctx context.Context, clnt Client, objectVersions []*Cli
 	return
 }
 
-func undoURL(ctx context.Context, aliasedURL string, last int, recursive, dryRun bool) (exitErr error) {
+func undoURL(ctx context.Context, aliasedURL string, last int, recursive, dryRun bool, asOf time.Time) (exitErr error) {
 	clnt, err := newClient(aliasedURL)
 	fatalIf(err.Trace(aliasedURL), "Unable to initialize target `"+aliasedURL+"`.")
 
@@ -196,6 +342,13 @@ func undoURL(ctx context.Context, aliasedURL string, last int, recursive, dryRun
 		atLeastOneUndoApplied bool
 	)
 
+	undoObject := func(objectVersions []*ClientContent) error {
+		if !asOf.IsZero() {
+			return undoAsOf(ctx, clnt, objectVersions, asOf, dryRun)
+		}
+		return undoLastNOperations(ctx, clnt, objectVersions, last, dryRun)
+	}
+
 	for content := range clnt.List(ctx, ListOptions{
 		Recursive:         recursive,
 		WithOlderVersions: true,
@@ -218,7 +371,9 @@ func undoURL(ctx context.Context, aliasedURL string, last int, recursive, dryRun
 
 		if lastObjectPath != content.URL.Path {
 			// Print any object in the current list before reinitializing it
-			exitErr = undoLastNOperations(ctx, clnt, perObjectVersions, last, dryRun)
+			if err := undoObject(perObjectVersions); err != nil {
+				exitErr = err
+			}
 			lastObjectPath = content.URL.Path
 			perObjectVersions = []*ClientContent{}
 		}
@@ -228,7 +383,9 @@ func undoURL(ctx context.Context, aliasedURL string, last int, recursive, dryRun
 	}
 
 	// Undo the remaining versions found if any
-	exitErr = undoLastNOperations(ctx, clnt, perObjectVersions, last, dryRun)
+	if err := undoObject(perObjectVersions); err != nil {
+		exitErr = err
+	}
 
 	if !atLeastOneUndoApplied {
 		errorIf(errDummy().Trace(clnt.GetURL().String()), "Unable to find any object version to undo.")
@@ -272,11 +429,11 @@ func mainUndo(cliCtx *cli.Context) error {
 	console.SetColor("Success", color.New(color.FgGreen, color.Bold))
 
 	// check 'undo' cli arguments.
-	targetAliasedURL, last, recursive, dryRun := parseUndoSyntax(cliCtx)
+	targetAliasedURL, last, recursive, dryRun, asOf := parseUndoSyntax(cliCtx)
 
 	if !checkIfBucketIsVersioned(ctx, targetAliasedURL) {
 		fatalIf(errDummy().Trace(), "Undo command works only with S3 versioned-enabled buckets.")
 	}
 
-	return undoURL(ctx, targetAliasedURL, last, recursive, dryRun)
+	return undoURL(ctx, targetAliasedURL, last, recursive, dryRun, asOf)
 }
@@ -0,0 +1,54 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUndoAsOfVersions(t *testing.T) {
+	asOf := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	v1 := &ClientContent{VersionID: "v1", Time: asOf.Add(-48 * time.Hour)}
+	v2 := &ClientContent{VersionID: "v2", Time: asOf.Add(-time.Hour)}
+	v3 := &ClientContent{VersionID: "v3", Time: asOf.Add(time.Hour)}
+	v4 := &ClientContent{VersionID: "v4", Time: asOf.Add(48 * time.Hour), IsDeleteMarker: true, IsLatest: true}
+
+	testCases := []struct {
+		versions []*ClientContent
+		remove   []*ClientContent
+		restore  *ClientContent
+	}{
+		// Nothing changed after asOf.
+		{[]*ClientContent{v1, v2}, nil, nil},
+		// Newer versions are removed and the one current at asOf is restored.
+		{[]*ClientContent{v1, v3, v2, v4}, []*ClientContent{v4, v3}, v2},
+		// The object did not exist at asOf.
+		{[]*ClientContent{v3, v4}, []*ClientContent{v4, v3}, nil},
+	}
+	for i, tc := range testCases {
+		remove, restore := undoAsOfVersions(tc.versions, asOf)
+		if !reflect.DeepEqual(remove, tc.remove) {
+			t.Errorf("Test %d: expected remove %v, got %v", i+1, tc.remove, remove)
+		}
+		if restore != tc.restore {
+			t.Errorf("Test %d: expected restore %v, got %v", i+1, tc.restore, restore)
+		}
+	}
+}
@@ -23,17 +23,19 @@ import (
 
 // URLs contains source and target urls
 type URLs struct {
-	SourceAlias      string
-	SourceContent    *ClientContent
-	TargetAlias      string
-	TargetContent    *ClientContent
-	TotalCount       int64
-	TotalSize        int64
-	MD5              bool
-	DisableMultipart bool
-	encKeyDB         map[string][]prefixSSEPair
-	Error            *probe.Error `json:"-"`
-	ErrorCond        differType   `json:"-"`
+	SourceAlias           string
+	SourceContent         *ClientContent
+	TargetAlias           string
+	TargetContent         *ClientContent
+	TotalCount            int64
+	TotalSize             int64
+	MD5                   bool
+	DisableMultipart      bool
+	DisableServerSideCopy bool
+	encKeyDB              map[string][]prefixSSEPair
+	Error                 *probe.Error `json:"-"`
+	ErrorCond             differType   `json:"-"`
+	Skipped               bool         `json:"-"`
 }
 
 // WithError sets the error and returns object
@@ -42,6 +44,11 @@ func (m URLs) WithError(err *probe.Error) URLs {
 	return m
 }
 
+// isEmpty returns true for a task result with nothing to report
+func (m URLs) isEmpty() bool {
+	return m.SourceContent == nil && m.TargetContent == nil && m.Error == nil && !m.Skipped
+}
+
 // Equal tests if both urls are equal
 func (m URLs) Equal(n URLs) bool {
 	if m.SourceContent == nil && n.SourceContent == nil {
@@ -33,6 +33,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/mattn/go-ieproxy"
 	"github.com/minio/cli"
 	"github.com/minio/madmin-go"
@@ -153,12 +154,14 @@ func NewS3Config(urlStr string, aliasCfg *aliasConfigV10) *Config {
 	s3Config.Insecure = globalInsecure
 	s3Config.ConnReadDeadline = globalConnReadDeadline
 	s3Config.ConnWriteDeadline = globalConnWriteDeadline
+	s3Config.RequestTimeout = globalRequestTimeout
 
 	s3Config.HostURL = urlStr
 	if aliasCfg != nil {
 		s3Config.AccessKey = aliasCfg.AccessKey
 		s3Config.SecretKey = aliasCfg.SecretKey
 		s3Config.SessionToken = aliasCfg.SessionToken
+		s3Config.STS = aliasCfg.STS
 		s3Config.Signature = aliasCfg.API
 		s3Config.Lookup = getLookupType(aliasCfg.Path)
 	}
@@ -202,6 +205,27 @@ func isNewer(ti time.Time, newerRef string) bool {
 	return objectAge >= time.Duration(newerThan)
 }
 
+// parseSizeFilter parses the size of --smaller-than or --larger-than
+// once before listing, an empty value returns -1 which disables the filter.
+func parseSizeFilter(flag, value string) int64 {
+	if value == "" {
+		return -1
+	}
+	size, e := humanize.ParseBytes(value)
+	fatalIf(probe.NewError(e).Trace(value), "Unable to parse --"+flag+"=`"+value+"`.")
+	return int64(size)
+}
+
+// isSmallerThan returns true if the passed object size is smaller than smallerThan
+func isSmallerThan(size, smallerThan int64) bool {
+	return smallerThan < 0 || size < smallerThan
+}
+
+// isLargerThan returns true if the passed object size is larger than largerThan
+func isLargerThan(size, largerThan int64) bool {
+	return largerThan < 0 || size > largerThan
+}
+
 // getLookupType returns the minio.BucketLookupType for lookup
 // option entered on the command line
 func getLookupType(l string) minio.BucketLookupType {
@@ -160,3 +160,28 @@ func TestParseAttribute(t *testing.T) {
 
 	}
 }
+
+func TestSizeFilters(t *testing.T) {
+	testCases := []struct {
+		size                    int64
+		smallerThan, largerThan string
+		match                   bool
+	}{
+		{10, "", "", true},
+		{10, "1KiB", "", true},
+		{1024, "1KiB", "", false},
+		{1025, "", "1KiB", true},
+		{1024, "", "1KiB", false},
+		{5 << 20, "10MiB", "1MiB", true},
+		{20 << 20, "10MiB", "1MiB", false},
+	}
+
+	for idx, testCase := range testCases {
+		smallerThan := parseSizeFilter("smaller-than", testCase.smallerThan)
+		largerThan := parseSizeFilter("larger-than", testCase.largerThan)
+		match := isSmallerThan(testCase.size, smallerThan) && isLargerThan(testCase.size, largerThan)
+		if match != testCase.match {
+			t.Fatalf("Test %d: expected match = %v, found = %v", idx+1, testCase.match, match)
+		}
+	}
+}
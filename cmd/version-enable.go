@@ -20,12 +20,12 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/pkg/console"
 )
 
@@ -78,14 +78,9 @@ func checkVersionEnableSyntax(ctx *cli.Context) {
 
 type versionEnableMessage struct {
 	Op         string
-	Status     string `json:"status"`
-	URL        string `json:"url"`
-	Versioning struct {
-		Status           string   `json:"status"`
-		MFADelete        string   `json:"MFADelete"`
-		ExcludedPrefixes []string `json:"ExcludedPrefixes,omitempty"`
-		ExcludeFolders   bool     `json:"ExcludeFolders,,omitempty"`
-	} `json:"versioning"`
+	Status     string           `json:"status"`
+	URL        string           `json:"url"`
+	Versioning versioningStatus `json:"versioning"`
 }
 
 func (v versionEnableMessage) JSON() string {
@@ -111,21 +106,39 @@ func mainVersionEnable(cliCtx *cli.Context) error {
 	args := cliCtx.Args()
 	aliasedURL := args.Get(0)
 
-	var excludedPrefixes []string
-	prefixesStr := cliCtx.String("excluded-prefixes")
-	if prefixesStr != "" {
-		excludedPrefixes = strings.Split(prefixesStr, ",")
-	}
+	excludedPrefixes, err := parseExcludedPrefixes(cliCtx.String("excluded-prefixes"))
+	fatalIf(err.Trace(aliasedURL), "Invalid --excluded-prefixes value")
 	excludeFolders := cliCtx.Bool("exclude-folders")
 
 	// Create a new Client
 	client, err := newClient(aliasedURL)
 	fatalIf(err, "Unable to initialize connection.")
-	fatalIf(client.SetVersion(ctx, "enable", excludedPrefixes, excludeFolders), "Unable to enable versioning")
-	printMsg(versionEnableMessage{
+	err = client.SetVersion(ctx, "enable", excludedPrefixes, excludeFolders)
+	if err != nil && (len(excludedPrefixes) > 0 || excludeFolders) {
+		switch minio.ToErrorResponse(err.ToGoError()).Code {
+		case "MalformedXML", "NotImplemented":
+			fatalIf(err, "Unable to enable versioning, the server does not support --excluded-prefixes or --exclude-folders")
+		}
+	}
+	fatalIf(err, "Unable to enable versioning")
+
+	vMsg := versionEnableMessage{
 		Op:     cliCtx.Command.Name,
 		Status: "success",
 		URL:    aliasedURL,
-	})
+	}
+	vMsg.Versioning = versioningStatus{
+		Status:           minio.Enabled,
+		ExcludedPrefixes: excludedPrefixes,
+		ExcludeFolders:   excludeFolders,
+	}
+	// Report the configuration as applied by the server.
+	if vConfig, err := client.GetVersion(ctx); err == nil {
+		vMsg.Versioning = newVersioningStatus(vConfig)
+		if len(excludedPrefixes) > len(vMsg.Versioning.ExcludedPrefixes) || excludeFolders && !vMsg.Versioning.ExcludeFolders {
+			errorIf(errDummy().Trace(aliasedURL), "Versioning is enabled but the server ignored --excluded-prefixes or --exclude-folders.")
+		}
+	}
+	printMsg(vMsg)
 	return nil
 }
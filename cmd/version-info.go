@@ -48,6 +48,9 @@ FLAGS:
 EXAMPLES:
    1. Display bucket versioning status for bucket "mybucket".
       {{.Prompt}} {{.HelpName}} myminio/mybucket
+
+   2. Display bucket versioning status, MFA delete state and excluded prefixes for bucket "mybucket" in JSON.
+      {{.Prompt}} {{.HelpName}} myminio/mybucket --json
 `,
 }
 
@@ -60,14 +63,9 @@ func checkVersionInfoSyntax(ctx *cli.Context) {
 
 type versioningInfoMessage struct {
 	Op         string
-	Status     string `json:"status"`
-	URL        string `json:"url"`
-	Versioning struct {
-		Status           string   `json:"status"`
-		MFADelete        string   `json:"MFADelete"`
-		ExcludedPrefixes []string `json:"ExcludedPrefixes,omitempty"`
-		ExcludeFolders   bool     `json:"ExcludeFolders,omitempty"`
-	} `json:"versioning"`
+	Status     string           `json:"status"`
+	URL        string           `json:"url"`
+	Versioning versioningStatus `json:"versioning"`
 }
 
 func (v versioningInfoMessage) JSON() string {
@@ -85,6 +83,15 @@ func (v versioningInfoMessage) String() string {
 	default:
 		msg = fmt.Sprintf("%s versioning is %s", v.URL, strings.ToLower(v.Versioning.Status))
 	}
+	if v.Versioning.MFADelete != "" {
+		msg += fmt.Sprintf(", MFA delete is %s", strings.ToLower(v.Versioning.MFADelete))
+	}
+	if len(v.Versioning.ExcludedPrefixes) > 0 {
+		msg += fmt.Sprintf("\nExcluded prefixes: %s", strings.Join(v.Versioning.ExcludedPrefixes, ", "))
+	}
+	if v.Versioning.ExcludeFolders {
+		msg += "\nFolders are excluded from versioning"
+	}
 	return console.Colorize("versioningInfoMessage", msg)
 }
 
@@ -105,19 +112,10 @@ func mainVersionInfo(cliCtx *cli.Context) error {
 	vConfig, e := client.GetVersion(ctx)
 	fatalIf(e, "Unable to get versioning info")
 	vMsg := versioningInfoMessage{
-		Op:     cliCtx.Command.Name,
-		Status: "success",
-		URL:    aliasedURL,
-	}
-	vMsg.Versioning.Status = vConfig.Status
-	vMsg.Versioning.MFADelete = vConfig.MFADelete
-	vMsg.Versioning.ExcludeFolders = vConfig.ExcludeFolders
-	if len(vConfig.ExcludedPrefixes) > 0 {
-		prefixes := make([]string, 0, len(vConfig.ExcludedPrefixes))
-		for _, eprefix := range vConfig.ExcludedPrefixes {
-			prefixes = append(prefixes, eprefix.Prefix)
-		}
-		vMsg.Versioning.ExcludedPrefixes = prefixes
+		Op:         cliCtx.Command.Name,
+		Status:     "success",
+		URL:        aliasedURL,
+		Versioning: newVersioningStatus(vConfig),
 	}
 
 	printMsg(vMsg)
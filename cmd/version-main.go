@@ -17,7 +17,18 @@
 
 package cmd
 
-import "github.com/minio/cli"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/minio/cli"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+)
+
+// maxExcludedPrefixes is the maximum number of excluded prefixes
+// accepted by the server in a bucket versioning configuration.
+const maxExcludedPrefixes = 10
 
 var versionSubcommands = []cli.Command{
 	versionEnableCmd,
@@ -41,3 +52,55 @@ func mainVersion(ctx *cli.Context) error {
 	return nil
 	// Sub-commands like "info", "enable", "suspend" have their own main.
 }
+
+// versioningStatus - versioning state of a bucket as reported
+// by the info, enable and suspend commands.
+type versioningStatus struct {
+	Status           string   `json:"status"`
+	MFADelete        string   `json:"MFADelete"`
+	ExcludedPrefixes []string `json:"ExcludedPrefixes,omitempty"`
+	ExcludeFolders   bool     `json:"ExcludeFolders,omitempty"`
+}
+
+func newVersioningStatus(vConfig minio.BucketVersioningConfiguration) versioningStatus {
+	v := versioningStatus{
+		Status:         vConfig.Status,
+		MFADelete:      vConfig.MFADelete,
+		ExcludeFolders: vConfig.ExcludeFolders,
+	}
+	if len(vConfig.ExcludedPrefixes) > 0 {
+		v.ExcludedPrefixes = make([]string, 0, len(vConfig.ExcludedPrefixes))
+		for _, eprefix := range vConfig.ExcludedPrefixes {
+			v.ExcludedPrefixes = append(v.ExcludedPrefixes, eprefix.Prefix)
+		}
+	}
+	return v
+}
+
+// parseExcludedPrefixes - parse and validate a comma separated list of prefixes
+// to be excluded from versioning.
+func parseExcludedPrefixes(prefixesStr string) ([]string, *probe.Error) {
+	if prefixesStr == "" {
+		return nil, nil
+	}
+	var prefixes []string
+	seen := make(map[string]struct{})
+	for _, prefix := range strings.Split(prefixesStr, ",") {
+		prefix = strings.TrimSpace(prefix)
+		if prefix == "" {
+			return nil, probe.NewError(fmt.Errorf("empty prefix in `%s`", prefixesStr))
+		}
+		if strings.HasPrefix(prefix, "/") {
+			return nil, probe.NewError(fmt.Errorf("prefix `%s` must not start with '/'", prefix))
+		}
+		if _, ok := seen[prefix]; ok {
+			return nil, probe.NewError(fmt.Errorf("prefix `%s` is specified more than once", prefix))
+		}
+		seen[prefix] = struct{}{}
+		prefixes = append(prefixes, prefix)
+	}
+	if len(prefixes) > maxExcludedPrefixes {
+		return nil, probe.NewError(fmt.Errorf("too many excluded prefixes, at most %d are allowed", maxExcludedPrefixes))
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExcludedPrefixes(t *testing.T) {
+	testCases := []struct {
+		input    string
+		prefixes []string
+		success  bool
+	}{
+		{"", nil, true},
+		{"app1/*/_temporary/", []string{"app1/*/_temporary/"}, true},
+		{"app1/*/_temporary/, app2/*/_staging/", []string{"app1/*/_temporary/", "app2/*/_staging/"}, true},
+		{"app1/,", nil, false},
+		{"/app1/", nil, false},
+		{"app1/,app1/", nil, false},
+		{"a/,b/,c/,d/,e/,f/,g/,h/,i/,j/,k/", nil, false},
+	}
+	for i, tc := range testCases {
+		prefixes, err := parseExcludedPrefixes(tc.input)
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: expected success %v, got %v", i+1, tc.success, err)
+		}
+		if !reflect.DeepEqual(prefixes, tc.prefixes) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.prefixes, prefixes)
+		}
+	}
+}
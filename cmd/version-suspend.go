@@ -25,6 +25,7 @@ import (
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/pkg/console"
 )
 
@@ -59,12 +60,9 @@ func checkVersionSuspendSyntax(ctx *cli.Context) {
 
 type versionSuspendMessage struct {
 	Op         string
-	Status     string `json:"status"`
-	URL        string `json:"url"`
-	Versioning struct {
-		Status    string `json:"status"`
-		MFADelete string `json:"MFADelete"`
-	} `json:"versioning"`
+	Status     string           `json:"status"`
+	URL        string           `json:"url"`
+	Versioning versioningStatus `json:"versioning"`
 }
 
 func (v versionSuspendMessage) JSON() string {
@@ -94,10 +92,17 @@ func mainVersionSuspend(cliCtx *cli.Context) error {
 	client, err := newClient(aliasedURL)
 	fatalIf(err, "Unable to initialize connection.")
 	fatalIf(client.SetVersion(ctx, "suspend", nil, false), "Unable to suspend versioning")
-	printMsg(versionSuspendMessage{
+
+	vMsg := versionSuspendMessage{
 		Op:     cliCtx.Command.Name,
 		Status: "success",
 		URL:    aliasedURL,
-	})
+	}
+	vMsg.Versioning.Status = minio.Suspended
+	// Report the configuration as applied by the server.
+	if vConfig, err := client.GetVersion(ctx); err == nil {
+		vMsg.Versioning = newVersioningStatus(vConfig)
+	}
+	printMsg(vMsg)
 	return nil
 }
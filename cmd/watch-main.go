@@ -20,14 +20,18 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
 	"strings"
-	"sync"
+	"time"
 
 	humanize "github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 	"github.com/minio/cli"
 	json "github.com/minio/colorjson"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/notification"
 	"github.com/minio/pkg/console"
 )
@@ -36,7 +40,7 @@ var watchFlags = []cli.Flag{
 	cli.StringFlag{
 		Name:  "events",
 		Value: "put,delete,get",
-		Usage: "filter specific types of events; defaults to all events by default",
+		Usage: "comma separated event types to watch: put, delete, get, replica, ilm, bucket-creation, bucket-removal",
 	},
 	cli.StringFlag{
 		Name:  "prefix",
@@ -50,8 +54,24 @@ var watchFlags = []cli.Flag{
 		Name:  "recursive",
 		Usage: "recursively watch for events",
 	},
+	cli.IntFlag{
+		Name:  "max-reconnect",
+		Usage: "maximum number of consecutive reconnects after the connection is lost, 0 for unlimited",
+	},
+	cli.DurationFlag{
+		Name:  "reconnect-delay",
+		Usage: "delay before the first reconnect, doubled after every failed reconnect up to one minute",
+		Value: defaultWatchReconnectDelay,
+	},
 }
 
+const (
+	// Default delay before reconnecting a lost watch.
+	defaultWatchReconnectDelay = time.Second
+	// Maximum delay between two reconnects.
+	watchMaxReconnectDelay = time.Minute
+)
+
 var watchCmd = cli.Command{
 	Name:         "watch",
 	Usage:        "listen for object notification events",
@@ -86,6 +106,12 @@ EXAMPLES:
 
   6. Watch for events on local directory.
      {{.Prompt}} {{.HelpName}} /usr/share
+
+  7. Watch only uploads and deletions of ".jpg" objects under "uploads/", printing the full event payload.
+     {{.Prompt}} {{.HelpName}} --json --events put,delete --prefix "uploads/" --suffix ".jpg" play/testbucket
+
+  8. Watch a bucket during a rolling upgrade, giving up after 10 failed reconnects.
+     {{.Prompt}} {{.HelpName}} --max-reconnect 10 --reconnect-delay 2s play/testbucket
 `,
 }
 
@@ -110,6 +136,8 @@ type watchMessage struct {
 		Port      string `json:"port,omitempty"`
 		UserAgent string `json:"userAgent,omitempty"`
 	} `json:"source,omitempty"`
+	// Full event payload, only printed in JSON mode.
+	Record *notification.Event `json:"record,omitempty"`
 }
 
 func (u watchMessage) JSON() string {
@@ -144,7 +172,15 @@ func mainWatch(cliCtx *cli.Context) error {
 
 	prefix := cliCtx.String("prefix")
 	suffix := cliCtx.String("suffix")
-	events := strings.Split(cliCtx.String("events"), ",")
+	var events []string
+	for _, event := range strings.Split(cliCtx.String("events"), ",") {
+		if event = strings.ToLower(strings.TrimSpace(event)); event != "" {
+			events = append(events, event)
+		}
+	}
+	if len(events) == 0 {
+		fatalIf(errInvalidArgument().Trace(cliCtx.String("events")), "At least one event type is required for --events.")
+	}
 	recursive := cliCtx.Bool("recursive")
 
 	s3Client, pErr := newClient(path)
@@ -162,56 +198,142 @@ func mainWatch(cliCtx *cli.Context) error {
 	ctx, cancelWatch := context.WithCancel(globalContext)
 	defer cancelWatch()
 
+	maxReconnect := cliCtx.Int("max-reconnect")
+	reconnectDelay := cliCtx.Duration("reconnect-delay")
+	if reconnectDelay <= 0 {
+		fatalIf(errInvalidArgument().Trace(reconnectDelay.String()), "--reconnect-delay must be positive.")
+	}
+
 	// Start watching on events
 	wo, err := s3Client.Watch(ctx, options)
 	fatalIf(err, "Unable to watch on the specified bucket.")
 
-	// Initialize.. waitgroup to track the go-routine.
-	var wg sync.WaitGroup
-
-	// Increment wait group to wait subsequent routine.
-	wg.Add(1)
-
-	// Start routine to watching on events.
-	go func() {
-		defer wg.Done()
-
-		// Wait for all events.
-		for {
-			select {
-			case <-globalContext.Done():
-				// Signal received we are done.
-				close(wo.DoneChan)
-				return
-			case events, ok := <-wo.Events():
-				if !ok {
-					return
-				}
-				for _, event := range events {
-					msg := watchMessage{}
-					msg.Event.Path = event.Path
-					msg.Event.Size = event.Size
-					msg.Event.Time = event.Time
-					msg.Event.Type = event.Type
-					msg.Source.Host = event.Host
-					msg.Source.Port = event.Port
-					msg.Source.UserAgent = event.UserAgent
-					printMsg(msg)
-				}
-			case err, ok := <-wo.Errors():
-				if !ok {
-					return
-				}
-				if err != nil {
-					errorIf(err, "Unable to watch for events.")
-					return
+	seen := newWatchDedup(watchDedupSize)
+	delay := reconnectDelay
+	reconnects := 0
+	for {
+		err = watchEvents(wo, seen, func() {
+			// Connection is healthy again.
+			reconnects, delay = 0, reconnectDelay
+		})
+		if err == nil {
+			return nil
+		}
+		if !isWatchReconnectable(err) || (maxReconnect > 0 && reconnects >= maxReconnect) {
+			errorIf(err.Trace(path), "Unable to watch for events.")
+			return nil
+		}
+		reconnects++
+		limit := "unlimited"
+		if maxReconnect > 0 {
+			limit = strconv.Itoa(maxReconnect)
+		}
+		errorIf(err.Trace(path), fmt.Sprintf("Watch connection lost, reconnecting in %s (%d/%s).", delay, reconnects, limit))
+		select {
+		case <-globalContext.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > watchMaxReconnectDelay {
+			delay = watchMaxReconnectDelay
+		}
+		wo, err = s3Client.Watch(ctx, options)
+		fatalIf(err, "Unable to watch on the specified bucket.")
+	}
+}
+
+// watchEvents - prints the events of wo until the watch fails or
+// mc is interrupted, returns nil when interrupted. onEvent is called
+// for every received batch of events.
+func watchEvents(wo *WatchObject, seen *watchDedup, onEvent func()) *probe.Error {
+	defer close(wo.DoneChan)
+	for {
+		select {
+		case <-globalContext.Done():
+			// Signal received we are done.
+			return nil
+		case events, ok := <-wo.Events():
+			if !ok {
+				return probe.NewError(io.ErrUnexpectedEOF)
+			}
+			onEvent()
+			for _, event := range events {
+				// Events may be sent again right after reconnecting.
+				if seen.isDuplicate(event) {
+					continue
 				}
+				msg := watchMessage{}
+				msg.Event.Path = event.Path
+				msg.Event.Size = event.Size
+				msg.Event.Time = event.Time
+				msg.Event.Type = event.Type
+				msg.Source.Host = event.Host
+				msg.Source.Port = event.Port
+				msg.Source.UserAgent = event.UserAgent
+				msg.Record = event.Record
+				printMsg(msg)
+			}
+		case err, ok := <-wo.Errors():
+			if !ok {
+				return probe.NewError(io.ErrUnexpectedEOF)
+			}
+			if err != nil {
+				return err
 			}
 		}
-	}()
+	}
+}
 
-	// Wait on the routine to be finished or exit.
-	wg.Wait()
+// isWatchReconnectable - returns false for errors which fail the same
+// way after reconnecting, such as access denied or unsupported watch.
+func isWatchReconnectable(err *probe.Error) bool {
+	e := err.ToGoError()
+	if _, ok := e.(APINotImplemented); ok {
+		return false
+	}
+	if resp := minio.ToErrorResponse(e); resp.StatusCode != 0 {
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// Number of recent events remembered to drop the events sent again
+// after reconnecting.
+const watchDedupSize = 1024
 
-	return nil
+// watchDedup - remembers the most recent events by their sequencer.
+type watchDedup struct {
+	keys  map[string]struct{}
+	order []string
+	next  int
+}
+
+func newWatchDedup(size int) *watchDedup {
+	return &watchDedup{keys: make(map[string]struct{}, size), order: make([]string, size)}
+}
+
+// isDuplicate - returns true if event was already seen, events
+// without a sequencer such as local filesystem events are never
+// duplicates.
+func (d *watchDedup) isDuplicate(event EventInfo) bool {
+	if event.Record == nil || event.Record.S3.Object.Sequencer == "" {
+		return false
+	}
+	key := strings.Join([]string{
+		event.Record.EventName,
+		event.Record.S3.Bucket.Name,
+		event.Record.S3.Object.Key,
+		event.Record.S3.Object.VersionID,
+		event.Record.S3.Object.Sequencer,
+	}, "/")
+	if _, ok := d.keys[key]; ok {
+		return true
+	}
+	if old := d.order[d.next]; old != "" {
+		delete(d.keys, old)
+	}
+	d.order[d.next] = key
+	d.next = (d.next + 1) % len(d.order)
+	d.keys[key] = struct{}{}
+	return false
 }
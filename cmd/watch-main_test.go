@@ -0,0 +1,80 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/notification"
+)
+
+func TestWatchDedup(t *testing.T) {
+	newEvent := func(key, sequencer string) EventInfo {
+		record := &notification.Event{EventName: "s3:ObjectCreated:Put"}
+		record.S3.Bucket.Name = "bucket"
+		record.S3.Object.Key = key
+		record.S3.Object.Sequencer = sequencer
+		return EventInfo{Record: record}
+	}
+
+	d := newWatchDedup(2)
+	testCases := []struct {
+		event     EventInfo
+		duplicate bool
+	}{
+		{newEvent("a", "1"), false},
+		{newEvent("a", "1"), true},
+		{newEvent("a", "2"), false},
+		{newEvent("b", "2"), false},
+		// Evicted by the two previous events.
+		{newEvent("a", "1"), false},
+		// Events without a sequencer are never duplicates.
+		{newEvent("c", ""), false},
+		{newEvent("c", ""), false},
+		{EventInfo{Path: "/tmp/a"}, false},
+		{EventInfo{Path: "/tmp/a"}, false},
+	}
+	for i, testCase := range testCases {
+		if got := d.isDuplicate(testCase.event); got != testCase.duplicate {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.duplicate, got)
+		}
+	}
+}
+
+func TestIsWatchReconnectable(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected bool
+	}{
+		{io.ErrUnexpectedEOF, true},
+		{errors.New("connection reset by peer"), true},
+		{minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable}, true},
+		{minio.ErrorResponse{StatusCode: http.StatusForbidden}, false},
+		{APINotImplemented{API: "Watch"}, false},
+	}
+	for i, testCase := range testCases {
+		if got := isWatchReconnectable(probe.NewError(testCase.err)); got != testCase.expected {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
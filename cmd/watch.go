@@ -37,6 +37,9 @@ type EventInfo struct {
 	Port         string
 	UserAgent    string
 	Type         notification.EventType
+	// Record is the original event as sent by the server, nil for
+	// local filesystem events.
+	Record *notification.Event
 }
 
 // WatchOptions contains watch configuration options
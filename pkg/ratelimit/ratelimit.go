@@ -0,0 +1,123 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package ratelimit implements a bandwidth limiter that can be shared
+// by any number of concurrent readers, so that their aggregate
+// throughput stays under a configured bytes per second rate.
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// maxChunk caps the number of bytes a single Read may consume from the
+// limiter, this keeps the throughput smooth for small rates.
+const maxChunk = 32 * 1024
+
+// Limiter is a token bucket shared across readers. Each byte read
+// consumes one token, tokens are refilled at the configured rate.
+type Limiter struct {
+	mu   sync.Mutex
+	rate uint64    // bytes per second
+	next time.Time // time at which the bucket is empty again
+}
+
+// NewLimiter returns a limiter allowing up to rate bytes per second,
+// returns nil for a zero rate which means no limit.
+func NewLimiter(rate uint64) *Limiter {
+	if rate == 0 {
+		return nil
+	}
+	return &Limiter{rate: rate}
+}
+
+// Rate returns the configured rate in bytes per second.
+func (l *Limiter) Rate() uint64 {
+	if l == nil {
+		return 0
+	}
+	return l.rate
+}
+
+// WaitN blocks until n bytes are allowed to pass the limiter or
+// the context is canceled.
+func (l *Limiter) WaitN(ctx context.Context, n int) error {
+	if l == nil || n <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(n) / float64(l.rate) * float64(time.Second)))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// chunkSize returns the largest read allowed in a single call.
+func (l *Limiter) chunkSize() int {
+	if l.rate < maxChunk {
+		return int(l.rate)
+	}
+	return maxChunk
+}
+
+type reader struct {
+	ctx     context.Context
+	source  io.Reader
+	limiter *Limiter
+}
+
+// Read implements io.Reader, reads are split into small chunks and
+// each chunk waits on the limiter before it is returned.
+func (r *reader) Read(b []byte) (n int, err error) {
+	if chunk := r.limiter.chunkSize(); len(b) > chunk {
+		b = b[:chunk]
+	}
+	n, err = r.source.Read(b)
+	if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+		return n, werr
+	}
+	return n, err
+}
+
+// NewReader returns an io.Reader which reads from source no faster
+// than the limiter allows. A nil limiter returns source as is.
+func NewReader(ctx context.Context, source io.Reader, limiter *Limiter) io.Reader {
+	if limiter == nil {
+		return source
+	}
+	return &reader{ctx: ctx, source: source, limiter: limiter}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-2022 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+// Tests that a nil limiter does not wrap the source.
+func (s *MySuite) TestNoLimit(c *C) {
+	source := bytes.NewReader([]byte("Hello"))
+	c.Assert(NewLimiter(0), IsNil)
+	c.Assert(NewReader(context.Background(), source, nil), Equals, io.Reader(source))
+}
+
+// Tests that concurrent readers share the same limit.
+func (s *MySuite) TestSharedLimit(c *C) {
+	limiter := NewLimiter(64 * 1024)
+	start := time.Now()
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			r := NewReader(context.Background(), bytes.NewReader(make([]byte, 64*1024)), limiter)
+			_, err := io.Copy(io.Discard, r)
+			done <- err
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		c.Assert(<-done, IsNil)
+	}
+	// 128KiB at 64KiB/s, the first chunk passes without waiting.
+	c.Assert(time.Since(start) >= 1500*time.Millisecond, Equals, true)
+}
+
+// Tests that a canceled context unblocks the reader.
+func (s *MySuite) TestCanceled(c *C) {
+	limiter := NewLimiter(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := NewReader(ctx, bytes.NewReader([]byte("Hello")), limiter)
+	b := make([]byte, 5)
+	_, err := r.Read(b)
+	c.Assert(err, IsNil)
+	_, err = r.Read(b)
+	c.Assert(err, Equals, context.Canceled)
+}